@@ -3,10 +3,13 @@ package api
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/dipsylala/veracode-mcp/api/rest"
 	applications "github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	dynamic_analysis "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_analysis"
 	dynamic_flaw "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_flaw"
+	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
 	policy "github.com/dipsylala/veracode-mcp/api/rest/generated/policy"
 	static_finding_data_path "github.com/dipsylala/veracode-mcp/api/rest/generated/static_finding_data_path"
 	"github.com/dipsylala/veracode-mcp/api/xml"
@@ -29,15 +32,49 @@ type Client interface {
 	GetApplication(ctx context.Context, applicationGUID string) (*applications.Application, error)
 	GetApplicationByName(ctx context.Context, name string) (*applications.Application, error)
 	ListApplications(ctx context.Context, page, size int) (*applications.PagedResourceOfApplication, error)
+	SearchApplications(ctx context.Context, req ApplicationsRequest) (*applications.PagedResourceOfApplication, error)
+
+	// Sandboxes
+	ListSandboxes(ctx context.Context, applicationGUID string) ([]applications.Sandbox, error)
+	GetSandboxByName(ctx context.Context, applicationGUID, name string) (*applications.Sandbox, error)
+
+	// CWEs and finding categories
+	GetCWE(ctx context.Context, cweID int32) (*findings.CweDetail, error)
+	ListCWEs(ctx context.Context) ([]findings.CweDetail, error)
+	ListFindingCategories(ctx context.Context) ([]findings.Category, error)
 
 	// Policy
 	GetPolicy(ctx context.Context, policyName string) (*policy.PagedResourceOfPolicyVersion, error)
+	GetPolicyByGUID(ctx context.Context, policyGUID string) (*policy.PolicyVersion, error)
+	ListScaLicenses(ctx context.Context) ([]policy.ScaLicenseSummary, error)
+
+	// Dynamic Analysis configuration and scheduling
+	ListDynamicAnalyses(ctx context.Context, name string) ([]dynamic_analysis.Analysis, error)
+	GetDynamicAnalysis(ctx context.Context, analysisID string) (*dynamic_analysis.Analysis, error)
+	ListDynamicAnalysisScans(ctx context.Context, analysisID string) ([]dynamic_analysis.Scan, error)
+	ListAnalysisOccurrences(ctx context.Context, analysisID string, limit int32) ([]dynamic_analysis.AnalysisOccurrence, error)
+	ListScanOccurrences(ctx context.Context, analysisOccurrenceID string) ([]dynamic_analysis.ScanOccurrence, error)
+	GetVerificationReport(ctx context.Context, scanOccurrenceID string) (*dynamic_analysis.VerificationReport, error)
+	// StartDynamicAnalysis schedules a configured Dynamic Analysis to start now
+	StartDynamicAnalysis(ctx context.Context, analysisID string) error
 
 	// Findings (SAST/DAST/SCA)
 	GetStaticFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error)
 	GetDynamicFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error)
 	GetScaFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error)
-	GetFindingByID(ctx context.Context, findingID string, isDynamic bool) (*Finding, error)
+	GetManualFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error)
+	// GetManualFindingDetails returns the Manual Scans API write-up of each manual finding, keyed by issue ID
+	GetManualFindingDetails(ctx context.Context, applicationGUID string) (map[string]ManualFindingDetail, error)
+	// GetManualFindingDetail returns the Manual Scans API write-up of one manual finding by issue ID
+	GetManualFindingDetail(ctx context.Context, applicationGUID, issueID string) (*ManualFindingDetail, error)
+	// GetFindingByID searches the policy scan, or the sandbox if sandboxGUID is set.
+	// scanType (STATIC, DYNAMIC, MANUAL or SCA) limits the search to one scan type; "" searches them all
+	GetFindingByID(ctx context.Context, applicationGUID, sandboxGUID, findingID, scanType string) (*Finding, error)
+
+	// IterateFindings streams findings from every page; scanType is STATIC, DYNAMIC, MANUAL or SCA
+	IterateFindings(ctx context.Context, req FindingsRequest, scanType string) iter.Seq2[Finding, error]
+	// FetchAllFindings collects findings from every page, up to maxResults (capped at MaxFetchAllFindings)
+	FetchAllFindings(ctx context.Context, req FindingsRequest, scanType string, maxResults int) (*FindingsResponse, error)
 
 	// Direct access to generated clients (for advanced use cases)
 	StaticFindingDataPathClient() *static_finding_data_path.APIClient
@@ -52,6 +89,25 @@ type Client interface {
 
 	// GetMitigationInfoForSingleFlaw is a convenience method to get mitigation info for a single flaw
 	GetMitigationInfoForSingleFlaw(ctx context.Context, buildID, flawID int64) (*MitigationIssue, error)
+
+	// UpdateMitigationInfo adds a mitigation action (proposal or review) with a comment to flaws in a build
+	UpdateMitigationInfo(ctx context.Context, buildID int64, action, comment string, flawIDs []int64) (*MitigationInfo, error)
+
+	// Platform scans take numeric application and sandbox IDs; sandboxID 0 means the policy scan
+	// CreateBuild creates a new build named version
+	CreateBuild(ctx context.Context, appID, sandboxID int64, version string) (*BuildInfo, error)
+	// UploadFile uploads an artifact to the current build, returning every file in the build
+	UploadFile(ctx context.Context, appID, sandboxID int64, filePath string) ([]UploadedFile, error)
+	// BeginPrescan starts the pre-scan of the current build; the scan starts automatically when it succeeds
+	BeginPrescan(ctx context.Context, appID, sandboxID int64) (*BuildInfo, error)
+	// GetBuildInfo retrieves the status of a build, or of the most recent build if buildID is 0
+	GetBuildInfo(ctx context.Context, appID, sandboxID, buildID int64) (*BuildInfo, error)
+	// GetBuildList lists the builds of an application or sandbox, oldest first
+	GetBuildList(ctx context.Context, appID, sandboxID int64) ([]BuildSummary, error)
+	// DeleteBuild deletes the most recent build of an application or sandbox, returning the builds that remain
+	DeleteBuild(ctx context.Context, appID, sandboxID int64) ([]BuildSummary, error)
+	// DownloadDetailedReport downloads and parses a build's detailed report, also returning the XML document to cache
+	DownloadDetailedReport(ctx context.Context, buildID int64) (*DetailedReport, []byte, error)
 }
 
 // unifiedClient wraps both REST and XML API clients to provide transparent access
@@ -67,12 +123,18 @@ type unifiedClient struct {
 // 1. ~/.veracode/veracode.yml (preferred)
 // 2. Environment variables VERACODE_API_ID and VERACODE_API_KEY (fallback)
 func NewClient() (Client, error) {
-	restClient, err := rest.NewClient()
+	return NewClientForProfile("")
+}
+
+// NewClientForProfile creates a client with the credentials of a named profile of ~/.veracode/veracode.yml,
+// such as a workspace's credential_profile. An empty profile uses the default credentials, like NewClient.
+func NewClientForProfile(profile string) (Client, error) {
+	restClient, err := rest.NewClientForProfile(profile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create REST client: %w", err)
 	}
 
-	xmlClient, err := xml.NewClient()
+	xmlClient, err := xml.NewClientForProfile(profile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create XML client: %w", err)
 	}
@@ -118,10 +180,70 @@ func (c *unifiedClient) ListApplications(ctx context.Context, page, size int) (*
 	return c.restClient.ListApplications(ctx, page, size)
 }
 
+func (c *unifiedClient) SearchApplications(ctx context.Context, req ApplicationsRequest) (*applications.PagedResourceOfApplication, error) {
+	return c.restClient.SearchApplications(ctx, req)
+}
+
+func (c *unifiedClient) ListSandboxes(ctx context.Context, applicationGUID string) ([]applications.Sandbox, error) {
+	return c.restClient.ListSandboxes(ctx, applicationGUID)
+}
+
+func (c *unifiedClient) GetSandboxByName(ctx context.Context, applicationGUID, name string) (*applications.Sandbox, error) {
+	return c.restClient.GetSandboxByName(ctx, applicationGUID, name)
+}
+
+func (c *unifiedClient) GetCWE(ctx context.Context, cweID int32) (*findings.CweDetail, error) {
+	return c.restClient.GetCWE(ctx, cweID)
+}
+
+func (c *unifiedClient) ListCWEs(ctx context.Context) ([]findings.CweDetail, error) {
+	return c.restClient.ListCWEs(ctx)
+}
+
+func (c *unifiedClient) ListFindingCategories(ctx context.Context) ([]findings.Category, error) {
+	return c.restClient.ListFindingCategories(ctx)
+}
+
 func (c *unifiedClient) GetPolicy(ctx context.Context, policyName string) (*policy.PagedResourceOfPolicyVersion, error) {
 	return c.restClient.GetPolicy(ctx, policyName)
 }
 
+func (c *unifiedClient) GetPolicyByGUID(ctx context.Context, policyGUID string) (*policy.PolicyVersion, error) {
+	return c.restClient.GetPolicyByGUID(ctx, policyGUID)
+}
+
+func (c *unifiedClient) ListScaLicenses(ctx context.Context) ([]policy.ScaLicenseSummary, error) {
+	return c.restClient.ListScaLicenses(ctx)
+}
+
+func (c *unifiedClient) ListDynamicAnalyses(ctx context.Context, name string) ([]dynamic_analysis.Analysis, error) {
+	return c.restClient.ListDynamicAnalyses(ctx, name)
+}
+
+func (c *unifiedClient) GetDynamicAnalysis(ctx context.Context, analysisID string) (*dynamic_analysis.Analysis, error) {
+	return c.restClient.GetDynamicAnalysis(ctx, analysisID)
+}
+
+func (c *unifiedClient) ListDynamicAnalysisScans(ctx context.Context, analysisID string) ([]dynamic_analysis.Scan, error) {
+	return c.restClient.ListDynamicAnalysisScans(ctx, analysisID)
+}
+
+func (c *unifiedClient) ListAnalysisOccurrences(ctx context.Context, analysisID string, limit int32) ([]dynamic_analysis.AnalysisOccurrence, error) {
+	return c.restClient.ListAnalysisOccurrences(ctx, analysisID, limit)
+}
+
+func (c *unifiedClient) ListScanOccurrences(ctx context.Context, analysisOccurrenceID string) ([]dynamic_analysis.ScanOccurrence, error) {
+	return c.restClient.ListScanOccurrences(ctx, analysisOccurrenceID)
+}
+
+func (c *unifiedClient) GetVerificationReport(ctx context.Context, scanOccurrenceID string) (*dynamic_analysis.VerificationReport, error) {
+	return c.restClient.GetVerificationReport(ctx, scanOccurrenceID)
+}
+
+func (c *unifiedClient) StartDynamicAnalysis(ctx context.Context, analysisID string) error {
+	return c.restClient.StartDynamicAnalysis(ctx, analysisID)
+}
+
 func (c *unifiedClient) GetStaticFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
 	return c.restClient.GetStaticFindings(ctx, req)
 }
@@ -134,8 +256,28 @@ func (c *unifiedClient) GetScaFindings(ctx context.Context, req FindingsRequest)
 	return c.restClient.GetScaFindings(ctx, req)
 }
 
-func (c *unifiedClient) GetFindingByID(ctx context.Context, findingID string, isDynamic bool) (*Finding, error) {
-	return c.restClient.GetFindingByID(ctx, findingID, isDynamic)
+func (c *unifiedClient) GetManualFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
+	return c.restClient.GetManualFindings(ctx, req)
+}
+
+func (c *unifiedClient) GetManualFindingDetails(ctx context.Context, applicationGUID string) (map[string]ManualFindingDetail, error) {
+	return c.restClient.GetManualFindingDetails(ctx, applicationGUID)
+}
+
+func (c *unifiedClient) GetManualFindingDetail(ctx context.Context, applicationGUID, issueID string) (*ManualFindingDetail, error) {
+	return c.restClient.GetManualFindingDetail(ctx, applicationGUID, issueID)
+}
+
+func (c *unifiedClient) GetFindingByID(ctx context.Context, applicationGUID, sandboxGUID, findingID, scanType string) (*Finding, error) {
+	return c.restClient.GetFindingByID(ctx, applicationGUID, sandboxGUID, findingID, scanType)
+}
+
+func (c *unifiedClient) IterateFindings(ctx context.Context, req FindingsRequest, scanType string) iter.Seq2[Finding, error] {
+	return c.restClient.IterateFindings(ctx, req, scanType)
+}
+
+func (c *unifiedClient) FetchAllFindings(ctx context.Context, req FindingsRequest, scanType string, maxResults int) (*FindingsResponse, error) {
+	return c.restClient.FetchAllFindings(ctx, req, scanType, maxResults)
 }
 
 func (c *unifiedClient) StaticFindingDataPathClient() *static_finding_data_path.APIClient {
@@ -167,3 +309,63 @@ func (c *unifiedClient) GetMitigationInfoForSingleFlaw(ctx context.Context, buil
 	}
 	return convertXMLIssue(xmlIssue), nil
 }
+
+func (c *unifiedClient) UpdateMitigationInfo(ctx context.Context, buildID int64, action, comment string, flawIDs []int64) (*MitigationInfo, error) {
+	xmlInfo, err := c.xmlClient.UpdateMitigationInfo(ctx, buildID, xml.ActionType(action), comment, flawIDs)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLMitigationInfo(xmlInfo), nil
+}
+
+func (c *unifiedClient) CreateBuild(ctx context.Context, appID, sandboxID int64, version string) (*BuildInfo, error) {
+	xmlInfo, err := c.xmlClient.CreateBuild(ctx, appID, sandboxID, version)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLBuildInfo(xmlInfo), nil
+}
+
+func (c *unifiedClient) UploadFile(ctx context.Context, appID, sandboxID int64, filePath string) ([]UploadedFile, error) {
+	xmlList, err := c.xmlClient.UploadFile(ctx, appID, sandboxID, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLFileList(xmlList), nil
+}
+
+func (c *unifiedClient) BeginPrescan(ctx context.Context, appID, sandboxID int64) (*BuildInfo, error) {
+	xmlInfo, err := c.xmlClient.BeginPrescan(ctx, appID, sandboxID, true)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLBuildInfo(xmlInfo), nil
+}
+
+func (c *unifiedClient) GetBuildInfo(ctx context.Context, appID, sandboxID, buildID int64) (*BuildInfo, error) {
+	xmlInfo, err := c.xmlClient.GetBuildInfo(ctx, appID, sandboxID, buildID)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLBuildInfo(xmlInfo), nil
+}
+
+func (c *unifiedClient) GetBuildList(ctx context.Context, appID, sandboxID int64) ([]BuildSummary, error) {
+	xmlList, err := c.xmlClient.GetBuildList(ctx, appID, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLBuildList(xmlList), nil
+}
+
+func (c *unifiedClient) DeleteBuild(ctx context.Context, appID, sandboxID int64) ([]BuildSummary, error) {
+	xmlList, err := c.xmlClient.DeleteBuild(ctx, appID, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	return convertXMLBuildList(xmlList), nil
+}
+
+func (c *unifiedClient) DownloadDetailedReport(ctx context.Context, buildID int64) (*DetailedReport, []byte, error) {
+	return c.xmlClient.GetDetailedReportXML(ctx, buildID)
+}
@@ -54,3 +54,62 @@ func convertXMLIssue(xmlIssue *xml.Issue) *MitigationIssue {
 		MitigationActions: actions,
 	}
 }
+
+// convertXMLBuildInfo converts xml.BuildInfo to api.BuildInfo wrapper type
+func convertXMLBuildInfo(xmlInfo *xml.BuildInfo) *BuildInfo {
+	if xmlInfo == nil {
+		return nil
+	}
+
+	buildID := xmlInfo.Build.BuildID
+	if buildID == 0 {
+		buildID = xmlInfo.BuildID
+	}
+
+	return &BuildInfo{
+		AppID:                  xmlInfo.AppID,
+		SandboxID:              xmlInfo.SandboxID,
+		BuildID:                buildID,
+		Version:                xmlInfo.Build.Version,
+		Submitter:              xmlInfo.Build.Submitter,
+		PolicyName:             xmlInfo.Build.PolicyName,
+		PolicyComplianceStatus: xmlInfo.Build.PolicyComplianceStatus,
+		ResultsReady:           xmlInfo.Build.ResultsReady,
+		AnalysisStatus:         xmlInfo.Build.AnalysisUnit.Status,
+		PublishedDate:          xmlInfo.Build.AnalysisUnit.PublishedDate,
+	}
+}
+
+// convertXMLFileList converts an xml.FileList to api.UploadedFile wrapper types
+func convertXMLFileList(xmlList *xml.FileList) []UploadedFile {
+	if xmlList == nil {
+		return nil
+	}
+
+	files := make([]UploadedFile, len(xmlList.Files))
+	for i, file := range xmlList.Files {
+		files[i] = UploadedFile{
+			FileID:     file.FileID,
+			FileName:   file.FileName,
+			FileStatus: file.FileStatus,
+		}
+	}
+	return files
+}
+
+// convertXMLBuildList converts an xml.BuildList to api.BuildSummary wrapper types
+func convertXMLBuildList(xmlList *xml.BuildList) []BuildSummary {
+	if xmlList == nil {
+		return nil
+	}
+
+	builds := make([]BuildSummary, len(xmlList.Builds))
+	for i, build := range xmlList.Builds {
+		builds[i] = BuildSummary{
+			BuildID:           build.BuildID,
+			Version:           build.Version,
+			PolicyUpdatedDate: build.PolicyUpdatedDate,
+		}
+	}
+	return builds
+}
@@ -139,3 +139,84 @@ func (c *Client) ListApplications(ctx context.Context, page, size int) (*applica
 
 	return resp, nil
 }
+
+// SearchApplications retrieves one page of applications matching the request's filters
+func (c *Client) SearchApplications(ctx context.Context, req ApplicationsRequest) (*applications.PagedResourceOfApplication, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	apiReq := c.applicationsClient.ApplicationInformationAPIAPI.GetApplicationsUsingGET(authCtx)
+
+	if req.Name != "" {
+		apiReq = apiReq.Name(req.Name)
+	}
+	if req.BusinessUnit != "" {
+		apiReq = apiReq.BusinessUnit(req.BusinessUnit)
+	}
+	if req.Team != "" {
+		apiReq = apiReq.Team(req.Team)
+	}
+	if req.Tag != "" {
+		apiReq = apiReq.Tag(req.Tag)
+	}
+	if req.Policy != "" {
+		apiReq = apiReq.Policy(req.Policy)
+	}
+	if req.PolicyGUID != "" {
+		apiReq = apiReq.PolicyGuid(req.PolicyGUID)
+	}
+	if req.PolicyCompliance != "" {
+		apiReq = apiReq.PolicyCompliance(req.PolicyCompliance)
+	}
+	if req.PolicyComplianceCheckedAfter != nil {
+		apiReq = apiReq.PolicyComplianceCheckedAfter(*req.PolicyComplianceCheckedAfter)
+	}
+	if req.ScanType != "" {
+		apiReq = apiReq.ScanType(req.ScanType)
+	}
+	if len(req.ScanStatus) > 0 {
+		apiReq = apiReq.ScanStatus(req.ScanStatus)
+	}
+	if req.ModifiedAfter != nil {
+		apiReq = apiReq.ModifiedAfter(*req.ModifiedAfter)
+	}
+	if len(req.CustomFieldNames) > 0 {
+		apiReq = apiReq.CustomFieldNames(req.CustomFieldNames)
+	}
+	if len(req.CustomFieldValues) > 0 {
+		apiReq = apiReq.CustomFieldValues(req.CustomFieldValues)
+	}
+	if req.Page > 0 {
+		if req.Page > math.MaxInt32 {
+			return nil, fmt.Errorf("page value %d exceeds maximum allowed value", req.Page)
+		}
+		apiReq = apiReq.Page(int32(req.Page)) // #nosec G115 - validated above
+	}
+	if req.Size > 0 {
+		if req.Size > math.MaxInt32 {
+			return nil, fmt.Errorf("size value %d exceeds maximum allowed value", req.Size)
+		}
+		apiReq = apiReq.Size(int32(req.Size)) // #nosec G115 - validated above
+	}
+
+	resp, httpResp, err := apiReq.Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to search applications: %w", err)
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,9 @@ package rest
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -240,3 +243,54 @@ func TestGetApplication_WithoutAuthorization(t *testing.T) {
 		t.Error("Expected error with invalid credentials, got nil")
 	}
 }
+
+func TestSearchApplications_SendsFilters(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_embedded": {"applications": [{"guid": "app-guid", "profile": {"name": "MyApp"}}]}, "page": {"number": 1, "size": 10, "total_elements": 11, "total_pages": 2}}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	modifiedAfter := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	resp, err := client.SearchApplications(context.Background(), ApplicationsRequest{
+		Name:             "My",
+		Team:             "Payments",
+		PolicyCompliance: "DID_NOT_PASS",
+		ScanStatus:       []string{"PUBLISHED", "IN_PROGRESS"},
+		ModifiedAfter:    &modifiedAfter,
+		CustomFieldNames: []string{"Owner"},
+		Page:             1,
+		Size:             10,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.Embedded.Applications) != 1 {
+		t.Fatalf("Expected one application, got %+v", resp.Embedded)
+	}
+
+	expected := map[string]string{
+		"name":               "My",
+		"team":               "Payments",
+		"policy_compliance":  "DID_NOT_PASS",
+		"modified_after":     "2026-03-01",
+		"custom_field_names": "Owner",
+		"page":               "1",
+		"size":               "10",
+	}
+	for param, want := range expected {
+		if got := query[param]; len(got) != 1 || got[0] != want {
+			t.Errorf("Expected %s=%s, got %v", param, want, got)
+		}
+	}
+	if got := query["scan_status"]; len(got) != 2 {
+		t.Errorf("Expected both scan statuses, got %v", got)
+	}
+	if _, ok := query["business_unit"]; ok {
+		t.Error("Expected unset filters to be omitted")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	applications "github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	dynamic_analysis "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_analysis"
 	dynamic_flaw "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_flaw"
 	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
 	healthcheck "github.com/dipsylala/veracode-mcp/api/rest/generated/healthcheck"
@@ -24,19 +25,20 @@ type Client struct {
 	staticFindingDataPathClient *static_finding_data_path.APIClient
 	applicationsClient          *applications.APIClient
 	policyClient                *policy.APIClient
-	apiID                       string
-	apiKey                      string
+	dynamicAnalysisClient       *dynamic_analysis.APIClient
+	creds                       credentials.Provider
 	baseURL                     string
 }
 
 // hmacTransport is an HTTP RoundTripper that adds Veracode HMAC authentication to every request
 type hmacTransport struct {
-	apiID     string
-	apiKey    string
+	creds     credentials.Provider
 	transport http.RoundTripper
 }
 
-// RoundTrip implements the http.RoundTripper interface, adding HMAC authentication to each request
+// RoundTrip implements the http.RoundTripper interface, adding HMAC authentication to each request.
+// If the API responds 401 the credentials are invalidated and the request is retried once,
+// which lets a credential-process supply fresh credentials.
 func (t *hmacTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Veracode's API expects query parameters to use %20 for spaces, not +
 	// Go's url.Values.Encode() uses + for spaces (per application/x-www-form-urlencoded spec)
@@ -46,29 +48,67 @@ func (t *hmacTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		req.URL.RawQuery = normalizedQuery
 	}
 
+	// Use the wrapped transport (or default) to execute the request
+	transport := t.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, apiID, err := t.signAndSend(transport, req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Only retry when the body can be replayed and the provider hands back different credentials
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	t.creds.Invalidate()
+	newID, _, err := t.creds.Retrieve(req.Context())
+	if err != nil || newID == apiID {
+		return resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	resp.Body.Close()
+
+	resp, _, err = t.signAndSend(transport, retry)
+	return resp, err
+}
+
+// signAndSend adds the HMAC Authorization header using the current credentials and executes the request.
+// It returns the API key ID used so callers can tell whether a refresh produced new credentials.
+func (t *hmacTransport) signAndSend(transport http.RoundTripper, req *http.Request) (*http.Response, string, error) {
+	apiID, apiKey, err := t.creds.Retrieve(req.Context())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve API credentials: %w", err)
+	}
+
 	// Calculate the HMAC Authorization header
-	authHeader, err := veracodehmac.CalculateAuthorizationHeader(req.URL, req.Method, t.apiID, t.apiKey)
+	authHeader, err := veracodehmac.CalculateAuthorizationHeader(req.URL, req.Method, apiID, apiKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate HMAC authorization: %w", err)
+		return nil, "", fmt.Errorf("failed to calculate HMAC authorization: %w", err)
 	}
 
 	// Add the Authorization header to the request
 	req.Header.Set("Authorization", authHeader)
 
-	// Use the wrapped transport (or default) to execute the request
-	transport := t.transport
-	if transport == nil {
-		transport = http.DefaultTransport
-	}
-	return transport.RoundTrip(req)
+	resp, err := transport.RoundTrip(req)
+	return resp, apiID, err
 }
 
 // newHMACHTTPClient creates an HTTP client that automatically adds HMAC authentication to all requests
-func newHMACHTTPClient(apiID, apiKey string) *http.Client {
+func newHMACHTTPClient(creds credentials.Provider) *http.Client {
 	return &http.Client{
 		Transport: &hmacTransport{
-			apiID:     apiID,
-			apiKey:    apiKey,
+			creds:     creds,
 			transport: http.DefaultTransport,
 		},
 	}
@@ -76,74 +116,44 @@ func newHMACHTTPClient(apiID, apiKey string) *http.Client {
 
 // NewClient creates a new Veracode API client
 // Credentials are loaded from:
-// 1. ~/.veracode/veracode.yml (preferred)
-// 2. Environment variables VERACODE_API_ID and VERACODE_API_KEY (fallback)
+// 1. ~/.veracode/veracode.yml (preferred), either key-id/key-secret or credential-process
+// 2. Environment variables VERACODE_API_ID and VERACODE_API_KEY, or VERACODE_CREDENTIAL_PROCESS (fallback)
 // Base URL is auto-detected from API key ID prefix (vera01ei-* → EU, otherwise → US)
 // Can be overridden via override-api-base-url in veracode.yml or VERACODE_OVERRIDE_API_BASE_URL environment variable
 func NewClient() (*Client, error) {
-	apiID, apiKey, baseURL, err := credentials.GetCredentials()
+	return NewClientForProfile("")
+}
+
+// NewClientForProfile creates a client with the credentials of a named profile of veracode.yml.
+// An empty profile uses the default credentials, like NewClient.
+func NewClientForProfile(profile string) (*Client, error) {
+	creds, baseURL, err := credentials.GetProfileCredentialProvider(profile)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create HTTP client with HMAC authentication
-	httpClient := newHMACHTTPClient(apiID, apiKey)
-
-	// Configure all API clients to use the HMAC-authenticated HTTP client and base URL
-	healthcheckCfg := healthcheck.NewConfiguration()
-	healthcheckCfg.HTTPClient = httpClient
-	healthcheckCfg.Servers[0].URL = baseURL
-
-	findingsCfg := findings.NewConfiguration()
-	findingsCfg.HTTPClient = httpClient
-	findingsCfg.Servers[0].URL = baseURL
-
-	dynamicFlawCfg := dynamic_flaw.NewConfiguration()
-	dynamicFlawCfg.HTTPClient = httpClient
-	dynamicFlawCfg.Servers[0].URL = baseURL
-
-	staticFindingDataPathCfg := static_finding_data_path.NewConfiguration()
-	staticFindingDataPathCfg.HTTPClient = httpClient
-	staticFindingDataPathCfg.Servers[0].URL = baseURL
-
-	applicationsCfg := applications.NewConfiguration()
-	applicationsCfg.HTTPClient = httpClient
-	applicationsCfg.Servers[0].URL = baseURL
-
-	policyCfg := policy.NewConfiguration()
-	policyCfg.HTTPClient = httpClient
-	policyCfg.Servers[0].URL = baseURL
-
-	return &Client{
-		healthcheckClient:           healthcheck.NewAPIClient(healthcheckCfg),
-		findingsClient:              findings.NewAPIClient(findingsCfg),
-		dynamicFlawClient:           dynamic_flaw.NewAPIClient(dynamicFlawCfg),
-		staticFindingDataPathClient: static_finding_data_path.NewAPIClient(staticFindingDataPathCfg),
-		applicationsClient:          applications.NewAPIClient(applicationsCfg),
-		policyClient:                policy.NewAPIClient(policyCfg),
-		apiID:                       apiID,
-		apiKey:                      apiKey,
-		baseURL:                     baseURL,
-	}, nil
+	return newClientWithProvider(creds, baseURL), nil
 }
 
 // NewClientUnconfigured creates a client without checking credentials
 // Useful for testing or when credentials will be set later
 func NewClientUnconfigured() *Client {
-	apiID, apiKey, baseURL, err := credentials.GetCredentials()
-
-	// Create HTTP client with HMAC authentication if credentials are available
-	var httpClient *http.Client
-	if err == nil && apiID != "" && apiKey != "" {
-		httpClient = newHMACHTTPClient(apiID, apiKey)
-	} else {
-		httpClient = http.DefaultClient
-		apiID = ""
-		apiKey = ""
-		baseURL = credentials.DefaultBaseURL
+	creds, baseURL, err := credentials.GetCredentialProvider()
+	if err != nil {
+		return newClientWithProvider(nil, credentials.DefaultBaseURL)
+	}
+	return newClientWithProvider(creds, baseURL)
+}
+
+// newClientWithProvider configures all generated API clients against baseURL.
+// Requests are HMAC-signed with creds; a nil provider yields an unconfigured client.
+func newClientWithProvider(creds credentials.Provider, baseURL string) *Client {
+	httpClient := http.DefaultClient
+	if creds != nil {
+		httpClient = newHMACHTTPClient(creds)
 	}
 
-	// Configure all API clients
+	// Configure all API clients to use the HTTP client and base URL
 	healthcheckCfg := healthcheck.NewConfiguration()
 	healthcheckCfg.HTTPClient = httpClient
 	healthcheckCfg.Servers[0].URL = baseURL
@@ -164,9 +174,13 @@ func NewClientUnconfigured() *Client {
 	applicationsCfg.HTTPClient = httpClient
 	applicationsCfg.Servers[0].URL = baseURL
 
-	policyCfg2 := policy.NewConfiguration()
-	policyCfg2.HTTPClient = httpClient
-	policyCfg2.Servers[0].URL = baseURL
+	policyCfg := policy.NewConfiguration()
+	policyCfg.HTTPClient = httpClient
+	policyCfg.Servers[0].URL = baseURL
+
+	dynamicAnalysisCfg := dynamic_analysis.NewConfiguration()
+	dynamicAnalysisCfg.HTTPClient = httpClient
+	dynamicAnalysisCfg.Servers[0].URL = baseURL
 
 	return &Client{
 		healthcheckClient:           healthcheck.NewAPIClient(healthcheckCfg),
@@ -174,16 +188,16 @@ func NewClientUnconfigured() *Client {
 		dynamicFlawClient:           dynamic_flaw.NewAPIClient(dynamicFlawCfg),
 		staticFindingDataPathClient: static_finding_data_path.NewAPIClient(staticFindingDataPathCfg),
 		applicationsClient:          applications.NewAPIClient(applicationsCfg),
-		policyClient:                policy.NewAPIClient(policyCfg2),
-		apiID:                       apiID,
-		apiKey:                      apiKey,
+		policyClient:                policy.NewAPIClient(policyCfg),
+		dynamicAnalysisClient:       dynamic_analysis.NewAPIClient(dynamicAnalysisCfg),
+		creds:                       creds,
 		baseURL:                     baseURL,
 	}
 }
 
 // IsConfigured returns true if API credentials are set
 func (c *Client) IsConfigured() bool {
-	return c.apiID != "" && c.apiKey != ""
+	return c.creds != nil
 }
 
 // GetAuthContext returns a context with Veracode API authentication
@@ -216,7 +230,7 @@ func (c *Client) RawGet(ctx context.Context, endpoint string) (string, error) {
 	}
 
 	// Create HTTP client with HMAC authentication
-	httpClient := newHMACHTTPClient(c.apiID, c.apiKey)
+	httpClient := newHMACHTTPClient(c.creds)
 
 	// Build the full URL
 	fullURL := c.baseURL + endpoint
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// rotatingProvider hands out a new key pair each time it is invalidated
+type rotatingProvider struct {
+	keys        []string
+	current     int
+	invalidated int
+}
+
+func (p *rotatingProvider) Retrieve(ctx context.Context) (string, string, error) {
+	return p.keys[p.current], "0123456789abcdef", nil
+}
+
+func (p *rotatingProvider) Invalidate() {
+	p.invalidated++
+	if p.current < len(p.keys)-1 {
+		p.current++
+	}
+}
+
+func TestHMACTransport_RetriesWithRefreshedCredentialsOn401(t *testing.T) {
+	var seenAuth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		seenAuth = append(seenAuth, auth)
+		if len(seenAuth) == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	provider := &rotatingProvider{keys: []string{"expired-id", "fresh-id"}}
+	client := newHMACHTTPClient(provider)
+
+	resp, err := client.Get(server.URL + "/healthcheck/status")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200 after retry, got %d", resp.StatusCode)
+	}
+	if provider.invalidated != 1 {
+		t.Errorf("Expected credentials to be invalidated once, got %d", provider.invalidated)
+	}
+	if len(seenAuth) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(seenAuth))
+	}
+}
+
+func TestHMACTransport_NoRetryWhenCredentialsUnchanged(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	provider := &rotatingProvider{keys: []string{"static-id"}}
+	client := newHMACHTTPClient(provider)
+
+	resp, err := client.Get(server.URL + "/healthcheck/status")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected 401 to be returned, got %d", resp.StatusCode)
+	}
+	if requests != 1 {
+		t.Errorf("Expected a single request when credentials are unchanged, got %d", requests)
+	}
+}
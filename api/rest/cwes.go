@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
+)
+
+// cwePageSize is the page size used when listing CWEs and finding categories
+const cwePageSize = 500
+
+// GetCWE retrieves Veracode's description of a single CWE
+func (c *Client) GetCWE(ctx context.Context, cweID int32) (*findings.CweDetail, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+	resp, httpResp, err := c.findingsClient.CWEAPIInformationAPI.GetCweUsingGET(authCtx, cweID).Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d for CWE-%d: %w", httpResp.StatusCode, cweID, err)
+		}
+		return nil, fmt.Errorf("failed to get CWE-%d: %w", cweID, err)
+	}
+
+	return resp, nil
+}
+
+// ListCWEs retrieves every CWE Veracode reports on
+func (c *Client) ListCWEs(ctx context.Context) ([]findings.CweDetail, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var cwes []findings.CweDetail
+	for page := int32(0); ; page++ {
+		resp, err := c.getCWEsPage(authCtx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Embedded != nil {
+			cwes = append(cwes, resp.Embedded.Cwes...)
+		}
+
+		if resp.Page == nil || resp.Page.TotalPages == nil || int64(page)+1 >= *resp.Page.TotalPages {
+			return cwes, nil
+		}
+	}
+}
+
+// getCWEsPage retrieves one page of CWEs
+func (c *Client) getCWEsPage(authCtx context.Context, page int32) (*findings.PagedResourceOfCwe, error) {
+	resp, httpResp, err := c.findingsClient.CWEAPIInformationAPI.GetCwesUsingGET(authCtx).
+		Page(page).
+		Size(cwePageSize).
+		Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to list CWEs: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListFindingCategories retrieves every Veracode finding category
+func (c *Client) ListFindingCategories(ctx context.Context) ([]findings.Category, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var categories []findings.Category
+	for page := int32(0); ; page++ {
+		resp, err := c.getFindingCategoriesPage(authCtx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Embedded != nil {
+			categories = append(categories, resp.Embedded.Categories...)
+		}
+
+		if resp.Page == nil || resp.Page.TotalPages == nil || int64(page)+1 >= *resp.Page.TotalPages {
+			return categories, nil
+		}
+	}
+}
+
+// getFindingCategoriesPage retrieves one page of finding categories
+func (c *Client) getFindingCategoriesPage(authCtx context.Context, page int32) (*findings.PagedResourceOfCategory, error) {
+	resp, httpResp, err := c.findingsClient.CWEAPIInformationAPI.GetCategoriesUsingGET(authCtx).
+		Page(page).
+		Size(cwePageSize).
+		Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to list finding categories: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newCWETestServer serves a single CWE and two pages of finding categories
+func newCWETestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/appsec/v1/cwes/89":
+			fmt.Fprint(w, `{"id": 89, "name": "SQL Injection", "remediation_effort": 2, "recommendation": "Use parameterized queries", "references": [{"name": "CWE", "url": "https://cwe.mitre.org/data/definitions/89.html"}]}`)
+		case "/appsec/v1/categories":
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			names := []string{"SQL Injection", "Cross-Site Scripting"}
+			fmt.Fprintf(w, `{"_embedded": {"categories": [{"id": %d, "name": %q}]}, "page": {"number": %d, "size": 1, "total_elements": 2, "total_pages": 2}}`,
+				page+19, names[page], page)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetCWE(t *testing.T) {
+	client := newTestClient(newCWETestServer(t).URL)
+
+	cwe, err := client.GetCWE(context.Background(), 89)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cwe.GetName() != "SQL Injection" || cwe.GetRemediationEffort() != 2 || len(cwe.References) != 1 {
+		t.Errorf("Unexpected CWE: %+v", cwe)
+	}
+
+	if _, err := client.GetCWE(context.Background(), 12345); err == nil {
+		t.Error("Expected error for an unknown CWE")
+	}
+}
+
+func TestListFindingCategories(t *testing.T) {
+	client := newTestClient(newCWETestServer(t).URL)
+
+	categories, err := client.ListFindingCategories(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(categories) != 2 || categories[1].GetName() != "Cross-Site Scripting" {
+		t.Errorf("Expected categories from both pages, got %+v", categories)
+	}
+}
@@ -0,0 +1,234 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	dynamic_analysis "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_analysis"
+)
+
+// dynamicAnalysisPageSize is the page size used when listing Dynamic Analysis resources
+const dynamicAnalysisPageSize = 100
+
+// dynamicAnalysisStartDuration is how long a Dynamic Analysis started on demand may run, in days
+const dynamicAnalysisStartDuration = 1
+
+// ListDynamicAnalyses retrieves the Dynamic Analyses configured in the account, filtered on the server by name if one is given
+func (c *Client) ListDynamicAnalyses(ctx context.Context, name string) ([]dynamic_analysis.Analysis, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var analyses []dynamic_analysis.Analysis
+	for page := int32(0); ; page++ {
+		request := c.dynamicAnalysisClient.AnalysesAPI.GetAnalysesUsingGET(authCtx).
+			Page(page).
+			Size(dynamicAnalysisPageSize)
+		if name != "" {
+			request = request.Name(name)
+		}
+		resp, httpResp, err := request.Execute()
+		closeResponseBody(httpResp)
+		if err != nil {
+			return nil, dynamicAnalysisError("list dynamic analyses", httpResp, err)
+		}
+
+		if resp.Embedded != nil {
+			analyses = append(analyses, resp.Embedded.Analyses...)
+		}
+
+		if isLastDynamicAnalysisPage(resp.Page, page) {
+			return analyses, nil
+		}
+	}
+}
+
+// GetDynamicAnalysis retrieves a Dynamic Analysis by ID
+func (c *Client) GetDynamicAnalysis(ctx context.Context, analysisID string) (*dynamic_analysis.Analysis, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	resp, httpResp, err := c.dynamicAnalysisClient.AnalysesAPI.GetAnalysisUsingGET(authCtx, analysisID).Execute()
+	closeResponseBody(httpResp)
+	if err != nil {
+		return nil, dynamicAnalysisError("get dynamic analysis", httpResp, err)
+	}
+
+	return resp, nil
+}
+
+// ListDynamicAnalysisScans retrieves the scans (target URLs) configured in a Dynamic Analysis
+func (c *Client) ListDynamicAnalysisScans(ctx context.Context, analysisID string) ([]dynamic_analysis.Scan, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var scans []dynamic_analysis.Scan
+	for page := int32(0); ; page++ {
+		resp, httpResp, err := c.dynamicAnalysisClient.AnalysesAPI.GetAnalysisScansUsingGET(authCtx, analysisID).
+			Page(page).
+			Size(dynamicAnalysisPageSize).
+			Execute()
+		closeResponseBody(httpResp)
+		if err != nil {
+			return nil, dynamicAnalysisError("list dynamic analysis scans", httpResp, err)
+		}
+
+		if resp.Embedded != nil {
+			scans = append(scans, resp.Embedded.Scans...)
+		}
+
+		if isLastDynamicAnalysisPage(resp.Page, page) {
+			return scans, nil
+		}
+	}
+}
+
+// ListAnalysisOccurrences retrieves the latest limit runs of a Dynamic Analysis, most recent first.
+// The API has no sort order, so every page is read and the runs are sorted by start date.
+func (c *Client) ListAnalysisOccurrences(ctx context.Context, analysisID string, limit int32) ([]dynamic_analysis.AnalysisOccurrence, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var occurrences []dynamic_analysis.AnalysisOccurrence
+	for page := int32(0); ; page++ {
+		resp, httpResp, err := c.dynamicAnalysisClient.AnalysisOccurrencesAPI.GetAnalysisOccurrencesUsingGET(authCtx).
+			AnalysisId(analysisID).
+			Page(page).
+			Size(dynamicAnalysisPageSize).
+			Execute()
+		closeResponseBody(httpResp)
+		if err != nil {
+			return nil, dynamicAnalysisError("list analysis occurrences", httpResp, err)
+		}
+
+		if resp.Embedded != nil {
+			occurrences = append(occurrences, resp.Embedded.AnalysisOccurrences...)
+		}
+
+		if isLastDynamicAnalysisPage(resp.Page, page) {
+			break
+		}
+	}
+
+	// The dates are ISO 8601, so they sort as strings
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].GetStartDate() > occurrences[j].GetStartDate()
+	})
+	if int32(len(occurrences)) > limit {
+		occurrences = occurrences[:limit]
+	}
+	return occurrences, nil
+}
+
+// ListScanOccurrences retrieves the per-URL scan runs of an analysis occurrence
+func (c *Client) ListScanOccurrences(ctx context.Context, analysisOccurrenceID string) ([]dynamic_analysis.ScanOccurrence, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var occurrences []dynamic_analysis.ScanOccurrence
+	for page := int32(0); ; page++ {
+		resp, httpResp, err := c.dynamicAnalysisClient.AnalysisOccurrencesAPI.GetScanOccurrencesUsingGET(authCtx, analysisOccurrenceID).
+			Page(page).
+			Size(dynamicAnalysisPageSize).
+			Execute()
+		closeResponseBody(httpResp)
+		if err != nil {
+			return nil, dynamicAnalysisError("list scan occurrences", httpResp, err)
+		}
+
+		if resp.Embedded != nil {
+			occurrences = append(occurrences, resp.Embedded.ScanOccurrences...)
+		}
+
+		if isLastDynamicAnalysisPage(resp.Page, page) {
+			return occurrences, nil
+		}
+	}
+}
+
+// GetVerificationReport retrieves the pre-scan verification (reachability and login) report of a scan occurrence
+func (c *Client) GetVerificationReport(ctx context.Context, scanOccurrenceID string) (*dynamic_analysis.VerificationReport, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	resp, httpResp, err := c.dynamicAnalysisClient.ScanOccurrencesAPI.GetVerificationReportUsingGET(authCtx, scanOccurrenceID).Execute()
+	closeResponseBody(httpResp)
+	if err != nil {
+		return nil, dynamicAnalysisError("get verification report", httpResp, err)
+	}
+
+	return resp, nil
+}
+
+// StartDynamicAnalysis schedules a configured Dynamic Analysis to start now, using its existing scan configuration
+func (c *Client) StartDynamicAnalysis(ctx context.Context, analysisID string) error {
+	if !c.IsConfigured() {
+		return fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	duration := dynamic_analysis.NewDuration()
+	duration.SetLength(dynamicAnalysisStartDuration)
+	duration.SetUnit("DAY")
+	schedule := dynamic_analysis.NewScheduleSetting()
+	schedule.SetNow(true)
+	schedule.SetDuration(*duration)
+	update := dynamic_analysis.NewAnalysisUpdate()
+	update.SetSchedule(*schedule)
+
+	// The API only applies the fields present in the body when method=PATCH
+	httpResp, err := c.dynamicAnalysisClient.AnalysesAPI.UpdateAnalysisUsingPUT(authCtx, analysisID).
+		Method("PATCH").
+		AnalysisUpdate(*update).
+		Execute()
+	closeResponseBody(httpResp)
+	if err != nil {
+		return dynamicAnalysisError("start dynamic analysis", httpResp, err)
+	}
+
+	return nil
+}
+
+// closeResponseBody closes a generated client's response body, which has already been read into memory
+func closeResponseBody(httpResp *http.Response) {
+	if httpResp == nil || httpResp.Body == nil {
+		return
+	}
+	if closeErr := httpResp.Body.Close(); closeErr != nil {
+		log.Printf("Failed to close response body: %v", closeErr)
+	}
+}
+
+// dynamicAnalysisError wraps a Dynamic Analysis API error with the HTTP status when one was received
+func dynamicAnalysisError(action string, httpResp *http.Response, err error) error {
+	if httpResp != nil {
+		return fmt.Errorf("failed to %s: API returned status %d: %w", action, httpResp.StatusCode, err)
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+// isLastDynamicAnalysisPage reports whether page is the final page described by the page metadata
+func isLastDynamicAnalysisPage(metadata *dynamic_analysis.PageMetadata, page int32) bool {
+	return metadata == nil || metadata.TotalPages == nil || page+1 >= *metadata.TotalPages
+}
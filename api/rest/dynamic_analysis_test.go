@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDynamicAnalysis(t *testing.T) {
+	var startBody map[string]interface{}
+	var startQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/was/configservice/v1/analyses":
+			if r.URL.Query().Get("page") == "0" {
+				fmt.Fprint(w, `{"_embedded": {"analyses": [{"analysis_id": "a1", "name": "Nightly"}]}, "page": {"number": 0, "total_pages": 2}}`)
+				return
+			}
+			fmt.Fprint(w, `{"_embedded": {"analyses": [{"analysis_id": "a2", "name": "Release"}]}, "page": {"number": 1, "total_pages": 2}}`)
+		case r.URL.Path == "/was/configservice/v1/analyses/a1/scans":
+			fmt.Fprint(w, `{"_embedded": {"scans": [{"scan_id": "s1", "target_url": "https://app.example.com", "linked_platform_app_uuid": "app-guid"}]}, "page": {"total_pages": 1}}`)
+		case r.URL.Path == "/was/configservice/v1/analysis_occurrences":
+			if r.URL.Query().Get("analysis_id") != "a1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			// The oldest runs come first, so the latest are on the last page
+			if r.URL.Query().Get("page") == "0" {
+				fmt.Fprint(w, `{"_embedded": {"analysis_occurrences": [{"analysis_occurrence_id": "o0", "start_date": "2026-08-01T00:00:00Z"}, {"analysis_occurrence_id": "o1", "start_date": "2026-09-01T00:00:00Z"}]}, "page": {"number": 0, "total_pages": 2}}`)
+				return
+			}
+			fmt.Fprint(w, `{"_embedded": {"analysis_occurrences": [{"analysis_occurrence_id": "o2", "start_date": "2026-10-01T00:00:00Z", "status": {"status_type": "FINISHED_RESULTS_AVAILABLE"}}]}, "page": {"number": 1, "total_pages": 2}}`)
+		case r.URL.Path == "/was/configservice/v1/analysis_occurrences/o1/scan_occurrences":
+			fmt.Fprint(w, `{"_embedded": {"scan_occurrences": [{"scan_occurrence_id": "so1", "count_of_flaws": 4}]}}`)
+		case r.URL.Path == "/was/configservice/v1/scan_occurrences/so1/verification_report":
+			fmt.Fprint(w, `{"scan_occurrence_id": "so1", "status": "FAILED", "messages": ["Login failed"]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/was/configservice/v1/analyses/a1":
+			startQuery = r.URL.RawQuery
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &startBody)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	ctx := context.Background()
+
+	analyses, err := client.ListDynamicAnalyses(ctx, "")
+	if err != nil {
+		t.Fatalf("ListDynamicAnalyses() error = %v", err)
+	}
+	if len(analyses) != 2 || analyses[1].GetName() != "Release" {
+		t.Errorf("expected both pages of analyses, got %+v", analyses)
+	}
+
+	scans, err := client.ListDynamicAnalysisScans(ctx, "a1")
+	if err != nil || len(scans) != 1 || scans[0].GetLinkedPlatformAppUuid() != "app-guid" {
+		t.Errorf("ListDynamicAnalysisScans() = %+v, %v", scans, err)
+	}
+
+	occurrences, err := client.ListAnalysisOccurrences(ctx, "a1", 2)
+	if err != nil || len(occurrences) != 2 || occurrences[0].GetAnalysisOccurrenceId() != "o2" || occurrences[1].GetAnalysisOccurrenceId() != "o1" {
+		t.Errorf("expected the two latest runs, most recent first, got %+v, %v", occurrences, err)
+	}
+	if occurrences[0].Status.GetStatusType() != "FINISHED_RESULTS_AVAILABLE" {
+		t.Errorf("unexpected status of the latest run: %+v", occurrences[0].Status)
+	}
+
+	if _, err := client.GetDynamicAnalysis(ctx, "missing"); err == nil || !strings.Contains(err.Error(), "failed to get dynamic analysis: API returned status 404") {
+		t.Errorf("expected the error to name the action and the status, got %v", err)
+	}
+
+	scanOccurrences, err := client.ListScanOccurrences(ctx, "o1")
+	if err != nil || len(scanOccurrences) != 1 || scanOccurrences[0].GetCountOfFlaws() != 4 {
+		t.Errorf("ListScanOccurrences() = %+v, %v", scanOccurrences, err)
+	}
+
+	report, err := client.GetVerificationReport(ctx, "so1")
+	if err != nil || report.GetStatus() != "FAILED" || len(report.Messages) != 1 {
+		t.Errorf("GetVerificationReport() = %+v, %v", report, err)
+	}
+
+	if err := client.StartDynamicAnalysis(ctx, "a1"); err != nil {
+		t.Fatalf("StartDynamicAnalysis() error = %v", err)
+	}
+	if startQuery != "method=PATCH" {
+		t.Errorf("expected method=PATCH, got %q", startQuery)
+	}
+	schedule, _ := startBody["schedule"].(map[string]interface{})
+	if schedule["now"] != true || schedule["duration"] == nil {
+		t.Errorf("unexpected start body: %v", startBody)
+	}
+
+	if err := client.StartDynamicAnalysis(ctx, "missing"); err == nil {
+		t.Error("expected error for an unknown analysis")
+	}
+}
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newScanTypeTestServer serves a single page of findings per scan type; missing scan types return 403
+func newScanTypeTestServer(t *testing.T, findingsByType map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scanType := r.URL.Query().Get("scan_type")
+		if r.URL.Query().Get("include_annot") != "true" {
+			t.Errorf("Expected include_annot=true for %s findings", scanType)
+		}
+
+		items, ok := findingsByType[scanType]
+		if !ok {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"_embedded": {"findings": [%s]}, "page": {"number": 0, "size": 500, "total_elements": 1, "total_pages": 1}}`, items)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetFindingByID_FindsDynamicFinding(t *testing.T) {
+	server := newScanTypeTestServer(t, map[string]string{
+		"STATIC": `{"issue_id": 1, "scan_type": "STATIC"}`,
+		"DYNAMIC": `{"issue_id": 42, "scan_type": "DYNAMIC", "build_id": 7, "annotations": [
+			{"action": "APPDESIGN", "comment": "Protected by WAF", "user_name": "jdoe", "created": "2025-01-02T03:04:05Z"}]}`,
+	})
+	client := newTestClient(server.URL)
+
+	finding, err := client.GetFindingByID(context.Background(), "app-guid", "", "42", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if finding.ScanType != "DYNAMIC" {
+		t.Errorf("Expected scan type DYNAMIC, got %q", finding.ScanType)
+	}
+	if finding.BuildID != 7 {
+		t.Errorf("Expected build ID 7, got %d", finding.BuildID)
+	}
+	if len(finding.Annotations) != 1 || finding.Annotations[0].Action != "APPDESIGN" {
+		t.Errorf("Expected the APPDESIGN annotation, got %+v", finding.Annotations)
+	}
+}
+
+func TestGetFindingByID_NotFound(t *testing.T) {
+	server := newScanTypeTestServer(t, map[string]string{
+		"STATIC": `{"issue_id": 1, "scan_type": "STATIC"}`,
+		"SCA":    `{"issue_id": 2, "scan_type": "SCA"}`,
+	})
+	client := newTestClient(server.URL)
+
+	_, err := client.GetFindingByID(context.Background(), "app-guid", "", "99", "")
+	if !errors.Is(err, ErrFindingNotFound) {
+		t.Fatalf("Expected ErrFindingNotFound, got %v", err)
+	}
+}
+
+func TestGetFindingByID_InvalidID(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+
+	if _, err := client.GetFindingByID(context.Background(), "app-guid", "", "abc-123", ""); err == nil {
+		t.Error("Expected error for non-numeric finding ID")
+	}
+}
+
+func TestGetFindingByID_SearchesOnlyRequestedScanType(t *testing.T) {
+	var queried []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queried = append(queried, r.URL.Query().Get("scan_type"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_embedded": {"findings": [{"issue_id": 42, "scan_type": "MANUAL"}]}, "page": {"number": 0, "size": 500, "total_elements": 1, "total_pages": 1}}`)
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(server.URL)
+
+	finding, err := client.GetFindingByID(context.Background(), "app-guid", "", "42", "MANUAL")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if finding.ScanType != "MANUAL" {
+		t.Errorf("Expected scan type MANUAL, got %q", finding.ScanType)
+	}
+	if len(queried) != 1 || queried[0] != "MANUAL" {
+		t.Errorf("Expected only MANUAL findings to be queried, got %v", queried)
+	}
+
+	if _, err := client.GetFindingByID(context.Background(), "app-guid", "", "42", "SAST"); err == nil {
+		t.Error("Expected error for an unknown scan type")
+	}
+}
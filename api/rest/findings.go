@@ -1,24 +1,24 @@
 package rest
 
-// NOTE ON POLYMORPHIC UNMARSHALING WORKAROUND:
-// The generated OpenAPI client has issues with FindingFindingDetails (oneOf: StaticFinding, DynamicFinding, ManualFinding, ScaFinding).
-// The UnmarshalJSON tries each type and accepts the first where the marshaled result != "{}".
-// This causes frequent misidentification:
-//   - Dynamic findings data is often unmarshaled into ScaFinding (most common case)
-//   - Static findings data can sometimes go into DynamicFinding
-//
-// SOLUTION:
-// We use the scan_type field from the parent Finding object to determine the correct extraction path,
-// and implement fallback extraction functions that can extract common fields (CWE, Severity) from
-// any variant type, even when the data is in the wrong variant.
-//
-// This is not ideal but necessary until the OpenAPI generator is fixed or we implement custom unmarshaling.
+// NOTE ON POLYMORPHIC FINDING DETAILS:
+// finding_details is a oneOf (StaticFinding, DynamicFinding, ManualFinding, ScaFinding) with no
+// discriminator inside the object itself. The generated FindingFindingDetails.UnmarshalJSON guesses
+// the variant from which fields are present, which misidentifies findings (e.g. dynamic findings
+// decoded as ScaFinding). Instead we decode the response body ourselves (see findings_decode.go)
+// and use the parent Finding's scan_type to pick the variant, so each finding is decoded exactly once
+// into the right type. Fields the variant doesn't declare are kept in Finding.AdditionalInfo.
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
 )
@@ -80,13 +80,53 @@ func buildFindingsAPIRequest(client *Client, ctx context.Context, req FindingsRe
 		apiReq = apiReq.ViolatesPolicy(*req.ViolatesPolicy)
 	}
 
+	// Scope to a sandbox; without a context the API returns policy scan findings
+	if req.Sandbox != "" {
+		apiReq = apiReq.Context(req.Sandbox)
+	}
+
+	// Include annotations (mitigations and comments) if requested
+	if req.IncludeAnnot {
+		apiReq = apiReq.IncludeAnnot(true)
+	}
+
+	// Add the remaining optional filters
+	if len(req.FindingCategory) > 0 {
+		apiReq = apiReq.FindingCategory(req.FindingCategory)
+	}
+	if req.MitigatedAfter != nil {
+		apiReq = apiReq.MitigatedAfter(*req.MitigatedAfter)
+	}
+	if req.New {
+		apiReq = apiReq.New(true)
+	}
+	if req.IncludeExpDate {
+		apiReq = apiReq.IncludeExpDate(true)
+	}
+	if req.CVE != "" {
+		apiReq = apiReq.Cve(req.CVE)
+	}
+	if req.CVSS != nil {
+		apiReq = apiReq.Cvss(*req.CVSS)
+	}
+	if req.CVSSGte != nil {
+		apiReq = apiReq.CvssGte(*req.CVSSGte)
+	}
+	if req.ScaDepMode != "" {
+		apiReq = apiReq.ScaDepMode(req.ScaDepMode)
+	}
+	if req.ScaScanMode != "" {
+		apiReq = apiReq.ScaScanMode(req.ScaScanMode)
+	}
+
 	return apiReq
 }
 
 // executeFindingsRequest executes the API request and handles response
 func executeFindingsRequest(apiReq findings.ApiGetFindingsUsingGETRequest, req FindingsRequest, scanType string) (*FindingsResponse, error) {
-	// Call the Findings API
-	resp, httpResp, err := apiReq.Execute()
+	// Call the Findings API. The generated client's decoded result is ignored: its oneOf
+	// handling of finding_details is unreliable, so the body is decoded below instead.
+	_, httpResp, err := apiReq.Execute()
 	if httpResp != nil && httpResp.Body != nil {
 		defer func() {
 			if closeErr := httpResp.Body.Close(); closeErr != nil {
@@ -95,15 +135,26 @@ func executeFindingsRequest(apiReq findings.ApiGetFindingsUsingGETRequest, req F
 		}()
 	}
 
-	if err != nil {
-		if httpResp != nil {
-			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
-		}
+	if httpResp == nil {
 		return nil, fmt.Errorf("failed to get %s findings: %w", scanType, err)
 	}
+	// A 2xx error only means the generated decoder rejected the body, which we decode ourselves
+	if err != nil && httpResp.StatusCode >= 300 {
+		return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s findings response: %w", scanType, err)
+	}
+
+	resp, err := decodeFindingsPage(body)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if response is valid
-	if resp == nil || resp.Embedded == nil {
+	if resp.Embedded == nil {
 		return &FindingsResponse{
 			Findings:   []Finding{},
 			TotalCount: 0,
@@ -118,65 +169,103 @@ func executeFindingsRequest(apiReq findings.ApiGetFindingsUsingGETRequest, req F
 	// Apply post-response filters
 	filteredFindings := applyFilters(convertedFindings, req)
 
-	// Get total count from pagination metadata
+	// Get total count and page count from pagination metadata
 	totalCount := 0
+	totalPages := 0
 	if resp.Page != nil && resp.Page.TotalElements != nil {
 		totalCount = safeInt64ToInt(*resp.Page.TotalElements)
 	}
+	if resp.Page != nil && resp.Page.TotalPages != nil {
+		totalPages = safeInt64ToInt(*resp.Page.TotalPages)
+	}
 
 	return &FindingsResponse{
 		Findings:   filteredFindings,
 		TotalCount: totalCount,
+		TotalPages: totalPages,
 		Page:       req.Page,
 		Size:       req.Size,
 	}, nil
 }
 
-// GetDynamicFindings retrieves DAST (Dynamic Analysis) findings
-func (c *Client) GetDynamicFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
+// findingScanTypes lists the scan types GetFindingByID searches, most common first
+var findingScanTypes = []string{"STATIC", "DYNAMIC", "MANUAL", "SCA"}
+
+// ErrFindingNotFound is returned by GetFindingByID when no scan type contains the finding
+var ErrFindingNotFound = errors.New("finding not found")
+
+// getFindings retrieves one page of findings of the given scan type
+func (c *Client) getFindings(ctx context.Context, req FindingsRequest, scanType string) (*FindingsResponse, error) {
 	if !c.IsConfigured() {
 		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
 	}
 
 	authCtx := c.GetAuthContext(ctx)
-	apiReq := buildFindingsAPIRequest(c, authCtx, req, "DYNAMIC")
-	return executeFindingsRequest(apiReq, req, "DYNAMIC")
+	apiReq := buildFindingsAPIRequest(c, authCtx, req, scanType)
+	return executeFindingsRequest(apiReq, req, scanType)
+}
+
+// GetDynamicFindings retrieves DAST (Dynamic Analysis) findings
+func (c *Client) GetDynamicFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
+	return c.getFindings(ctx, req, "DYNAMIC")
 }
 
 // GetStaticFindings retrieves SAST (Static Analysis) findings
 func (c *Client) GetStaticFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
-	if !c.IsConfigured() {
-		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
-	}
-
-	authCtx := c.GetAuthContext(ctx)
-	apiReq := buildFindingsAPIRequest(c, authCtx, req, "STATIC")
-	return executeFindingsRequest(apiReq, req, "STATIC")
+	return c.getFindings(ctx, req, "STATIC")
 }
 
 // GetScaFindings retrieves SCA findings for an application
 func (c *Client) GetScaFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
-	if !c.IsConfigured() {
-		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
-	}
-
-	authCtx := c.GetAuthContext(ctx)
-	apiReq := buildFindingsAPIRequest(c, authCtx, req, "SCA")
-	return executeFindingsRequest(apiReq, req, "SCA")
+	return c.getFindings(ctx, req, "SCA")
 }
 
-// GetFindingByID retrieves a specific finding by ID
-func (c *Client) GetFindingByID(ctx context.Context, findingID string, isDynamic bool) (*Finding, error) {
+// GetFindingByID finds an issue by ID in the findings of scanType, or across static, dynamic,
+// manual and SCA findings when scanType is empty.
+// The Findings API has no issue_id filter, so each scan type is walked page by page
+// (annotations included, no policy filter) and the walk stops at the first match.
+// The returned Finding carries its ScanType so callers can dispatch without probing other APIs.
+// If sandboxGUID is set the sandbox's findings are searched instead of the policy scan's.
+func (c *Client) GetFindingByID(ctx context.Context, applicationGUID, sandboxGUID, findingID, scanType string) (*Finding, error) {
 	if !c.IsConfigured() {
 		return nil, fmt.Errorf("API credentials not configured")
 	}
 
-	// TODO: Implement actual API call
-	return nil, fmt.Errorf("not implemented")
+	if _, err := strconv.ParseInt(findingID, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid finding ID %q: must be numeric", findingID)
+	}
+
+	scanTypes := findingScanTypes
+	if scanType != "" {
+		if !slices.Contains(findingScanTypes, scanType) {
+			return nil, fmt.Errorf("invalid scan type %q: must be one of %s", scanType, strings.Join(findingScanTypes, ", "))
+		}
+		scanTypes = []string{scanType}
+	}
+
+	// A scan type the application has no entitlement for returns an error; keep looking in the others
+	var searchErrs []error
+	req := FindingsRequest{AppProfile: applicationGUID, Sandbox: sandboxGUID, IncludeAnnot: true}
+	for _, scanType := range scanTypes {
+		for finding, err := range c.IterateFindings(ctx, req, scanType) {
+			if err != nil {
+				searchErrs = append(searchErrs, fmt.Errorf("%s: %w", scanType, err))
+				break
+			}
+			if finding.ID == findingID {
+				return &finding, nil
+			}
+		}
+	}
+
+	if len(searchErrs) > 0 {
+		return nil, fmt.Errorf("%w: issue %s in application %s (%w)", ErrFindingNotFound, findingID, applicationGUID, errors.Join(searchErrs...))
+	}
+	return nil, fmt.Errorf("%w: issue %s in application %s", ErrFindingNotFound, findingID, applicationGUID)
 }
 
-// convertFindings converts the generated API findings to our Finding type
-func convertFindings(apiFindings []findings.Finding, scanType string) []Finding {
+// convertFindings converts the decoded API findings to our Finding type
+func convertFindings(apiFindings []rawFinding, scanType string) []Finding {
 	result := make([]Finding, 0, len(apiFindings))
 
 	for _, apiFinding := range apiFindings {
@@ -187,49 +276,42 @@ func convertFindings(apiFindings []findings.Finding, scanType string) []Finding
 	return result
 }
 
-// convertSingleFinding converts a single API finding to our Finding type
-func convertSingleFinding(apiFinding findings.Finding, scanType string) Finding {
+// convertSingleFinding converts a single API finding to our Finding type.
+// The finding's own scan_type selects the finding_details variant; the requested
+// scan type is used if the API omits it.
+func convertSingleFinding(apiFinding rawFinding, scanType string) Finding {
 	finding := Finding{}
 
 	// Extract basic fields
-	extractBasicFields(&finding, &apiFinding)
-
-	// The generated client's polymorphic unmarshaling is unreliable.
-	// Use scan_type to determine which variant to extract from FindingDetails.
-	if apiFinding.FindingDetails != nil {
-		switch scanType {
-		case "STATIC":
-			// For static findings, try StaticFinding first, then DynamicFinding as fallback
-			// (due to broken unmarshaling that sometimes puts data in wrong field)
-			if apiFinding.FindingDetails.StaticFinding != nil {
-				extractStaticFindingDetails(&finding, apiFinding.FindingDetails.StaticFinding)
-			} else if apiFinding.FindingDetails.DynamicFinding != nil {
-				extractStaticFromDynamic(&finding, apiFinding.FindingDetails.DynamicFinding)
-			}
-		case "DYNAMIC":
-			// For dynamic findings, try DynamicFinding first, then fall back to ScaFinding or StaticFinding
-			// NOTE: The generated client frequently misidentifies dynamic findings as ScaFinding
-			if apiFinding.FindingDetails.DynamicFinding != nil {
-				extractDynamicFindingDetails(&finding, apiFinding.FindingDetails.DynamicFinding)
-			} else if apiFinding.FindingDetails.ScaFinding != nil {
-				// Common case: UnmarshalJSON puts dynamic data in ScaFinding
-				extractDynamicFromSca(&finding, apiFinding.FindingDetails.ScaFinding)
-			} else if apiFinding.FindingDetails.StaticFinding != nil {
-				extractDynamicFromMismarshaled(&finding, apiFinding.FindingDetails.StaticFinding)
-			}
-		case "SCA":
-			// For SCA findings, try ScaFinding first, then fall back to other types
-			if apiFinding.FindingDetails.ScaFinding != nil {
-				extractScaFindingDetails(&finding, apiFinding.FindingDetails.ScaFinding)
-			} else if apiFinding.FindingDetails.StaticFinding != nil {
-				// Fallback: extract common fields from StaticFinding
-				extractStaticFindingDetails(&finding, apiFinding.FindingDetails.StaticFinding)
-			} else if apiFinding.FindingDetails.DynamicFinding != nil {
-				// Fallback: extract common fields from DynamicFinding
-				extractDynamicFindingDetails(&finding, apiFinding.FindingDetails.DynamicFinding)
-			}
-		}
+	extractBasicFields(&finding, &apiFinding.Finding)
+
+	discriminator := scanType
+	if finding.ScanType != "" {
+		discriminator = finding.ScanType
+	}
+
+	details, err := decodeFindingDetails(discriminator, apiFinding.FindingDetails)
+	if err != nil {
+		// Keep the finding, flagged, so callers don't mistake its missing details for real values
+		log.Printf("Finding %s: %v", finding.ID, err)
+		finding.DetailsError = err.Error()
+		return finding
 	}
+	if details == nil {
+		return finding
+	}
+
+	switch {
+	case details.Static != nil:
+		extractStaticFindingDetails(&finding, details.Static)
+	case details.Dynamic != nil:
+		extractDynamicFindingDetails(&finding, details.Dynamic)
+	case details.Manual != nil:
+		extractManualFindingDetails(&finding, details.Manual)
+	case details.Sca != nil:
+		extractScaFindingDetails(&finding, details.Sca)
+	}
+	finding.AdditionalInfo = details.Unknown
 
 	return finding
 }
@@ -239,6 +321,9 @@ func extractBasicFields(finding *Finding, apiFinding *findings.Finding) {
 	if apiFinding.IssueId != nil {
 		finding.ID = fmt.Sprintf("%d", *apiFinding.IssueId)
 	}
+	if apiFinding.ScanType != nil {
+		finding.ScanType = *apiFinding.ScanType
+	}
 	if apiFinding.BuildId != nil {
 		finding.BuildID = *apiFinding.BuildId
 	}
@@ -258,6 +343,36 @@ func extractBasicFields(finding *Finding, apiFinding *findings.Finding) {
 			finding.ResolutionStatus = *apiFinding.FindingStatus.ResolutionStatus
 		}
 	}
+
+	// Grace period expiry (only present when include_exp_date was requested)
+	if apiFinding.GracePeriodExpiresDate != nil {
+		finding.GracePeriodExpires = apiFinding.GracePeriodExpiresDate.Format(time.RFC3339)
+	}
+
+	// Extract annotations (only present when include_annot was requested)
+	if len(apiFinding.Annotations) > 0 {
+		finding.Annotations = make([]Annotation, 0, len(apiFinding.Annotations))
+		for _, apiAnnotation := range apiFinding.Annotations {
+			finding.Annotations = append(finding.Annotations, convertAnnotation(apiAnnotation))
+		}
+	}
+}
+
+// convertAnnotation converts a generated annotation to our Annotation type
+func convertAnnotation(apiAnnotation findings.Annotation) Annotation {
+	annotation := Annotation{
+		Action:        apiAnnotation.GetAction(),
+		Comment:       apiAnnotation.GetComment(),
+		UserName:      apiAnnotation.GetUserName(),
+		Technique:     apiAnnotation.GetTechnique(),
+		Specifics:     apiAnnotation.GetSpecifics(),
+		RemainingRisk: apiAnnotation.GetRemainingRisk(),
+		Verification:  apiAnnotation.GetVerification(),
+	}
+	if apiAnnotation.Created != nil {
+		annotation.Created = apiAnnotation.Created.Format(time.RFC3339)
+	}
+	return annotation
 }
 
 // extractStaticFindingDetails extracts fields from static finding details
@@ -295,28 +410,12 @@ func extractStaticFindingDetails(finding *Finding, staticDetails *findings.Stati
 	if staticDetails.AttackVector != nil {
 		finding.AttackVector = *staticDetails.AttackVector
 	}
-}
-
-// extractStaticFindingDetails extracts fields from static finding details stored in DynamicFinding
-// when the generated client incorrectly unmarshaled static data as dynamic
-func extractStaticFromDynamic(finding *Finding, dynamicDetails *findings.DynamicFinding) {
-	if dynamicDetails == nil {
-		return
-	}
 
-	// Extract CWE - both types have this field
-	if dynamicDetails.Cwe != nil && dynamicDetails.Cwe.Id != nil {
-		finding.CWE = fmt.Sprintf("CWE-%d", *dynamicDetails.Cwe.Id)
+	// Extract finding category
+	if category := staticDetails.FindingCategory; category != nil {
+		finding.CategoryID = category.GetId()
+		finding.CategoryName = category.GetName()
 	}
-
-	// Extract severity - both types have this field
-	if dynamicDetails.Severity != nil {
-		finding.SeverityScore = *dynamicDetails.Severity
-		finding.Severity = fmt.Sprintf("%d", *dynamicDetails.Severity)
-	}
-
-	// FilePath and FileLineNumber are not in DynamicFinding, so they won't be extracted
-	// This is a limitation of the broken unmarshaling
 }
 
 // extractDynamicFindingDetails extracts fields from dynamic finding details
@@ -347,48 +446,34 @@ func extractDynamicFindingDetails(finding *Finding, dynamicDetails *findings.Dyn
 	}
 }
 
-// extractDynamicFromMismarshaled extracts dynamic finding data from a StaticFinding
-// when the generated client incorrectly unmarshaled dynamic data as static
-func extractDynamicFromMismarshaled(finding *Finding, staticDetails *findings.StaticFinding) {
-	if staticDetails == nil {
+// extractManualFindingDetails extracts fields from manual (penetration test) finding details
+func extractManualFindingDetails(finding *Finding, manualDetails *findings.ManualFindingV2) {
+	if manualDetails == nil {
 		return
 	}
 
-	// Extract CWE - both types have this field
-	if staticDetails.Cwe != nil && staticDetails.Cwe.Id != nil {
-		finding.CWE = fmt.Sprintf("CWE-%d", *staticDetails.Cwe.Id)
+	if manualDetails.Cwe != nil && manualDetails.Cwe.Id != nil {
+		finding.CWE = fmt.Sprintf("CWE-%d", *manualDetails.Cwe.Id)
 	}
 
-	// Extract severity - both types have this field
-	if staticDetails.Severity != nil {
-		finding.SeverityScore = *staticDetails.Severity
-		finding.Severity = fmt.Sprintf("%d", *staticDetails.Severity)
+	if manualDetails.Severity != nil {
+		finding.SeverityScore = *manualDetails.Severity
+		finding.Severity = fmt.Sprintf("%d", *manualDetails.Severity)
 	}
 
-	// URL is not in StaticFinding, so it won't be extracted
-	// This is a limitation of the broken unmarshaling
-}
-
-// extractDynamicFromSca extracts dynamic finding fields from ScaFinding
-// when the generated client incorrectly unmarshaled dynamic data as SCA
-func extractDynamicFromSca(finding *Finding, scaDetails *findings.ScaFinding) {
-	if scaDetails == nil {
-		return
+	// Manual findings are located by URL or page rather than file
+	if manualDetails.Location != nil {
+		finding.URL = *manualDetails.Location
 	}
-
-	// Extract CWE - ScaFinding has CWE with ID as int32
-	if scaDetails.Cwe != nil && scaDetails.Cwe.Id != nil {
-		finding.CWE = fmt.Sprintf("CWE-%d", *scaDetails.Cwe.Id)
+	if manualDetails.InputVector != nil {
+		finding.AttackVector = *manualDetails.InputVector
 	}
-
-	// Extract severity - ScaFinding has severity as int32
-	if scaDetails.Severity != nil {
-		finding.SeverityScore = *scaDetails.Severity
-		finding.Severity = fmt.Sprintf("%d", *scaDetails.Severity)
+	if manualDetails.Module != nil {
+		finding.Module = *manualDetails.Module
+	}
+	if manualDetails.CapecId != nil {
+		finding.CapecID = *manualDetails.CapecId
 	}
-
-	// URL is not in ScaFinding, so it won't be extracted
-	// This is a limitation of the broken unmarshaling
 }
 
 // extractScaFindingDetails extracts fields from SCA finding details
@@ -422,6 +507,15 @@ func extractScaFindingDetails(finding *Finding, scaDetails *findings.ScaFinding)
 		finding.CVE = *scaDetails.Cve.Name
 	}
 
+	// Prefer the CVSS v3 score when the CVE has one
+	if scaDetails.Cve != nil {
+		if scaDetails.Cve.Cvss3 != nil && scaDetails.Cve.Cvss3.Score != nil {
+			finding.CVSS = float64(*scaDetails.Cve.Cvss3.Score)
+		} else if scaDetails.Cve.Cvss != nil {
+			finding.CVSS = float64(*scaDetails.Cve.Cvss)
+		}
+	}
+
 	// Extract license information
 	if len(scaDetails.Licenses) > 0 {
 		finding.Licenses = make([]License, 0, len(scaDetails.Licenses))
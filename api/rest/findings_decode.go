@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
+)
+
+// rawFindingsPage mirrors findings.PagedResourceOfFinding but keeps each finding's
+// finding_details undecoded so it can be decoded using the finding's scan_type
+type rawFindingsPage struct {
+	Embedded *struct {
+		Findings []rawFinding `json:"findings"`
+	} `json:"_embedded,omitempty"`
+	Page *findings.PageMetadata `json:"page,omitempty"`
+}
+
+// rawFinding is a findings.Finding whose finding_details has not been decoded.
+// The outer FindingDetails field shadows the generated oneOf field, so the
+// generated heuristic UnmarshalJSON is never invoked.
+type rawFinding struct {
+	findings.Finding
+	FindingDetails json.RawMessage `json:"finding_details,omitempty"`
+}
+
+// findingDetails is finding_details decoded into the variant selected by scan_type.
+// Exactly one of Static, Dynamic, Manual or Sca is set. Fields the variant doesn't
+// declare (for example ones added to the API after the client was generated) are
+// kept in Unknown rather than dropped.
+type findingDetails struct {
+	Static  *findings.StaticFinding
+	Dynamic *findings.DynamicFinding
+	Manual  *findings.ManualFindingV2
+	Sca     *findings.ScaFinding
+	Unknown map[string]interface{}
+}
+
+// decodeFindingsPage decodes a Findings API response body
+func decodeFindingsPage(body []byte) (*rawFindingsPage, error) {
+	var page rawFindingsPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, fmt.Errorf("failed to decode findings response: %w", err)
+	}
+	return &page, nil
+}
+
+// decodeFindingDetails decodes raw finding_details using scanType as the discriminator
+func decodeFindingDetails(scanType string, raw json.RawMessage) (*findingDetails, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	details := &findingDetails{}
+	var target interface{}
+	switch strings.ToUpper(scanType) {
+	case "STATIC":
+		details.Static = &findings.StaticFinding{}
+		target = details.Static
+	case "DYNAMIC":
+		details.Dynamic = &findings.DynamicFinding{}
+		target = details.Dynamic
+	case "MANUAL":
+		details.Manual = &findings.ManualFindingV2{}
+		target = details.Manual
+	case "SCA":
+		details.Sca = &findings.ScaFinding{}
+		target = details.Sca
+	default:
+		return nil, fmt.Errorf("unknown scan type %q for finding details", scanType)
+	}
+
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, fmt.Errorf("failed to decode %s finding details: %w", scanType, err)
+	}
+
+	unknown, err := unknownFields(raw, reflect.TypeOf(target).Elem())
+	if err != nil {
+		return nil, err
+	}
+	details.Unknown = unknown
+
+	return details, nil
+}
+
+// unknownFields returns the members of the JSON object raw that t has no field for
+func unknownFields(raw json.RawMessage, t reflect.Type) (map[string]interface{}, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("finding details is not a JSON object: %w", err)
+	}
+
+	known := knownJSONFields(t)
+	var unknown map[string]interface{}
+	for name, value := range fields {
+		// encoding/json matches field names case-insensitively
+		if known[strings.ToLower(name)] {
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return nil, fmt.Errorf("failed to decode finding details field %q: %w", name, err)
+		}
+		if unknown == nil {
+			unknown = make(map[string]interface{})
+		}
+		unknown[name] = decoded
+	}
+	return unknown, nil
+}
+
+// knownFieldsCache holds the lowercased JSON field names of each variant type
+var knownFieldsCache sync.Map
+
+// knownJSONFields returns the lowercased JSON names of t's fields
+func knownJSONFields(t reflect.Type) map[string]bool {
+	if cached, ok := knownFieldsCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+
+	known := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		known[strings.ToLower(name)] = true
+	}
+
+	knownFieldsCache.Store(t, known)
+	return known
+}
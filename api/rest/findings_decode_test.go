@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// loadRecordedFindings decodes a recorded Findings API response and converts its findings
+func loadRecordedFindings(t *testing.T, scanType string) []Finding {
+	t.Helper()
+
+	body, err := os.ReadFile("findings_api_response_raw_" + scanType + ".json")
+	if err != nil {
+		t.Fatalf("Failed to read recorded response: %v", err)
+	}
+
+	page, err := decodeFindingsPage(body)
+	if err != nil {
+		t.Fatalf("Failed to decode recorded response: %v", err)
+	}
+	if page.Embedded == nil || len(page.Embedded.Findings) == 0 {
+		t.Fatal("Recorded response has no findings")
+	}
+
+	return convertFindings(page.Embedded.Findings, "")
+}
+
+func TestDecodeRecordedStaticFindings(t *testing.T) {
+	finding := loadRecordedFindings(t, "static")[0]
+
+	if finding.ScanType != "STATIC" || finding.CWE != "CWE-78" || finding.SeverityScore != 5 {
+		t.Errorf("Unexpected static finding: scan_type=%s cwe=%s severity=%d", finding.ScanType, finding.CWE, finding.SeverityScore)
+	}
+	if finding.FilePath == "" || finding.LineNumber != 68 || finding.Module != "app.dll" {
+		t.Errorf("Expected static location fields, got file=%q line=%d module=%q", finding.FilePath, finding.LineNumber, finding.Module)
+	}
+}
+
+func TestDecodeRecordedDynamicFindings(t *testing.T) {
+	// The heuristic oneOf decoder used to put this finding into ScaFinding
+	finding := loadRecordedFindings(t, "dynamic")[0]
+
+	if finding.ScanType != "DYNAMIC" || finding.CWE != "CWE-757" || finding.SeverityScore != 3 {
+		t.Errorf("Unexpected dynamic finding: scan_type=%s cwe=%s severity=%d", finding.ScanType, finding.CWE, finding.SeverityScore)
+	}
+	if finding.ComponentFilename != "" || len(finding.Licenses) > 0 {
+		t.Error("Dynamic finding should not carry SCA fields")
+	}
+}
+
+func TestDecodeRecordedScaFindings(t *testing.T) {
+	findings := loadRecordedFindings(t, "sca")
+
+	finding := findings[0]
+	if finding.ComponentFilename != "commons-collections4-4.0.jar" || finding.ComponentVersion != "4.0" {
+		t.Errorf("Unexpected component: %s %s", finding.ComponentFilename, finding.ComponentVersion)
+	}
+	if finding.CVE != "CVE-2015-7501" || finding.CWE != "CWE-502" {
+		t.Errorf("Unexpected CVE/CWE: %s %s", finding.CVE, finding.CWE)
+	}
+	if len(finding.Licenses) != 1 || finding.Licenses[0].LicenseID != "apache-2.0" {
+		t.Errorf("Unexpected licenses: %+v", finding.Licenses)
+	}
+
+	// The API returns component_path while the spec declares component_path(s); it must not be dropped
+	if _, ok := finding.AdditionalInfo["component_path"]; !ok {
+		t.Errorf("Expected component_path to be kept in AdditionalInfo, got %v", finding.AdditionalInfo)
+	}
+}
+
+func TestDecodeFindingDetails_UsesScanTypeDiscriminator(t *testing.T) {
+	// Only common fields: the heuristic decoder fell through to ManualFinding and failed on the cwe object
+	raw := json.RawMessage(`{"cwe": {"id": 89}, "severity": 4, "new_field": "kept"}`)
+
+	details, err := decodeFindingDetails("STATIC", raw)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if details.Static == nil || details.Dynamic != nil || details.Manual != nil || details.Sca != nil {
+		t.Fatalf("Expected only the static variant to be set, got %+v", details)
+	}
+	if details.Static.Cwe == nil || *details.Static.Cwe.Id != 89 {
+		t.Error("Expected CWE 89")
+	}
+	if details.Unknown["new_field"] != "kept" {
+		t.Errorf("Expected new_field in Unknown, got %v", details.Unknown)
+	}
+}
+
+func TestDecodeFindingDetails_Manual(t *testing.T) {
+	// Every field of the spec's ManualFindingV2
+	raw := json.RawMessage(`{
+		"cwe": {"id": 639, "name": "Authorization Bypass Through User-Controlled Key", "href": "https://api.veracode.com/appsec/v1/cwes/639"},
+		"cvss": "6.5",
+		"severity": 4,
+		"capec_id": "87",
+		"exploit_desc": "Changed the account id parameter to view other customers",
+		"exploit_difficulty": "Easy",
+		"input_vector": "URL parameter",
+		"location": "https://example.com/account",
+		"module": "Customer portal",
+		"remediation_desc": "Check the account belongs to the session user",
+		"severity_desc": "Exposes every customer's account details"
+	}`)
+
+	details, err := decodeFindingDetails("MANUAL", raw)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var finding Finding
+	extractManualFindingDetails(&finding, details.Manual)
+	if finding.CWE != "CWE-639" || finding.URL != "https://example.com/account" || finding.AttackVector != "URL parameter" {
+		t.Errorf("Unexpected manual finding: %+v", finding)
+	}
+	if finding.Module != "Customer portal" || finding.CapecID != "87" {
+		t.Errorf("Unexpected manual finding details: %+v", finding)
+	}
+	if len(details.Unknown) != 0 {
+		t.Errorf("Expected every manual field to be known, got unknown %v", details.Unknown)
+	}
+}
+
+func TestConvertSingleFinding_FlagsUndecodableDetails(t *testing.T) {
+	var apiFinding rawFinding
+	if err := json.Unmarshal([]byte(`{"issue_id": 7, "scan_type": "MANUAL", "finding_details": {"capec_id": 87}}`), &apiFinding); err != nil {
+		t.Fatal(err)
+	}
+
+	finding := convertSingleFinding(apiFinding, "")
+	if finding.ID != "7" || finding.ScanType != "MANUAL" {
+		t.Errorf("Expected the finding's common fields to be kept, got %+v", finding)
+	}
+	if !strings.Contains(finding.DetailsError, "failed to decode MANUAL finding details") {
+		t.Errorf("Expected the decode error to be flagged on the finding, got %q", finding.DetailsError)
+	}
+}
+
+func TestDecodeFindingDetails_Errors(t *testing.T) {
+	if _, err := decodeFindingDetails("BOGUS", json.RawMessage(`{}`)); err == nil {
+		t.Error("Expected error for unknown scan type")
+	}
+	if _, err := decodeFindingDetails("STATIC", json.RawMessage(`{"severity": "high"}`)); err == nil {
+		t.Error("Expected error for mistyped field")
+	}
+	if details, err := decodeFindingDetails("STATIC", nil); err != nil || details != nil {
+		t.Errorf("Expected nil details for absent finding_details, got %v, %v", details, err)
+	}
+}
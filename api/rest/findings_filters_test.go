@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// newQueryCaptureServer returns an empty findings page and records the query of the last request
+func newQueryCaptureServer(t *testing.T) (*httptest.Server, *url.Values) {
+	t.Helper()
+	query := &url.Values{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"_embedded": {"findings": []}, "page": {"number": 0, "size": 10, "total_elements": 0, "total_pages": 0}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, query
+}
+
+func TestGetScaFindings_SendsScaFilters(t *testing.T) {
+	server, query := newQueryCaptureServer(t)
+	client := newTestClient(server.URL)
+
+	cvssGte := 7.5
+	req := FindingsRequest{
+		AppProfile:     "app-guid",
+		Size:           10,
+		CVE:            "CVE-2021-44228",
+		CVSSGte:        &cvssGte,
+		New:            true,
+		IncludeExpDate: true,
+		ScaDepMode:     "TRANSITIVE",
+		ScaScanMode:    "AGENT",
+	}
+	if _, err := client.GetScaFindings(context.Background(), req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"cve":              "CVE-2021-44228",
+		"cvss_gte":         "7.5",
+		"new":              "true",
+		"include_exp_date": "true",
+		"sca_dep_mode":     "TRANSITIVE",
+		"sca_scan_mode":    "AGENT",
+	}
+	for param, want := range expected {
+		if got := query.Get(param); got != want {
+			t.Errorf("Expected %s=%s, got %q", param, want, got)
+		}
+	}
+}
+
+func TestGetStaticFindings_SendsCategoryAndMitigatedAfter(t *testing.T) {
+	server, query := newQueryCaptureServer(t)
+	client := newTestClient(server.URL)
+
+	mitigatedAfter := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	req := FindingsRequest{
+		AppProfile:      "app-guid",
+		Size:            10,
+		FindingCategory: []int32{19},
+		MitigatedAfter:  &mitigatedAfter,
+	}
+	if _, err := client.GetStaticFindings(context.Background(), req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := query.Get("finding_category"); got != "19" {
+		t.Errorf("Expected finding_category=19, got %q", got)
+	}
+	if got := query.Get("mitigated_after"); got != "2024-01-31" {
+		t.Errorf("Expected mitigated_after=2024-01-31, got %q", got)
+	}
+}
@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+const (
+	// IteratePageSize is the page size used when walking every page of findings
+	IteratePageSize = 500
+	// MaxFetchAllFindings is the hard cap on the number of findings FetchAllFindings returns
+	MaxFetchAllFindings = 10000
+	// iterateConcurrency bounds how many pages are requested in parallel
+	iterateConcurrency = 4
+)
+
+// findingsPageResult carries one fetched page (or its error) back to the iterator
+type findingsPageResult struct {
+	resp *FindingsResponse
+	err  error
+}
+
+// getFindingsPage fetches a single page of findings for the given scan type
+func (c *Client) getFindingsPage(ctx context.Context, req FindingsRequest, scanType string) (*FindingsResponse, error) {
+	switch scanType {
+	case "STATIC", "DYNAMIC", "MANUAL", "SCA":
+		return c.getFindings(ctx, req, scanType)
+	default:
+		return nil, fmt.Errorf("unsupported scan type %q (expected STATIC, DYNAMIC, MANUAL or SCA)", scanType)
+	}
+}
+
+// IterateFindings streams every finding matching req across all pages.
+// The first page is fetched to learn Page.TotalPages; the remaining pages are then
+// fetched with at most iterateConcurrency requests in flight. Findings are yielded
+// in page order. req.Page is ignored and req.Size defaults to IteratePageSize.
+// Iteration stops at the first error, which is yielded with a zero Finding.
+func (c *Client) IterateFindings(ctx context.Context, req FindingsRequest, scanType string) iter.Seq2[Finding, error] {
+	return func(yield func(Finding, error) bool) {
+		err := c.iterateFindingsPages(ctx, req, scanType, func(page *FindingsResponse) bool {
+			for _, finding := range page.Findings {
+				if !yield(finding, nil) {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			yield(Finding{}, err)
+		}
+	}
+}
+
+// FetchAllFindings collects findings from every page into a single response, stopping
+// once maxResults findings have been gathered. maxResults is clamped to MaxFetchAllFindings.
+// TotalCount reports the API's total across all pages; Truncated is set if the cap was hit.
+func (c *Client) FetchAllFindings(ctx context.Context, req FindingsRequest, scanType string, maxResults int) (*FindingsResponse, error) {
+	if maxResults <= 0 || maxResults > MaxFetchAllFindings {
+		maxResults = MaxFetchAllFindings
+	}
+
+	result := &FindingsResponse{
+		Findings: []Finding{},
+		AllPages: true,
+	}
+
+	err := c.iterateFindingsPages(ctx, req, scanType, func(page *FindingsResponse) bool {
+		if page.Page == 0 {
+			result.TotalCount = page.TotalCount
+			result.Size = page.Size
+		}
+
+		remaining := maxResults - len(result.Findings)
+		if len(page.Findings) > remaining {
+			result.Findings = append(result.Findings, page.Findings[:remaining]...)
+			result.Truncated = true
+			return false
+		}
+		result.Findings = append(result.Findings, page.Findings...)
+		return len(result.Findings) < maxResults
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Hitting the cap exactly still leaves later pages unread
+	if len(result.Findings) >= maxResults && result.TotalCount > len(result.Findings) {
+		result.Truncated = true
+	}
+	result.TotalPages = 1
+
+	return result, nil
+}
+
+// iterateFindingsPages calls yield with each page of findings in order until yield returns false.
+// Pages after the first are fetched in parallel within a sliding window of iterateConcurrency,
+// so at most that many pages are in flight or buffered at any time.
+func (c *Client) iterateFindingsPages(ctx context.Context, req FindingsRequest, scanType string, yield func(*FindingsResponse) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req.Page = 0
+	if req.Size <= 0 {
+		req.Size = IteratePageSize
+	}
+
+	first, err := c.getFindingsPage(ctx, req, scanType)
+	if err != nil {
+		return err
+	}
+	if !yield(first) {
+		return nil
+	}
+
+	totalPages := first.TotalPages
+	if totalPages <= 1 {
+		return nil
+	}
+
+	pages := make([]chan findingsPageResult, totalPages)
+	for i := range pages {
+		pages[i] = make(chan findingsPageResult, 1)
+	}
+
+	// A slot is taken before a page is requested and released once it has been consumed
+	window := make(chan struct{}, iterateConcurrency)
+	go func() {
+		for page := 1; page < totalPages; page++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(page int) {
+				pageReq := req
+				pageReq.Page = page
+				resp, err := c.getFindingsPage(ctx, pageReq, scanType)
+				pages[page] <- findingsPageResult{resp: resp, err: err}
+			}(page)
+		}
+	}()
+
+	for page := 1; page < totalPages; page++ {
+		var result findingsPageResult
+		select {
+		case result = <-pages[page]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-window
+
+		if result.err != nil {
+			return fmt.Errorf("failed to fetch page %d of %d: %w", page+1, totalPages, result.err)
+		}
+		if !yield(result.resp) {
+			return nil
+		}
+	}
+
+	return nil
+}
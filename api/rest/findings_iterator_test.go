@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/credentials"
+)
+
+// newFindingsTestServer serves totalFindings static findings in pages, recording which pages were requested
+func newFindingsTestServer(t *testing.T, totalFindings int, failPage int) (*httptest.Server, *sync.Map) {
+	t.Helper()
+	requested := &sync.Map{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		requested.Store(page, true)
+
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		totalPages := (totalFindings + size - 1) / size
+		items := make([]string, 0, size)
+		for i := page * size; i < (page+1)*size && i < totalFindings; i++ {
+			items = append(items, fmt.Sprintf(`{"issue_id": %d, "scan_type": "STATIC", "description": "finding", "finding_details": {"cwe": {"id": 89}, "severity": 4}}`, i+1))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"_embedded": {"findings": [%s]}, "page": {"number": %d, "size": %d, "total_elements": %d, "total_pages": %d}}`,
+			strings.Join(items, ","), page, size, totalFindings, totalPages)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requested
+}
+
+func newTestClient(baseURL string) *Client {
+	return newClientWithProvider(credentials.NewStaticProvider("test-id", "0123456789abcdef"), baseURL)
+}
+
+func TestIterateFindings_AllPagesInOrder(t *testing.T) {
+	server, requested := newFindingsTestServer(t, 23, -1)
+	client := newTestClient(server.URL)
+
+	req := FindingsRequest{AppProfile: "app-guid", Size: 5, Page: 3}
+	var ids []string
+	for finding, err := range client.IterateFindings(context.Background(), req, "STATIC") {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		ids = append(ids, finding.ID)
+	}
+
+	if len(ids) != 23 {
+		t.Fatalf("Expected 23 findings, got %d", len(ids))
+	}
+	for i, id := range ids {
+		if id != strconv.Itoa(i+1) {
+			t.Fatalf("Expected findings in page order, got %s at position %d", id, i)
+		}
+	}
+	for page := 0; page < 5; page++ {
+		if _, ok := requested.Load(page); !ok {
+			t.Errorf("Expected page %d to be requested", page)
+		}
+	}
+}
+
+func TestIterateFindings_StopsOnError(t *testing.T) {
+	server, _ := newFindingsTestServer(t, 20, 2)
+	client := newTestClient(server.URL)
+
+	count := 0
+	var iterErr error
+	for _, err := range client.IterateFindings(context.Background(), FindingsRequest{AppProfile: "app-guid", Size: 5}, "STATIC") {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		count++
+	}
+
+	if iterErr == nil {
+		t.Fatal("Expected an error from the failing page")
+	}
+	if count != 10 {
+		t.Errorf("Expected the 10 findings before the failing page, got %d", count)
+	}
+}
+
+func TestIterateFindings_EarlyBreak(t *testing.T) {
+	server, _ := newFindingsTestServer(t, 100, -1)
+	client := newTestClient(server.URL)
+
+	count := 0
+	for _, err := range client.IterateFindings(context.Background(), FindingsRequest{AppProfile: "app-guid", Size: 5}, "STATIC") {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		count++
+		if count == 7 {
+			break
+		}
+	}
+
+	if count != 7 {
+		t.Errorf("Expected iteration to stop at 7, got %d", count)
+	}
+}
+
+func TestFetchAllFindings(t *testing.T) {
+	server, _ := newFindingsTestServer(t, 23, -1)
+	client := newTestClient(server.URL)
+
+	resp, err := client.FetchAllFindings(context.Background(), FindingsRequest{AppProfile: "app-guid", Size: 5}, "STATIC", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Findings) != 23 || resp.TotalCount != 23 {
+		t.Errorf("Expected 23 findings and total 23, got %d and %d", len(resp.Findings), resp.TotalCount)
+	}
+	if !resp.AllPages || resp.Truncated {
+		t.Errorf("Expected AllPages without truncation, got AllPages=%v Truncated=%v", resp.AllPages, resp.Truncated)
+	}
+}
+
+func TestFetchAllFindings_Truncated(t *testing.T) {
+	server, _ := newFindingsTestServer(t, 23, -1)
+	client := newTestClient(server.URL)
+
+	resp, err := client.FetchAllFindings(context.Background(), FindingsRequest{AppProfile: "app-guid", Size: 5}, "STATIC", 12)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp.Findings) != 12 {
+		t.Errorf("Expected 12 findings, got %d", len(resp.Findings))
+	}
+	if resp.TotalCount != 23 {
+		t.Errorf("Expected TotalCount to report the API total of 23, got %d", resp.TotalCount)
+	}
+	if !resp.Truncated {
+		t.Error("Expected Truncated to be set")
+	}
+}
+
+func TestFetchAllFindings_UnsupportedScanType(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+
+	if _, err := client.FetchAllFindings(context.Background(), FindingsRequest{AppProfile: "app-guid"}, "BOGUS", 10); err == nil {
+		t.Error("Expected error for unsupported scan type")
+	}
+}
@@ -558,7 +558,8 @@ func (a *ApplicationInformationAPIAPIService) GetApplicationsUsingGETExecute(r A
 		parameterAddToHeaderOrQuery(localVarQueryParams, "legacy_id", r.legacyId, "", "")
 	}
 	if r.modifiedAfter != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "modified_after", r.modifiedAfter, "", "")
+		// PATCHED: The API expects yyyy-MM-dd; the generated client would send RFC3339
+		parameterAddToHeaderOrQuery(localVarQueryParams, "modified_after", r.modifiedAfter.Format("2006-01-02"), "", "")
 	}
 	if r.name != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "name", r.name, "", "")
@@ -573,7 +574,8 @@ func (a *ApplicationInformationAPIAPIService) GetApplicationsUsingGETExecute(r A
 		parameterAddToHeaderOrQuery(localVarQueryParams, "policy_compliance", r.policyCompliance, "", "")
 	}
 	if r.policyComplianceCheckedAfter != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "policy_compliance_checked_after", r.policyComplianceCheckedAfter, "", "")
+		// PATCHED: The API expects yyyy-MM-dd; the generated client would send RFC3339
+		parameterAddToHeaderOrQuery(localVarQueryParams, "policy_compliance_checked_after", r.policyComplianceCheckedAfter.Format("2006-01-02"), "", "")
 	}
 	if r.policyGuid != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "policy_guid", r.policyGuid, "", "")
@@ -241,7 +241,8 @@ func (a *ApplicationFindingsInformationAPIService) GetFindingsUsingGETExecute(r
 		parameterAddToHeaderOrQuery(localVarQueryParams, "include_exp_date", r.includeExpDate, "form", "")
 	}
 	if r.mitigatedAfter != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "mitigated_after", r.mitigatedAfter, "form", "")
+		// PATCHED: The API expects yyyy-MM-dd; the generated client would send RFC3339
+		parameterAddToHeaderOrQuery(localVarQueryParams, "mitigated_after", r.mitigatedAfter.Format("2006-01-02"), "form", "")
 	}
 	if r.new != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "new", r.new, "form", "")
@@ -19,8 +19,9 @@ var _ MappedNullable = &PagedResourceOfScaLicenseSummary{}
 
 // PagedResourceOfScaLicenseSummary struct for PagedResourceOfScaLicenseSummary
 type PagedResourceOfScaLicenseSummary struct {
-	Embedded *EmbeddedScaLicenseSummary `json:"__embedded,omitempty"`
-	Links    *Link                      `json:"__links,omitempty"`
+	// PATCHED: The API responds with HAL's _embedded and _links; the spec's __embedded and __links never match
+	Embedded *EmbeddedScaLicenseSummary `json:"_embedded,omitempty"`
+	Links    *Link                      `json:"_links,omitempty"`
 }
 
 // NewPagedResourceOfScaLicenseSummary instantiates a new PagedResourceOfScaLicenseSummary object
@@ -115,10 +116,10 @@ func (o PagedResourceOfScaLicenseSummary) MarshalJSON() ([]byte, error) {
 func (o PagedResourceOfScaLicenseSummary) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	if !IsNil(o.Embedded) {
-		toSerialize["__embedded"] = o.Embedded
+		toSerialize["_embedded"] = o.Embedded
 	}
 	if !IsNil(o.Links) {
-		toSerialize["__links"] = o.Links
+		toSerialize["_links"] = o.Links
 	}
 	return toSerialize, nil
 }
@@ -0,0 +1,261 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
+)
+
+// manualScanPageSize is the page size used when listing an application's manual scans
+const manualScanPageSize = 100
+
+// GetManualFindings retrieves manual penetration test findings for an application
+func (c *Client) GetManualFindings(ctx context.Context, req FindingsRequest) (*FindingsResponse, error) {
+	return c.getFindings(ctx, req, "MANUAL")
+}
+
+// ListManualScans retrieves every manual scan performed on an application
+func (c *Client) ListManualScans(ctx context.Context, applicationGUID string) ([]findings.Scan, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var scans []findings.Scan
+	for page := int32(0); ; page++ {
+		resp, err := c.getManualScansPage(authCtx, applicationGUID, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Embedded != nil {
+			scans = append(scans, resp.Embedded.Scans...)
+		}
+
+		if resp.Page == nil || resp.Page.TotalPages == nil || int64(page)+1 >= *resp.Page.TotalPages {
+			return scans, nil
+		}
+	}
+}
+
+// getManualScansPage retrieves one page of an application's manual scans
+func (c *Client) getManualScansPage(authCtx context.Context, applicationGUID string, page int32) (*findings.PagedModelOfScan, error) {
+	resp, httpResp, err := c.findingsClient.ManualScansInformationAPI.GetManualScans(authCtx).
+		Application(applicationGUID).
+		Page(page).
+		Size(manualScanPageSize).
+		Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to list manual scans: %w", err)
+	}
+
+	return resp, nil
+}
+
+// getManualScanFindings retrieves the findings recorded by one manual scan
+func (c *Client) getManualScanFindings(authCtx context.Context, scanID int32) ([]findings.ManualFinding, error) {
+	resp, httpResp, err := c.findingsClient.ManualScansInformationAPI.GetScanFindings(authCtx, scanID).Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d for manual scan %d: %w", httpResp.StatusCode, scanID, err)
+		}
+		return nil, fmt.Errorf("failed to get findings for manual scan %d: %w", scanID, err)
+	}
+
+	if resp.Embedded == nil {
+		return nil, nil
+	}
+	return resp.Embedded.ManualFindings, nil
+}
+
+// GetManualFindingDetails retrieves the tester's write-up for every manual finding of an
+// application, keyed by the finding's issue ID in the Findings API
+func (c *Client) GetManualFindingDetails(ctx context.Context, applicationGUID string) (map[string]ManualFindingDetail, error) {
+	scans, err := c.ListManualScans(ctx, applicationGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+	details := make(map[string]ManualFindingDetail)
+	efforts := make(map[int32]int32)
+	for _, scan := range scans {
+		if scan.Id == nil {
+			continue
+		}
+
+		manualFindings, err := c.getManualScanFindings(authCtx, *scan.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, manualFinding := range manualFindings {
+			detail := convertManualFinding(scan, manualFinding)
+			detail.RemediationEffort = c.remediationEffort(ctx, efforts, detail.CWE)
+			if detail.IssueID != "" {
+				details[detail.IssueID] = detail
+			}
+		}
+	}
+
+	return details, nil
+}
+
+// convertManualFinding converts a Manual Scans API finding into a ManualFindingDetail
+func convertManualFinding(scan findings.Scan, manualFinding findings.ManualFinding) ManualFindingDetail {
+	detail := ManualFindingDetail{
+		ID:             manualFinding.GetId(),
+		ScanID:         scan.GetId(),
+		ScanName:       scan.GetName(),
+		CWE:            manualFinding.GetCwe(),
+		Exploitability: manualFinding.Exploitability,
+		CVSS:           manualFinding.GetCvss(),
+	}
+
+	// The external ID is the issue_id the Findings API reports for the same finding
+	if manualFinding.ExternalId != nil {
+		detail.IssueID = strconv.FormatInt(*manualFinding.ExternalId, 10)
+	}
+
+	if source := manualFinding.Source; source != nil {
+		detail.CapecID = source.GetCapecId()
+		detail.ExploitDifficulty = source.GetExploitDifficulty()
+		detail.ExploitDescription = source.GetExploitDesc()
+		detail.InputVector = source.GetInputVector()
+		detail.Location = source.GetLocation()
+		detail.Module = source.GetModule()
+		detail.Remediation = source.GetRemediationDesc()
+		detail.SeverityRationale = source.GetSeverityDesc()
+	}
+
+	return detail
+}
+
+// GetManualFindingDetail retrieves the tester's write-up for one manual finding of an application.
+// The single-finding endpoint is tried first with the issue ID; it is used when the finding it returns
+// is the same issue (its external ID) in a scan of the same application. Otherwise the application's
+// manual scans are walked as in GetManualFindingDetails.
+func (c *Client) GetManualFindingDetail(ctx context.Context, applicationGUID, issueID string) (*ManualFindingDetail, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	findingID, err := strconv.ParseInt(issueID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue ID %q: must be numeric", issueID)
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+	if detail, err := c.getManualFinding(authCtx, applicationGUID, findingID); err != nil {
+		log.Printf("Manual finding %s is not available from the single-finding endpoint: %v", issueID, err)
+	} else {
+		detail.RemediationEffort = c.remediationEffort(ctx, make(map[int32]int32), detail.CWE)
+		return detail, nil
+	}
+
+	details, err := c.GetManualFindingDetails(ctx, applicationGUID)
+	if err != nil {
+		return nil, err
+	}
+	detail, ok := details[issueID]
+	if !ok {
+		return nil, fmt.Errorf("issue %s is not in the manual scans of application %s", issueID, applicationGUID)
+	}
+	return &detail, nil
+}
+
+// getManualFinding retrieves a manual finding by ID, with its scan, and checks it is the
+// application's issue findingID
+func (c *Client) getManualFinding(authCtx context.Context, applicationGUID string, findingID int64) (*ManualFindingDetail, error) {
+	manualFinding, httpResp, err := c.findingsClient.ManualScansInformationAPI.GetManualFinding(authCtx, findingID).Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d for manual finding %d: %w", httpResp.StatusCode, findingID, err)
+		}
+		return nil, fmt.Errorf("failed to get manual finding %d: %w", findingID, err)
+	}
+	if manualFinding.GetExternalId() != findingID {
+		return nil, fmt.Errorf("manual finding %d is issue %d", findingID, manualFinding.GetExternalId())
+	}
+
+	scan, err := c.getManualScan(authCtx, manualFinding.GetScanId())
+	if err != nil {
+		return nil, err
+	}
+	if scan.GetApplicationGuid() != applicationGUID {
+		return nil, fmt.Errorf("manual finding %d belongs to application %s", findingID, scan.GetApplicationGuid())
+	}
+
+	detail := convertManualFinding(*scan, *manualFinding)
+	return &detail, nil
+}
+
+// remediationEffort looks up the remediation effort of a CWE, once per CWE in efforts.
+// The Manual Scans API doesn't report it, so it comes from the CWE API; 0 if it's unavailable.
+func (c *Client) remediationEffort(ctx context.Context, efforts map[int32]int32, cweID int32) int32 {
+	if cweID == 0 {
+		return 0
+	}
+	if effort, ok := efforts[cweID]; ok {
+		return effort
+	}
+
+	cwe, err := c.GetCWE(ctx, cweID)
+	if err != nil {
+		log.Printf("Failed to look up the remediation effort of CWE-%d: %v", cweID, err)
+	}
+	efforts[cweID] = cwe.GetRemediationEffort()
+	return efforts[cweID]
+}
+
+// getManualScan retrieves one manual scan
+func (c *Client) getManualScan(authCtx context.Context, scanID int32) (*findings.Scan, error) {
+	scan, httpResp, err := c.findingsClient.ManualScansInformationAPI.GetManualScan(authCtx, scanID).Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d for manual scan %d: %w", httpResp.StatusCode, scanID, err)
+		}
+		return nil, fmt.Errorf("failed to get manual scan %d: %w", scanID, err)
+	}
+
+	return scan, nil
+}
@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newManualScansTestServer serves two pages of manual scans, each with one finding. Manual finding 30
+// (issue 30) is only reachable through the single-finding endpoint; manual finding 10 is issue 99.
+func newManualScansTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/mpt/v1/scans":
+			if r.URL.Query().Get("application") != "app-guid" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			fmt.Fprintf(w, `{"__embedded": {"scans": [{"id": %d, "name": "Pen test %d"}]}, "_page": {"number": %d, "size": 1, "total_elements": 2, "total_pages": 2}}`,
+				page+1, page+1, page)
+		case strings.HasPrefix(r.URL.Path, "/mpt/v1/scans/") && strings.HasSuffix(r.URL.Path, "/findings"):
+			scanID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/mpt/v1/scans/"), "/findings")
+			fmt.Fprintf(w, `{"__embedded": {"manualFindings": [{"id": %s00, "externalId": %s0, "cwe": 89, "exploitability": 1, "cvss": 7.5,
+				"source": {"capecId": 66, "exploitDifficulty": 2, "exploitDesc": "Injected a quote into the search box", "inputVector": "Parameter",
+				"location": "/search", "remediationDesc": "Use parameterised queries", "severityDesc": "Full database read"}}]}}`, scanID, scanID)
+		case r.URL.Path == "/mpt/v1/findings/30":
+			fmt.Fprint(w, `{"id": 30, "externalId": 30, "scanId": 3, "cwe": 89, "source": {"remediationDesc": "Check the session owns the account"}}`)
+		case r.URL.Path == "/mpt/v1/findings/10":
+			fmt.Fprint(w, `{"id": 10, "externalId": 99, "scanId": 1}`)
+		case r.URL.Path == "/mpt/v1/scans/3":
+			fmt.Fprint(w, `{"id": 3, "name": "Retest", "applicationGuid": "app-guid"}`)
+		case r.URL.Path == "/appsec/v1/cwes/89":
+			fmt.Fprint(w, `{"id": 89, "name": "SQL Injection", "remediation_effort": 2}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestListManualScans(t *testing.T) {
+	client := newTestClient(newManualScansTestServer(t).URL)
+
+	scans, err := client.ListManualScans(context.Background(), "app-guid")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(scans) != 2 {
+		t.Fatalf("Expected scans from both pages, got %d", len(scans))
+	}
+	if scans[1].GetName() != "Pen test 2" {
+		t.Errorf("Expected the second scan to be Pen test 2, got %s", scans[1].GetName())
+	}
+}
+
+func TestGetManualFindingDetails(t *testing.T) {
+	client := newTestClient(newManualScansTestServer(t).URL)
+
+	details, err := client.GetManualFindingDetails(context.Background(), "app-guid")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(details) != 2 {
+		t.Fatalf("Expected 2 manual findings, got %d", len(details))
+	}
+
+	detail, ok := details["20"]
+	if !ok {
+		t.Fatalf("Expected the finding to be keyed by its external ID, got %v", details)
+	}
+	if detail.ScanID != 2 || detail.ScanName != "Pen test 2" {
+		t.Errorf("Expected the finding to record scan 2, got %d %q", detail.ScanID, detail.ScanName)
+	}
+	if detail.CapecID != 66 || detail.ExploitDifficulty != 2 || detail.InputVector != "Parameter" {
+		t.Errorf("Unexpected source fields: %+v", detail)
+	}
+	if detail.Exploitability == nil || *detail.Exploitability != 1 {
+		t.Errorf("Expected exploitability 1, got %v", detail.Exploitability)
+	}
+	if detail.Remediation != "Use parameterised queries" || detail.Location != "/search" {
+		t.Errorf("Unexpected write-up fields: %+v", detail)
+	}
+	if detail.CWE != 89 || detail.RemediationEffort != 2 {
+		t.Errorf("Expected CWE-89 with remediation effort 2, got CWE-%d effort %d", detail.CWE, detail.RemediationEffort)
+	}
+}
+
+func TestGetManualFindingDetail(t *testing.T) {
+	client := newTestClient(newManualScansTestServer(t).URL)
+
+	// Issue 30 comes straight from the single-finding endpoint
+	detail, err := client.GetManualFindingDetail(context.Background(), "app-guid", "30")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.IssueID != "30" || detail.ScanName != "Retest" || detail.Remediation != "Check the session owns the account" {
+		t.Errorf("Expected issue 30 from the Retest scan, got %+v", detail)
+	}
+	if detail.RemediationEffort != 2 {
+		t.Errorf("Expected remediation effort 2, got %d", detail.RemediationEffort)
+	}
+
+	// Manual finding 10 is a different issue, so issue 10 is found by walking the scans
+	detail, err = client.GetManualFindingDetail(context.Background(), "app-guid", "10")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.ID != 100 || detail.ScanName != "Pen test 1" {
+		t.Errorf("Expected manual finding 100 from Pen test 1, got %+v", detail)
+	}
+
+	if _, err := client.GetManualFindingDetail(context.Background(), "app-guid", "77"); err == nil {
+		t.Error("Expected an error for an issue no manual scan reported")
+	}
+}
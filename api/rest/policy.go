@@ -42,3 +42,32 @@ func (c *Client) GetPolicy(ctx context.Context, policyName string) (*policy.Page
 
 	return pagedResult, nil
 }
+
+// GetPolicyByGUID retrieves the latest version of a policy, including its rules and grace periods
+func (c *Client) GetPolicyByGUID(ctx context.Context, policyGUID string) (*policy.PolicyVersion, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	policyVersion, httpResp, err := c.policyClient.PolicyInformationAPIAPI.
+		GetPolicyUsingGET(authCtx, policyGUID).
+		Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d for policy %s: %w", httpResp.StatusCode, policyGUID, err)
+		}
+		return nil, fmt.Errorf("failed to get policy %s: %w", policyGUID, err)
+	}
+
+	return policyVersion, nil
+}
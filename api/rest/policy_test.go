@@ -0,0 +1,44 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPolicyByGUID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/appsec/v1/policies/policy-guid" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"guid": "policy-guid", "name": "Veracode Recommended Medium", "version": 3,
+			"finding_rules": [{"type": "MAX_SEVERITY", "value": "3", "scan_type": ["STATIC", "DYNAMIC"]}],
+			"scan_frequency_rules": [{"scan_type": "STATIC", "frequency": "QUARTERLY"}],
+			"sev5_grace_period": 0, "sev3_grace_period": 30}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	policyVersion, err := client.GetPolicyByGUID(context.Background(), "policy-guid")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if policyVersion.GetName() != "Veracode Recommended Medium" || policyVersion.GetVersion() != 3 {
+		t.Errorf("Unexpected policy: %+v", policyVersion)
+	}
+	if len(policyVersion.FindingRules) != 1 || policyVersion.FindingRules[0].GetType() != "MAX_SEVERITY" {
+		t.Errorf("Expected the MAX_SEVERITY finding rule, got %+v", policyVersion.FindingRules)
+	}
+	if policyVersion.GetSev3GracePeriod() != 30 {
+		t.Errorf("Expected a 30 day medium grace period, got %d", policyVersion.GetSev3GracePeriod())
+	}
+
+	if _, err := client.GetPolicyByGUID(context.Background(), "missing"); err == nil {
+		t.Error("Expected error for an unknown policy")
+	}
+}
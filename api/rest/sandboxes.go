@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	applications "github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+)
+
+// sandboxPageSize is the page size used when listing an application's sandboxes
+const sandboxPageSize = 100
+
+// ListSandboxes retrieves every sandbox for an application
+func (c *Client) ListSandboxes(ctx context.Context, applicationGUID string) ([]applications.Sandbox, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var sandboxes []applications.Sandbox
+	for page := int32(0); ; page++ {
+		resp, err := c.getSandboxesPage(authCtx, applicationGUID, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Embedded != nil {
+			sandboxes = append(sandboxes, resp.Embedded.Sandboxes...)
+		}
+
+		if resp.Page == nil || resp.Page.TotalPages == nil || int64(page)+1 >= *resp.Page.TotalPages {
+			return sandboxes, nil
+		}
+	}
+}
+
+// getSandboxesPage retrieves one page of an application's sandboxes
+func (c *Client) getSandboxesPage(authCtx context.Context, applicationGUID string, page int32) (*applications.PagedResourceOfSandbox, error) {
+	resp, httpResp, err := c.applicationsClient.SandboxInformationAPIAPI.GetSandboxesUsingGET(authCtx, applicationGUID).
+		Page(page).
+		Size(sandboxPageSize).
+		Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to list sandboxes: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetSandboxByName resolves a sandbox name (case-insensitive) to the application's sandbox.
+// A sandbox GUID is also accepted and matched against the sandbox GUIDs.
+func (c *Client) GetSandboxByName(ctx context.Context, applicationGUID, name string) (*applications.Sandbox, error) {
+	sandboxes, err := c.ListSandboxes(ctx, applicationGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sandboxes {
+		sandbox := &sandboxes[i]
+		if sandbox.Guid != nil && strings.EqualFold(*sandbox.Guid, name) {
+			return sandbox, nil
+		}
+		if sandbox.Name != nil && equalFoldStrings(*sandbox.Name, name) {
+			return sandbox, nil
+		}
+	}
+
+	available := make([]string, 0, len(sandboxes))
+	for _, sandbox := range sandboxes {
+		if sandbox.Name != nil {
+			available = append(available, *sandbox.Name)
+		}
+	}
+	if len(available) == 0 {
+		return nil, fmt.Errorf("sandbox not found: %s (the application has no sandboxes)", name)
+	}
+	return nil, fmt.Errorf("sandbox not found: %s (available sandboxes: %s)", name, strings.Join(available, ", "))
+}
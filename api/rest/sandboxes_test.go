@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newSandboxTestServer serves two pages of sandboxes and records the context of findings requests
+func newSandboxTestServer(t *testing.T, findingsContext *string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/findings") {
+			*findingsContext = r.URL.Query().Get("context")
+			fmt.Fprint(w, `{"_embedded": {"findings": []}, "page": {"number": 0, "size": 10, "total_elements": 0, "total_pages": 0}}`)
+			return
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		sandboxes := []string{
+			`{"guid": "11111111-1111-1111-1111-111111111111", "name": "Development"}`,
+			`{"guid": "22222222-2222-2222-2222-222222222222", "name": "feature-login"}`,
+		}
+		fmt.Fprintf(w, `{"_embedded": {"sandboxes": [%s]}, "page": {"number": %d, "size": 1, "total_elements": 2, "total_pages": 2}}`,
+			sandboxes[page], page)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetSandboxByName(t *testing.T) {
+	var findingsContext string
+	server := newSandboxTestServer(t, &findingsContext)
+	client := newTestClient(server.URL)
+
+	sandbox, err := client.GetSandboxByName(context.Background(), "app-guid", "FEATURE-LOGIN")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *sandbox.Guid != "22222222-2222-2222-2222-222222222222" {
+		t.Errorf("Expected the sandbox from the second page, got %s", *sandbox.Guid)
+	}
+
+	byGUID, err := client.GetSandboxByName(context.Background(), "app-guid", "11111111-1111-1111-1111-111111111111")
+	if err != nil || *byGUID.Name != "Development" {
+		t.Errorf("Expected lookup by GUID to find Development, got %v, %v", byGUID, err)
+	}
+}
+
+func TestGetSandboxByName_NotFound(t *testing.T) {
+	var findingsContext string
+	server := newSandboxTestServer(t, &findingsContext)
+	client := newTestClient(server.URL)
+
+	_, err := client.GetSandboxByName(context.Background(), "app-guid", "missing")
+	if err == nil {
+		t.Fatal("Expected error for unknown sandbox")
+	}
+	if !strings.Contains(err.Error(), "Development, feature-login") {
+		t.Errorf("Expected available sandboxes in error, got: %v", err)
+	}
+}
+
+func TestGetFindings_SendsSandboxAsContext(t *testing.T) {
+	var findingsContext string
+	server := newSandboxTestServer(t, &findingsContext)
+	client := newTestClient(server.URL)
+
+	req := FindingsRequest{AppProfile: "app-guid", Sandbox: "22222222-2222-2222-2222-222222222222", Size: 10}
+	if _, err := client.GetStaticFindings(context.Background(), req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if findingsContext != req.Sandbox {
+		t.Errorf("Expected context %s, got %q", req.Sandbox, findingsContext)
+	}
+
+	req.Sandbox = ""
+	if _, err := client.GetStaticFindings(context.Background(), req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if findingsContext != "" {
+		t.Errorf("Expected no context for the policy scan, got %q", findingsContext)
+	}
+}
@@ -0,0 +1,88 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/policy"
+)
+
+// scaLicensePageSize is the page size used when listing SCA licenses
+const scaLicensePageSize = 500
+
+// scaLicensesPage mirrors policy.PagedResourceOfScaLicenseSummary with the page metadata
+// the API returns but the spec leaves out
+type scaLicensesPage struct {
+	Embedded *policy.EmbeddedScaLicenseSummary `json:"_embedded,omitempty"`
+	Page     *struct {
+		TotalPages *int32 `json:"total_pages,omitempty"`
+	} `json:"page,omitempty"`
+}
+
+// ListScaLicenses retrieves every license SCA policies can reference, with its full name, SPDX ID and risk
+func (c *Client) ListScaLicenses(ctx context.Context) ([]policy.ScaLicenseSummary, error) {
+	if !c.IsConfigured() {
+		return nil, fmt.Errorf("API credentials not configured. Set VERACODE_API_ID and VERACODE_API_KEY")
+	}
+
+	authCtx := c.GetAuthContext(ctx)
+
+	var licenses []policy.ScaLicenseSummary
+	for page := int32(0); ; page++ {
+		resp, err := c.getScaLicensesPage(authCtx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageLicenses []policy.ScaLicenseSummary
+		if resp.Embedded != nil {
+			pageLicenses = resp.Embedded.ScaLicenseSummaries
+		}
+		licenses = append(licenses, pageLicenses...)
+
+		// Read up to total_pages; without page metadata, until an empty page
+		if resp.Page != nil && resp.Page.TotalPages != nil {
+			if page+1 >= *resp.Page.TotalPages {
+				return licenses, nil
+			}
+		} else if len(pageLicenses) == 0 {
+			return licenses, nil
+		}
+	}
+}
+
+// getScaLicensesPage retrieves one page of SCA licenses. The generated client's decoded result
+// is ignored, as it has no page metadata; the body is decoded into scaLicensesPage instead.
+func (c *Client) getScaLicensesPage(authCtx context.Context, page int32) (*scaLicensesPage, error) {
+	_, httpResp, err := c.policyClient.SCAComponentLicenseInformationAPIAPI.GetScaLicensesUsingGET(authCtx).
+		Page(page).
+		Size(scaLicensePageSize).
+		Execute()
+	if httpResp != nil && httpResp.Body != nil {
+		defer func() {
+			if closeErr := httpResp.Body.Close(); closeErr != nil {
+				log.Printf("Failed to close response body: %v", closeErr)
+			}
+		}()
+	}
+
+	if err != nil {
+		if httpResp != nil {
+			return nil, fmt.Errorf("API returned status %d: %w", httpResp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to list SCA licenses: %w", err)
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SCA licenses response: %w", err)
+	}
+	var resp scaLicensesPage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode SCA licenses response: %w", err)
+	}
+	return &resp, nil
+}
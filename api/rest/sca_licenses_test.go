@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestListScaLicenses(t *testing.T) {
+	// Pages are read up to total_pages, even when one comes back short
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/appsec/v1/policy_licenselist" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		count := scaLicensePageSize
+		if page == 0 {
+			count = 2
+		}
+		if page == 2 {
+			count = 1
+		}
+		licenses := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			licenses = append(licenses, fmt.Sprintf(`{"spdx_id": "LIC-%d-%d", "name": "License %d", "risk": "Low"}`, page, i, i))
+		}
+		fmt.Fprintf(w, `{"_embedded": {"sca_license_summaries": [%s]}, "page": {"number": %d, "size": %d, "total_pages": 3}}`,
+			strings.Join(licenses, ","), page, scaLicensePageSize)
+	}))
+	defer server.Close()
+
+	licenses, err := newTestClient(server.URL).ListScaLicenses(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 3 || len(licenses) != scaLicensePageSize+3 {
+		t.Fatalf("Expected %d licenses from 3 pages, got %d from %d", scaLicensePageSize+3, len(licenses), requests)
+	}
+	if licenses[len(licenses)-1].GetSpdxId() != "LIC-2-0" {
+		t.Errorf("Expected the last license from the last page, got %q", licenses[len(licenses)-1].GetSpdxId())
+	}
+}
+
+func TestListScaLicenses_WithoutPageMetadata(t *testing.T) {
+	// Without page metadata, pages are read until an empty one
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if page, _ := strconv.Atoi(r.URL.Query().Get("page")); page > 1 {
+			fmt.Fprint(w, `{"_embedded": {"sca_license_summaries": []}}`)
+			return
+		}
+		fmt.Fprint(w, `{"_embedded": {"sca_license_summaries": [{"spdx_id": "MIT", "name": "MIT"}]}}`)
+	}))
+	defer server.Close()
+
+	licenses, err := newTestClient(server.URL).ListScaLicenses(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(licenses) != 2 {
+		t.Errorf("Expected a license from each of the first two pages, got %d", len(licenses))
+	}
+}
@@ -1,5 +1,7 @@
 package rest
 
+import "time"
+
 // HealthStatus represents the result of a health check
 type HealthStatus struct {
 	Available  bool   `json:"available"`
@@ -10,38 +12,109 @@ type HealthStatus struct {
 // FindingsRequest represents common parameters for findings queries
 type FindingsRequest struct {
 	AppProfile     string   `json:"app_profile"`
-	Sandbox        string   `json:"sandbox,omitempty"`
+	Sandbox        string   `json:"sandbox,omitempty"`      // Sandbox GUID, sent as the findings context; empty for the policy scan
 	Severity       *int32   `json:"severity,omitempty"`     // Filter for exact severity value (0-5)
 	SeverityGte    *int32   `json:"severity_gte,omitempty"` // Filter for severity >= value (0-5)
 	Status         []string `json:"status,omitempty"`
 	CWEIDs         []string `json:"cwe_ids,omitempty"`
 	ViolatesPolicy *bool    `json:"violates_policy,omitempty"`
+	IncludeAnnot   bool     `json:"include_annot,omitempty"` // Include mitigation/comment annotations on each finding
 	Page           int      `json:"page,omitempty"`
 	Size           int      `json:"size,omitempty"`
+
+	FindingCategory []int32    `json:"finding_category,omitempty"` // Finding category IDs (not valid for SCA)
+	MitigatedAfter  *time.Time `json:"mitigated_after,omitempty"`  // Only findings with annotations after this date (not valid for SCA)
+	New             bool       `json:"new,omitempty"`              // Only findings new in the current policy or sandbox context
+	IncludeExpDate  bool       `json:"include_exp_date,omitempty"` // Include the policy grace period expiry date on each finding
+	CVE             string     `json:"cve,omitempty"`              // CVE ID (SCA only)
+	CVSS            *float64   `json:"cvss,omitempty"`             // Exact CVSS score, 0-10 (SCA only)
+	CVSSGte         *float64   `json:"cvss_gte,omitempty"`         // CVSS score >= value, 0-10 (SCA only)
+	ScaDepMode      string     `json:"sca_dep_mode,omitempty"`     // DIRECT, TRANSITIVE, BOTH or UNKNOWN (SCA only)
+	ScaScanMode     string     `json:"sca_scan_mode,omitempty"`    // UPLOAD, AGENT or BOTH (SCA only)
+}
+
+// ApplicationsRequest represents the filters for searching application profiles
+type ApplicationsRequest struct {
+	Name                         string     `json:"name,omitempty"` // Substring match on the application name
+	BusinessUnit                 string     `json:"business_unit,omitempty"`
+	Team                         string     `json:"team,omitempty"`
+	Tag                          string     `json:"tag,omitempty"`
+	Policy                       string     `json:"policy,omitempty"`
+	PolicyGUID                   string     `json:"policy_guid,omitempty"`
+	PolicyCompliance             string     `json:"policy_compliance,omitempty"` // PASSED, CONDITIONAL_PASS, DID_NOT_PASS, NOT_ASSESSED, DETERMINING or VENDOR_REVIEW
+	PolicyComplianceCheckedAfter *time.Time `json:"policy_compliance_checked_after,omitempty"`
+	ScanType                     string     `json:"scan_type,omitempty"` // STATIC, DYNAMIC or MANUAL
+	ScanStatus                   []string   `json:"scan_status,omitempty"`
+	ModifiedAfter                *time.Time `json:"modified_after,omitempty"`
+	CustomFieldNames             []string   `json:"custom_field_names,omitempty"`
+	CustomFieldValues            []string   `json:"custom_field_values,omitempty"`
+	Page                         int        `json:"page,omitempty"`
+	Size                         int        `json:"size,omitempty"`
 }
 
 // Finding represents a security finding (SAST, DAST, or SCA)
 type Finding struct {
-	ID                string                 `json:"id"`
-	BuildID           int64                  `json:"build_id,omitempty"` // Build/scan ID where this finding was discovered
-	Severity          string                 `json:"severity"`           // Numeric severity as string for backward compatibility
-	SeverityScore     int32                  `json:"severity_score"`     // Numeric severity score (0-5)
-	CWE               string                 `json:"cwe"`
-	Status            string                 `json:"status"`
-	ResolutionStatus  string                 `json:"resolution_status"` // Mitigation status (PROPOSED, APPROVED, REJECTED, etc.)
-	Description       string                 `json:"description"`
-	FilePath          string                 `json:"file_path,omitempty"`     // SAST only
-	LineNumber        int                    `json:"line_number,omitempty"`   // SAST only
-	Module            string                 `json:"module,omitempty"`        // SAST only
-	Procedure         string                 `json:"procedure,omitempty"`     // SAST only
-	AttackVector      string                 `json:"attack_vector,omitempty"` // SAST only
-	URL               string                 `json:"url,omitempty"`           // DAST only
-	ViolatesPolicy    bool                   `json:"violates_policy"`
-	AdditionalInfo    map[string]interface{} `json:"additional_info,omitempty"`
-	ComponentFilename string                 `json:"component_filename,omitempty"` // SCA only
-	ComponentVersion  string                 `json:"component_version,omitempty"`  // SCA only
-	CVE               string                 `json:"cve,omitempty"`                // SCA only
-	Licenses          []License              `json:"licenses,omitempty"`           // SCA only
+	ID                 string                 `json:"id"`
+	ScanType           string                 `json:"scan_type,omitempty"` // STATIC, DYNAMIC, MANUAL or SCA
+	BuildID            int64                  `json:"build_id,omitempty"`  // Build/scan ID where this finding was discovered
+	Severity           string                 `json:"severity"`            // Numeric severity as string for backward compatibility
+	SeverityScore      int32                  `json:"severity_score"`      // Numeric severity score (0-5)
+	CWE                string                 `json:"cwe"`
+	Status             string                 `json:"status"`
+	ResolutionStatus   string                 `json:"resolution_status"` // Mitigation status (PROPOSED, APPROVED, REJECTED, etc.)
+	Description        string                 `json:"description"`
+	FilePath           string                 `json:"file_path,omitempty"`     // SAST only
+	LineNumber         int                    `json:"line_number,omitempty"`   // SAST only
+	Module             string                 `json:"module,omitempty"`        // SAST and MANUAL
+	Procedure          string                 `json:"procedure,omitempty"`     // SAST only
+	AttackVector       string                 `json:"attack_vector,omitempty"` // SAST only
+	CategoryID         int32                  `json:"category_id,omitempty"`   // SAST only
+	CategoryName       string                 `json:"category_name,omitempty"` // SAST only
+	URL                string                 `json:"url,omitempty"`           // DAST and MANUAL
+	ViolatesPolicy     bool                   `json:"violates_policy"`
+	AdditionalInfo     map[string]interface{} `json:"additional_info,omitempty"`
+	ComponentFilename  string                 `json:"component_filename,omitempty"`   // SCA only
+	ComponentVersion   string                 `json:"component_version,omitempty"`    // SCA only
+	CVE                string                 `json:"cve,omitempty"`                  // SCA only
+	CVSS               float64                `json:"cvss,omitempty"`                 // SCA only; CVSS v3 score, falling back to v2
+	Licenses           []License              `json:"licenses,omitempty"`             // SCA only
+	CapecID            string                 `json:"capec_id,omitempty"`             // MANUAL only
+	Annotations        []Annotation           `json:"annotations,omitempty"`          // Only populated when IncludeAnnot is set
+	GracePeriodExpires string                 `json:"grace_period_expires,omitempty"` // RFC3339; only populated when IncludeExpDate is set
+	DetailsError       string                 `json:"details_error,omitempty"`        // Why finding_details could not be decoded; its fields are then unset
+}
+
+// Annotation represents a mitigation proposal, review decision or comment on a finding
+type Annotation struct {
+	Action        string `json:"action"`
+	Comment       string `json:"comment,omitempty"`
+	UserName      string `json:"user_name,omitempty"`
+	Created       string `json:"created,omitempty"` // RFC3339
+	Technique     string `json:"technique,omitempty"`
+	Specifics     string `json:"specifics,omitempty"`
+	RemainingRisk string `json:"remaining_risk,omitempty"`
+	Verification  string `json:"verification,omitempty"`
+}
+
+// ManualFindingDetail is a manual penetration test finding from the Manual Scans API.
+// It carries the tester's write-up, which the Findings API doesn't return.
+type ManualFindingDetail struct {
+	ID                 int64   `json:"id"`
+	IssueID            string  `json:"issue_id"` // The finding's issue_id in the Findings API
+	ScanID             int32   `json:"scan_id"`
+	ScanName           string  `json:"scan_name,omitempty"`
+	CapecID            int32   `json:"capec_id,omitempty"`
+	CWE                int32   `json:"cwe,omitempty"`
+	RemediationEffort  int32   `json:"remediation_effort,omitempty"` // 1 (trivial) to 5 (complex), from the CWE API
+	Exploitability     *int32  `json:"exploitability,omitempty"`
+	ExploitDifficulty  int32   `json:"exploit_difficulty,omitempty"`
+	ExploitDescription string  `json:"exploit_description,omitempty"` // How the tester exploited the finding
+	InputVector        string  `json:"input_vector,omitempty"`
+	Location           string  `json:"location,omitempty"`
+	Module             string  `json:"module,omitempty"`
+	Remediation        string  `json:"remediation,omitempty"`
+	SeverityRationale  string  `json:"severity_rationale,omitempty"`
+	CVSS               float64 `json:"cvss,omitempty"`
 }
 
 // License represents SCA component license information
@@ -54,6 +127,9 @@ type License struct {
 type FindingsResponse struct {
 	Findings   []Finding `json:"findings"`
 	TotalCount int       `json:"total_count"`
+	TotalPages int       `json:"total_pages"`
 	Page       int       `json:"page"`
 	Size       int       `json:"size"`
+	AllPages   bool      `json:"all_pages,omitempty"` // Findings were collected from every page (FetchAllFindings)
+	Truncated  bool      `json:"truncated,omitempty"` // FetchAllFindings stopped at its result cap
 }
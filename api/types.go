@@ -1,6 +1,9 @@
 package api
 
-import "github.com/dipsylala/veracode-mcp/api/rest"
+import (
+	"github.com/dipsylala/veracode-mcp/api/rest"
+	"github.com/dipsylala/veracode-mcp/api/xml"
+)
 
 // Re-export types from rest package so consumers can import just "api"
 type (
@@ -13,13 +16,58 @@ type (
 	// FindingsRequest contains parameters for querying findings
 	FindingsRequest = rest.FindingsRequest
 
+	// ApplicationsRequest contains filters for searching application profiles
+	ApplicationsRequest = rest.ApplicationsRequest
+
 	// Finding represents a single security finding (SAST/DAST/SCA)
 	Finding = rest.Finding
 
 	// License represents license information for SCA findings
 	License = rest.License
+
+	// Annotation represents a mitigation or comment recorded against a finding
+	Annotation = rest.Annotation
+
+	// ManualFindingDetail represents a manual penetration test finding from the Manual Scans API
+	ManualFindingDetail = rest.ManualFindingDetail
+)
+
+// Re-export detailed report types from xml package. The report is read-only and is
+// often saved for offline use, so it keeps its XML form rather than being wrapped.
+type (
+	// DetailedReport is every flaw of a build, grouped by severity, category and CWE, with its SCA components
+	DetailedReport = xml.DetailedReport
+
+	// ReportFlaw is a static or dynamic flaw in a detailed report
+	ReportFlaw = xml.Flaw
+
+	// ReportMitigation is an entry in a flaw's mitigation history
+	ReportMitigation = xml.ReportMitigation
+
+	// ReportComponent is a third-party component in a detailed report
+	ReportComponent = xml.ReportComponent
 )
 
+// ParseDetailedReport parses a detailed report document, such as one cached from DownloadDetailedReport
+func ParseDetailedReport(data []byte) (*DetailedReport, error) {
+	return xml.ParseDetailedReport(data)
+}
+
+// ErrFindingNotFound is returned by GetFindingByID when no scan type contains the finding
+var ErrFindingNotFound = rest.ErrFindingNotFound
+
+// MaxFetchAllFindings is the hard cap on the number of findings FetchAllFindings returns
+const MaxFetchAllFindings = rest.MaxFetchAllFindings
+
+// MaxMitigationCommentLength is the longest comment UpdateMitigationInfo accepts
+const MaxMitigationCommentLength = xml.MaxMitigationCommentLength
+
+// IsMitigationReviewAction reports whether a mitigation action approves or rejects a mitigation
+// (accepted, rejected) rather than proposing one
+func IsMitigationReviewAction(action string) bool {
+	return xml.IsReviewAction(xml.ActionType(action))
+}
+
 // MitigationInfo represents the root element of the mitigation info response
 // This is a wrapper around xml.MitigationInfo that provides a cleaner API
 // without XML serialization tags.
@@ -51,3 +99,32 @@ type MitigationError struct {
 	Type       string
 	FlawIDList string
 }
+
+// BuildInfo represents the status of a platform scan build
+// This is a wrapper around xml.BuildInfo without XML serialization tags.
+type BuildInfo struct {
+	AppID                  int64
+	SandboxID              int64
+	BuildID                int64
+	Version                string // The scan name
+	Submitter              string
+	PolicyName             string
+	PolicyComplianceStatus string
+	ResultsReady           bool
+	AnalysisStatus         string // e.g. Incomplete, Pre-Scan Submitted, Scan In Process, Results Ready
+	PublishedDate          string
+}
+
+// BuildSummary represents a build in an application's or sandbox's build list
+type BuildSummary struct {
+	BuildID           int64
+	Version           string // The scan name
+	PolicyUpdatedDate string
+}
+
+// UploadedFile represents a file uploaded to a platform scan build
+type UploadedFile struct {
+	FileID     int64
+	FileName   string
+	FileStatus string
+}
@@ -0,0 +1,125 @@
+package xml
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// buildParams returns the app_id parameter, with sandbox_id when the build belongs to a sandbox
+func buildParams(appID, sandboxID int64) url.Values {
+	params := url.Values{}
+	params.Set("app_id", strconv.FormatInt(appID, 10))
+	if sandboxID != 0 {
+		params.Set("sandbox_id", strconv.FormatInt(sandboxID, 10))
+	}
+	return params
+}
+
+// CreateBuild creates a new build for an application or sandbox
+// appID: the application's numeric ID
+// sandboxID: the sandbox's numeric ID, or 0 for a policy scan
+// version: the scan name, which must be unique within the application or sandbox
+func (c *Client) CreateBuild(ctx context.Context, appID, sandboxID int64, version string) (*BuildInfo, error) {
+	if version == "" {
+		return nil, fmt.Errorf("a scan name is required")
+	}
+
+	params := buildParams(appID, sandboxID)
+	params.Set("version", version)
+
+	body, err := c.doRequest(ctx, "POST", "/5.0/createbuild.do", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var buildInfo BuildInfo
+	if err := unmarshalResponse(body, &buildInfo); err != nil {
+		return nil, err
+	}
+	return &buildInfo, nil
+}
+
+// UploadFile uploads an artifact to the application's or sandbox's current build
+func (c *Client) UploadFile(ctx context.Context, appID, sandboxID int64, filePath string) (*FileList, error) {
+	body, err := c.doMultipartRequest(ctx, "/5.0/uploadfile.do", buildParams(appID, sandboxID), "file", filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileList FileList
+	if err := unmarshalResponse(body, &fileList); err != nil {
+		return nil, err
+	}
+	return &fileList, nil
+}
+
+// BeginPrescan starts the pre-scan of the current build. With autoScan, the scan starts
+// as soon as the pre-scan succeeds, covering every top-level module without fatal errors.
+func (c *Client) BeginPrescan(ctx context.Context, appID, sandboxID int64, autoScan bool) (*BuildInfo, error) {
+	params := buildParams(appID, sandboxID)
+	params.Set("auto_scan", strconv.FormatBool(autoScan))
+	if autoScan {
+		params.Set("scan_all_nonfatal_top_level_modules", "true")
+	}
+
+	body, err := c.doRequest(ctx, "POST", "/5.0/beginprescan.do", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var buildInfo BuildInfo
+	if err := unmarshalResponse(body, &buildInfo); err != nil {
+		return nil, err
+	}
+	return &buildInfo, nil
+}
+
+// GetBuildInfo retrieves the status of a build
+// buildID: the build to query, or 0 for the most recent build
+func (c *Client) GetBuildInfo(ctx context.Context, appID, sandboxID, buildID int64) (*BuildInfo, error) {
+	params := buildParams(appID, sandboxID)
+	if buildID != 0 {
+		params.Set("build_id", strconv.FormatInt(buildID, 10))
+	}
+
+	body, err := c.doRequest(ctx, "GET", "/5.0/getbuildinfo.do", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var buildInfo BuildInfo
+	if err := unmarshalResponse(body, &buildInfo); err != nil {
+		return nil, err
+	}
+	return &buildInfo, nil
+}
+
+// GetBuildList lists the builds of an application or sandbox, oldest first
+func (c *Client) GetBuildList(ctx context.Context, appID, sandboxID int64) (*BuildList, error) {
+	body, err := c.doRequest(ctx, "GET", "/5.0/getbuildlist.do", buildParams(appID, sandboxID))
+	if err != nil {
+		return nil, err
+	}
+
+	var buildList BuildList
+	if err := unmarshalResponse(body, &buildList); err != nil {
+		return nil, err
+	}
+	return &buildList, nil
+}
+
+// DeleteBuild deletes the most recent build of an application or sandbox, returning the builds that remain
+func (c *Client) DeleteBuild(ctx context.Context, appID, sandboxID int64) (*BuildList, error) {
+	body, err := c.doRequest(ctx, "POST", "/5.0/deletebuild.do", buildParams(appID, sandboxID))
+	if err != nil {
+		return nil, err
+	}
+
+	var buildList BuildList
+	if err := unmarshalResponse(body, &buildList); err != nil {
+		return nil, err
+	}
+	return &buildList, nil
+}
@@ -0,0 +1,160 @@
+package xml
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testBuildInfoXML = `<?xml version="1.0" encoding="UTF-8"?>
+<buildinfo xmlns="https://analysiscenter.veracode.com/schema/4.0/buildinfo" buildinfo_version="1.5" account_id="1" app_id="100" sandbox_id="200" build_id="300">
+  <build version="MCP scan" build_id="300" submitter="dev@example.com" platform="Not Specified" lifecycle_stage="Not Specified" results_ready="%s" policy_name="Veracode Recommended Medium" policy_compliance_status="Not Assessed">
+    <analysis_unit analysis_type="Static" status="%s"/>
+  </build>
+</buildinfo>`
+
+// newBuildTestServer records each request's path, query and uploaded file, and answers like the build endpoints
+func newBuildTestServer(t *testing.T, requests *[]*http.Request, uploads *[]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+
+		switch r.URL.Path {
+		case "/5.0/createbuild.do":
+			fmt.Fprintf(w, testBuildInfoXML, "false", "Incomplete")
+		case "/5.0/uploadfile.do":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, _ := io.ReadAll(file)
+			*uploads = append(*uploads, header.Filename+":"+string(content))
+			fmt.Fprintf(w, `<filelist xmlns="https://analysiscenter.veracode.com/schema/2.0/filelist" app_id="100" sandbox_id="200" build_id="300"><file file_id="1" file_name=%q file_status="Uploaded"/></filelist>`, header.Filename)
+		case "/5.0/beginprescan.do":
+			fmt.Fprintf(w, testBuildInfoXML, "false", "Pre-Scan Submitted")
+		case "/5.0/getbuildinfo.do":
+			if r.URL.Query().Get("build_id") == "999" {
+				fmt.Fprint(w, `<error>Could not find build 999</error>`)
+				return
+			}
+			fmt.Fprintf(w, testBuildInfoXML, "true", "Results Ready")
+		case "/5.0/deletebuild.do":
+			fmt.Fprint(w, `<buildlist xmlns="https://analysiscenter.veracode.com/schema/2.0/buildlist" app_id="100" sandbox_id="200"><build build_id="250" version="Earlier scan"/></buildlist>`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestBuildLifecycle(t *testing.T) {
+	var requests []*http.Request
+	var uploads []string
+	client := newTestClient(newBuildTestServer(t, &requests, &uploads).URL)
+	ctx := context.Background()
+
+	artifact := filepath.Join(t.TempDir(), "app.zip")
+	if err := os.WriteFile(artifact, []byte("zip-bytes"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	buildInfo, err := client.CreateBuild(ctx, 100, 200, "MCP scan")
+	if err != nil {
+		t.Fatalf("CreateBuild failed: %v", err)
+	}
+	if buildInfo.BuildID != 300 || buildInfo.Build.Version != "MCP scan" || buildInfo.Build.AnalysisUnit.Status != "Incomplete" {
+		t.Errorf("Unexpected build info: %+v", buildInfo)
+	}
+
+	fileList, err := client.UploadFile(ctx, 100, 200, artifact)
+	if err != nil {
+		t.Fatalf("UploadFile failed: %v", err)
+	}
+	if len(fileList.Files) != 1 || fileList.Files[0].FileName != "app.zip" {
+		t.Errorf("Unexpected file list: %+v", fileList)
+	}
+	if len(uploads) != 1 || uploads[0] != "app.zip:zip-bytes" {
+		t.Errorf("Expected the artifact to be uploaded as multipart form data, got %v", uploads)
+	}
+
+	if _, err := client.BeginPrescan(ctx, 100, 200, true); err != nil {
+		t.Fatalf("BeginPrescan failed: %v", err)
+	}
+
+	buildInfo, err = client.GetBuildInfo(ctx, 100, 200, 300)
+	if err != nil {
+		t.Fatalf("GetBuildInfo failed: %v", err)
+	}
+	if !buildInfo.Build.ResultsReady || buildInfo.Build.PolicyComplianceStatus != "Not Assessed" {
+		t.Errorf("Unexpected build info: %+v", buildInfo)
+	}
+
+	remaining, err := client.DeleteBuild(ctx, 100, 200)
+	if err != nil {
+		t.Fatalf("DeleteBuild failed: %v", err)
+	}
+	if len(remaining.Builds) != 1 || remaining.Builds[0].BuildID != 250 {
+		t.Errorf("Unexpected remaining builds: %+v", remaining)
+	}
+
+	expected := []struct {
+		method, path string
+		query        map[string]string
+	}{
+		{http.MethodPost, "/5.0/createbuild.do", map[string]string{"app_id": "100", "sandbox_id": "200", "version": "MCP scan"}},
+		{http.MethodPost, "/5.0/uploadfile.do", map[string]string{"app_id": "100", "sandbox_id": "200"}},
+		{http.MethodPost, "/5.0/beginprescan.do", map[string]string{"auto_scan": "true", "scan_all_nonfatal_top_level_modules": "true"}},
+		{http.MethodGet, "/5.0/getbuildinfo.do", map[string]string{"build_id": "300"}},
+		{http.MethodPost, "/5.0/deletebuild.do", map[string]string{"app_id": "100", "sandbox_id": "200"}},
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected %d requests, got %d", len(expected), len(requests))
+	}
+	for i, want := range expected {
+		got := requests[i]
+		if got.Method != want.method || got.URL.Path != want.path {
+			t.Errorf("Request %d: expected %s %s, got %s %s", i, want.method, want.path, got.Method, got.URL.Path)
+		}
+		for key, value := range want.query {
+			if got.URL.Query().Get(key) != value {
+				t.Errorf("Request %d: expected %s=%q, got %q", i, key, value, got.URL.Query().Get(key))
+			}
+		}
+	}
+}
+
+func TestBuildParams_PolicyScan(t *testing.T) {
+	params := buildParams(100, 0)
+	if params.Get("app_id") != "100" || params.Has("sandbox_id") {
+		t.Errorf("Expected only app_id for a policy scan, got %v", params)
+	}
+}
+
+func TestGetBuildInfo_APIError(t *testing.T) {
+	var requests []*http.Request
+	var uploads []string
+	client := newTestClient(newBuildTestServer(t, &requests, &uploads).URL)
+
+	_, err := client.GetBuildInfo(context.Background(), 100, 0, 999)
+	if err == nil || !strings.Contains(err.Error(), "Could not find build 999") {
+		t.Errorf("Expected the API error message, got %v", err)
+	}
+}
+
+func TestUploadFile_MissingFile(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+
+	if _, err := client.UploadFile(context.Background(), 100, 0, filepath.Join(t.TempDir(), "missing.zip")); err == nil {
+		t.Error("Expected error for a missing file")
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/dipsylala/veracode-mcp/credentials"
@@ -20,25 +24,29 @@ const (
 
 // Client wraps HTTP client with HMAC authentication for Veracode XML API
 type Client struct {
-	apiID   string
-	apiKey  string
+	creds   credentials.Provider
 	baseURL string
 	client  *http.Client
 }
 
 // NewClient creates a new Veracode XML API client
 // Credentials are loaded from:
-// 1. ~/.veracode/veracode.yml (preferred)
-// 2. Environment variables VERACODE_API_ID and VERACODE_API_KEY (fallback)
+// 1. ~/.veracode/veracode.yml (preferred), either key-id/key-secret or credential-process
+// 2. Environment variables VERACODE_API_ID and VERACODE_API_KEY, or VERACODE_CREDENTIAL_PROCESS (fallback)
 func NewClient() (*Client, error) {
-	apiID, apiKey, _, err := credentials.GetCredentials()
+	return NewClientForProfile("")
+}
+
+// NewClientForProfile creates a client with the credentials of a named profile of veracode.yml.
+// An empty profile uses the default credentials, like NewClient.
+func NewClientForProfile(profile string) (*Client, error) {
+	creds, _, err := credentials.GetProfileCredentialProvider(profile)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Client{
-		apiID:   apiID,
-		apiKey:  apiKey,
+		creds:   creds,
 		baseURL: DefaultBaseURL,
 		client:  http.DefaultClient,
 	}, nil
@@ -47,11 +55,10 @@ func NewClient() (*Client, error) {
 // NewClientUnconfigured creates a client without checking credentials
 // Useful for testing or when credentials will be set later
 func NewClientUnconfigured() *Client {
-	apiID, apiKey, _, _ := credentials.GetCredentials()
+	creds, _, _ := credentials.GetCredentialProvider()
 
 	return &Client{
-		apiID:   apiID,
-		apiKey:  apiKey,
+		creds:   creds,
 		baseURL: DefaultBaseURL,
 		client:  http.DefaultClient,
 	}
@@ -59,11 +66,56 @@ func NewClientUnconfigured() *Client {
 
 // IsConfigured returns true if API credentials are set
 func (c *Client) IsConfigured() bool {
-	return c.apiID != "" && c.apiKey != ""
+	return c.creds != nil
 }
 
+// requestBody opens a fresh request body and returns it with its content type.
+// It is called again if the request has to be retried.
+type requestBody func() (io.ReadCloser, string, error)
+
 // doRequest performs an HTTP request with HMAC authentication
 func (c *Client) doRequest(ctx context.Context, method, endpoint string, params url.Values) ([]byte, error) {
+	return c.send(ctx, method, endpoint, params, nil)
+}
+
+// doMultipartRequest POSTs a file as multipart/form-data with HMAC authentication.
+// The file is streamed from disk rather than read into memory.
+func (c *Client) doMultipartRequest(ctx context.Context, endpoint string, params url.Values, fieldName, filePath string) ([]byte, error) {
+	if _, err := os.Stat(filePath); err != nil {
+		return nil, fmt.Errorf("failed to access file: %w", err)
+	}
+	return c.send(ctx, http.MethodPost, endpoint, params, multipartFileBody(fieldName, filePath))
+}
+
+// multipartFileBody returns a requestBody that streams filePath as the multipart form field fieldName
+func multipartFileBody(fieldName, filePath string) requestBody {
+	return func() (io.ReadCloser, string, error) {
+		// #nosec G304 -- filePath is an artifact the caller chose to upload
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open file: %w", err)
+		}
+
+		pipeReader, pipeWriter := io.Pipe()
+		writer := multipart.NewWriter(pipeWriter)
+		go func() {
+			defer file.Close()
+			part, err := writer.CreateFormFile(fieldName, filepath.Base(filePath))
+			if err == nil {
+				_, err = io.Copy(part, file)
+			}
+			if err == nil {
+				err = writer.Close()
+			}
+			pipeWriter.CloseWithError(err)
+		}()
+
+		return pipeReader, writer.FormDataContentType(), nil
+	}
+}
+
+// send builds the signed request URL, executes the request and returns the response body
+func (c *Client) send(ctx context.Context, method, endpoint string, params url.Values, body requestBody) ([]byte, error) {
 	if !c.IsConfigured() {
 		return nil, fmt.Errorf("API credentials not configured")
 	}
@@ -86,16 +138,75 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, params
 		parsedURL.RawQuery = normalizedQuery
 	}
 
+	resp, apiID, err := c.signAndSend(ctx, method, parsedURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// On 401, refresh the credentials and retry once if the provider hands back different ones
+	if resp.StatusCode == http.StatusUnauthorized {
+		c.creds.Invalidate()
+		if newID, _, retrieveErr := c.creds.Retrieve(ctx); retrieveErr == nil && newID != apiID {
+			resp.Body.Close()
+			resp, _, err = c.signAndSend(ctx, method, parsedURL, body)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// Check status code
+	if resp.StatusCode != http.StatusOK {
+		return respBody, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// signAndSend builds the request, adds the HMAC Authorization header using the current
+// credentials and executes it. It returns the API key ID used for signing.
+func (c *Client) signAndSend(ctx context.Context, method string, parsedURL *url.URL, body requestBody) (*http.Response, string, error) {
+	apiID, apiKey, err := c.creds.Retrieve(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve API credentials: %w", err)
+	}
+
+	// Open the request body, if any
+	var reqBody io.ReadCloser
+	var contentType string
+	if body != nil {
+		reqBody, contentType, err = body()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
 	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, parsedURL.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, method, parsedURL.String(), reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		if reqBody != nil {
+			reqBody.Close()
+		}
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	// Calculate HMAC authorization header
-	authHeader, err := veracodehmac.CalculateAuthorizationHeader(parsedURL, method, c.apiID, c.apiKey)
+	authHeader, err := veracodehmac.CalculateAuthorizationHeader(parsedURL, method, apiID, apiKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate HMAC authorization: %w", err)
+		if reqBody != nil {
+			reqBody.Close()
+		}
+		return nil, "", fmt.Errorf("failed to calculate HMAC authorization: %w", err)
 	}
 
 	// Add authorization header
@@ -104,22 +215,23 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, params
 	// Execute request
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, "", fmt.Errorf("failed to execute request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	return resp, apiID, nil
+}
 
-	// Check status code
-	if resp.StatusCode != http.StatusOK {
-		return body, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+// unmarshalResponse parses an XML response into v. The XML API reports failures as an
+// <error> document with a 200 status, which is returned as an error.
+func unmarshalResponse(body []byte, v interface{}) error {
+	if err := xml.Unmarshal(body, v); err != nil {
+		var apiErr APIError
+		if xml.Unmarshal(body, &apiErr) == nil {
+			return fmt.Errorf("API error: %s", strings.TrimSpace(apiErr.Message))
+		}
+		return fmt.Errorf("failed to unmarshal XML response: %w", err)
 	}
-
-	return body, nil
+	return nil
 }
 
 // GetMitigationInfo retrieves mitigation information for specific flaws in a build
@@ -180,3 +292,52 @@ func (c *Client) GetMitigationInfoForSingleFlaw(ctx context.Context, buildID, fl
 
 	return nil, fmt.Errorf("flaw ID %d not found in response", flawID)
 }
+
+// MaxMitigationCommentLength is the longest comment updatemitigationinfo.do accepts
+const MaxMitigationCommentLength = 2048
+
+// UpdateMitigationInfo adds a mitigation action with a comment to one or more flaws in a build
+// buildID: the build the flaws were reported in
+// action: a proposal (fp, appdesign, osenv, netenv, library, acceptrisk, comment) or a review (accepted, rejected)
+// flawIDs: the flaws to apply the action to
+func (c *Client) UpdateMitigationInfo(ctx context.Context, buildID int64, action ActionType, comment string, flawIDs []int64) (*MitigationInfo, error) {
+	if len(flawIDs) == 0 {
+		return nil, fmt.Errorf("at least one flaw ID is required")
+	}
+	if !slices.Contains(ProposalActions, action) && !IsReviewAction(action) {
+		return nil, fmt.Errorf("unsupported mitigation action %q", action)
+	}
+	if strings.TrimSpace(comment) == "" {
+		return nil, fmt.Errorf("a comment is required")
+	}
+	if len(comment) > MaxMitigationCommentLength {
+		return nil, fmt.Errorf("comment must be at most %d characters, got %d", MaxMitigationCommentLength, len(comment))
+	}
+
+	// Convert flaw IDs to comma-delimited string
+	flawIDStrs := make([]string, len(flawIDs))
+	for i, id := range flawIDs {
+		flawIDStrs[i] = fmt.Sprintf("%d", id)
+	}
+
+	// Build query parameters
+	params := url.Values{}
+	params.Set("build_id", fmt.Sprintf("%d", buildID))
+	params.Set("action", string(action))
+	params.Set("comment", comment)
+	params.Set("flaw_id_list", strings.Join(flawIDStrs, ","))
+
+	// Make request
+	body, err := c.doRequest(ctx, "POST", "/updatemitigationinfo.do", params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse XML response
+	var mitigationInfo MitigationInfo
+	if err := unmarshalResponse(body, &mitigationInfo); err != nil {
+		return nil, err
+	}
+
+	return &mitigationInfo, nil
+}
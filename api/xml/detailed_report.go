@@ -0,0 +1,40 @@
+package xml
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// GetDetailedReport downloads and parses the detailed report of a build
+func (c *Client) GetDetailedReport(ctx context.Context, buildID int64) (*DetailedReport, error) {
+	report, _, err := c.GetDetailedReportXML(ctx, buildID)
+	return report, err
+}
+
+// GetDetailedReportXML downloads and parses the detailed report of a build, also returning the
+// raw XML document for callers that keep a copy
+func (c *Client) GetDetailedReportXML(ctx context.Context, buildID int64) (*DetailedReport, []byte, error) {
+	params := url.Values{}
+	params.Set("build_id", strconv.FormatInt(buildID, 10))
+
+	body, err := c.doRequest(ctx, "GET", "/5.0/detailedreport.do", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report, err := ParseDetailedReport(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return report, body, nil
+}
+
+// ParseDetailedReport parses a detailed report document, such as one saved by GetDetailedReportXML
+func ParseDetailedReport(data []byte) (*DetailedReport, error) {
+	var report DetailedReport
+	if err := unmarshalResponse(data, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
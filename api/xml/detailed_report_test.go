@@ -0,0 +1,166 @@
+package xml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testDetailedReportXML = `<?xml version="1.0" encoding="UTF-8"?>
+<detailedreport xmlns="https://www.veracode.com/schema/reports/export/1.0" report_format_version="1.5" account_id="1" app_name="WebGoat" app_id="100" build_id="300" version="release 1.2" submitter="dev@example.com" generation_date="2026-10-01 10:00:00 UTC" policy_name="Veracode Recommended Medium" policy_compliance_status="Did Not Pass" total_flaws="3" flaws_not_mitigated="2" is_latest_build="true">
+  <static-analysis rating="C" score="72" submitted_date="2026-09-30 09:00:00 UTC" published_date="2026-10-01 09:30:00 UTC">
+    <modules>
+      <module name="webgoat.war" compiler="JAVAC_8" os="Java J2SE 8" score="72"/>
+    </modules>
+  </static-analysis>
+  <severity level="5"/>
+  <severity level="4">
+    <category categoryid="19" categoryname="SQL Injection">
+      <cwe cweid="89" cwename="Improper Neutralization of Special Elements used in an SQL Command">
+        <staticflaws>
+          <flaw issueid="12" severity="4" categoryname="SQL Injection" cweid="89" module="webgoat.war" exploitLevel="1" remediation_status="Open" mitigation_status="proposed" affects_policy_compliance="true" sourcefile="UserDao.java" sourcefilepath="org/owasp/webgoat/" line="57" date_first_occurrence="2026-08-01 10:00:00 UTC">
+            <mitigations>
+              <mitigation action="Mitigate by Design" description="Input is an allowlisted enum" user="dev@example.com" date="2026-09-01 10:00:00"/>
+            </mitigations>
+          </flaw>
+        </staticflaws>
+        <dynamicflaws>
+          <flaw issueid="40" severity="4" categoryname="SQL Injection" cweid="89" url="https://app.example.com/login" vuln_parameter="username" remediation_status="New" mitigation_status="none" affects_policy_compliance="true"/>
+        </dynamicflaws>
+      </cwe>
+    </category>
+  </severity>
+  <severity level="3">
+    <category categoryid="20" categoryname="Cross-Site Scripting">
+      <cwe cweid="80" cwename="Basic XSS">
+        <staticflaws>
+          <flaw issueid="13" severity="3" categoryname="Cross-Site Scripting" cweid="80" module="webgoat.war" remediation_status="Fixed" mitigation_status="none" affects_policy_compliance="false" sourcefile="view.jsp" line="9"/>
+        </staticflaws>
+      </cwe>
+    </category>
+  </severity>
+  <flaw-status new="1" reopen="0" open="1" fixed="1" total="3" not_mitigated="2"/>
+  <software_composition_analysis third_party_components="20" violate_policy="true" components_violated_policy="1">
+    <vulnerable_components>
+      <component component_id="abc" file_name="log4j-core-2.14.1.jar" library="log4j-core" version="2.14.1" vendor="org.apache.logging.log4j" max_cvss_score="10.0" component_affects_policy_compliance="true">
+        <file_paths><file_path value="WEB-INF/lib/log4j-core-2.14.1.jar"/></file_paths>
+        <licenses><license name="Apache License 2.0" spdx_id="Apache-2.0" license_url="https://spdx.org/licenses/Apache-2.0.html" risk_rating="2"/></licenses>
+        <vulnerabilities>
+          <vulnerability cve_id="CVE-2021-44228" cvss_score="10.0" severity="5" cwe_id="CWE-502" cve_summary="Log4Shell" mitigation="false" vulnerability_affects_policy_compliance="true"/>
+        </vulnerabilities>
+      </component>
+      <component component_id="def" file_name="commons-lang3-3.12.0.jar" library="commons-lang3" version="3.12.0" vendor="org.apache.commons" component_affects_policy_compliance="false">
+        <file_paths><file_path value="WEB-INF/lib/commons-lang3-3.12.0.jar"/></file_paths>
+        <licenses><license name="Apache License 2.0" spdx_id="Apache-2.0" risk_rating="2"/></licenses>
+        <vulnerabilities/>
+      </component>
+    </vulnerable_components>
+  </software_composition_analysis>
+</detailedreport>`
+
+func TestParseDetailedReport(t *testing.T) {
+	report, err := ParseDetailedReport([]byte(testDetailedReportXML))
+	if err != nil {
+		t.Fatalf("ParseDetailedReport() error = %v", err)
+	}
+
+	if report.AppName != "WebGoat" || report.BuildID != 300 || report.TotalFlaws != 3 || !report.IsLatestBuild {
+		t.Errorf("unexpected report attributes: %+v", report)
+	}
+	if report.StaticAnalysis == nil || len(report.StaticAnalysis.Modules) != 1 || report.StaticAnalysis.Modules[0].Name != "webgoat.war" {
+		t.Errorf("unexpected static analysis: %+v", report.StaticAnalysis)
+	}
+	if report.FlawStatus == nil || report.FlawStatus.NotMitigated != 2 {
+		t.Errorf("unexpected flaw status: %+v", report.FlawStatus)
+	}
+
+	static := report.StaticFlaws()
+	if len(static) != 2 || static[0].IssueID != 12 || static[1].IssueID != 13 {
+		t.Fatalf("StaticFlaws() = %+v, want flaws 12 and 13", static)
+	}
+	flaw := static[0]
+	if flaw.SourceFilePath != "org/owasp/webgoat/" || flaw.Line != 57 || flaw.ExploitLevel != "1" || !flaw.AffectsPolicyCompliance {
+		t.Errorf("unexpected static flaw: %+v", flaw)
+	}
+	if len(flaw.Mitigations) != 1 || flaw.Mitigations[0].Action != "Mitigate by Design" {
+		t.Errorf("unexpected mitigation history: %+v", flaw.Mitigations)
+	}
+
+	dynamic := report.DynamicFlaws()
+	if len(dynamic) != 1 || dynamic[0].URL != "https://app.example.com/login" || dynamic[0].VulnParameter != "username" {
+		t.Errorf("DynamicFlaws() = %+v", dynamic)
+	}
+
+	if report.SCA == nil || len(report.SCA.Components) != 2 {
+		t.Fatalf("unexpected SCA section: %+v", report.SCA)
+	}
+	component := report.SCA.Components[0]
+	if component.Library != "log4j-core" || len(component.FilePaths) != 1 || component.FilePaths[0].Value != "WEB-INF/lib/log4j-core-2.14.1.jar" || len(component.Vulnerabilities) != 1 || component.Vulnerabilities[0].CVEID != "CVE-2021-44228" {
+		t.Errorf("unexpected component: %+v", component)
+	}
+	if len(component.Licenses) != 1 || component.Licenses[0].SPDXID != "Apache-2.0" || component.Licenses[0].RiskRating != "2" {
+		t.Errorf("unexpected component licenses: %+v", component.Licenses)
+	}
+	if clean := report.SCA.Components[1]; clean.Library != "commons-lang3" || len(clean.Vulnerabilities) != 0 || len(clean.Licenses) != 1 {
+		t.Errorf("expected a component without vulnerabilities to be listed with its licenses, got %+v", clean)
+	}
+}
+
+func TestParseDetailedReport_APIError(t *testing.T) {
+	_, err := ParseDetailedReport([]byte(`<error>No report available.</error>`))
+	if err == nil || !strings.Contains(err.Error(), "No report available.") {
+		t.Errorf("expected API error, got %v", err)
+	}
+}
+
+func TestGetBuildListAndDetailedReport(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		switch r.URL.Path {
+		case "/5.0/getbuildlist.do":
+			fmt.Fprint(w, `<buildlist xmlns="https://analysiscenter.veracode.com/schema/2.0/buildlist" account_id="1" app_id="100"><build build_id="299" version="release 1.1"/><build build_id="300" version="release 1.2" policy_updated_date="2026-10-01T09:30:00-04:00"/></buildlist>`)
+		case "/5.0/detailedreport.do":
+			if r.URL.Query().Get("build_id") != "300" {
+				fmt.Fprint(w, `<error>No report available.</error>`)
+				return
+			}
+			fmt.Fprint(w, testDetailedReportXML)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(server.URL)
+	ctx := context.Background()
+
+	buildList, err := client.GetBuildList(ctx, 100, 0)
+	if err != nil {
+		t.Fatalf("GetBuildList() error = %v", err)
+	}
+	if len(buildList.Builds) != 2 || buildList.Builds[1].BuildID != 300 || buildList.Builds[1].Version != "release 1.2" {
+		t.Errorf("unexpected build list: %+v", buildList)
+	}
+
+	report, data, err := client.GetDetailedReportXML(ctx, 300)
+	if err != nil {
+		t.Fatalf("GetDetailedReportXML() error = %v", err)
+	}
+	if report.BuildID != 300 {
+		t.Errorf("GetDetailedReportXML() report build = %d, want 300", report.BuildID)
+	}
+	if string(data) != testDetailedReportXML {
+		t.Error("GetDetailedReportXML() should return the document unchanged")
+	}
+
+	if _, err := client.GetDetailedReport(ctx, 298); err == nil {
+		t.Error("expected an error for a build without a report")
+	}
+
+	if requests[0] != "/5.0/getbuildlist.do?app_id=100" {
+		t.Errorf("unexpected build list request: %s", requests[0])
+	}
+}
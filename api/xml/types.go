@@ -52,3 +52,279 @@ const (
 	ActionDeviates      ActionType = "deviates"
 	ActionDefer         ActionType = "defer"
 )
+
+// ProposalActions are the mitigation actions a developer can submit for review through updatemitigationinfo.do
+var ProposalActions = []ActionType{
+	ActionComment,
+	ActionFP,
+	ActionAppDesign,
+	ActionOSEnv,
+	ActionNetEnv,
+	ActionLibrary,
+	ActionAcceptRisk,
+}
+
+// ReviewActions approve or reject a proposed mitigation, and require the mitigation approver role
+var ReviewActions = []ActionType{
+	ActionAccepted,
+	ActionRejected,
+}
+
+// IsReviewAction reports whether action approves or rejects a mitigation rather than proposing one
+func IsReviewAction(action ActionType) bool {
+	for _, reviewAction := range ReviewActions {
+		if action == reviewAction {
+			return true
+		}
+	}
+	return false
+}
+
+// APIError is the error document the XML API returns in place of the expected response
+type APIError struct {
+	XMLName xml.Name `xml:"error"`
+	Message string   `xml:",chardata"`
+}
+
+// BuildInfo represents the buildinfo document returned by createbuild.do, beginprescan.do and getbuildinfo.do
+type BuildInfo struct {
+	XMLName   xml.Name `xml:"buildinfo"`
+	AccountID int64    `xml:"account_id,attr,omitempty"`
+	AppID     int64    `xml:"app_id,attr"`
+	SandboxID int64    `xml:"sandbox_id,attr,omitempty"`
+	BuildID   int64    `xml:"build_id,attr"`
+	Build     Build    `xml:"build"`
+}
+
+// Build represents a build (a single scan) of an application or sandbox
+type Build struct {
+	Version                string       `xml:"version,attr"`
+	BuildID                int64        `xml:"build_id,attr"`
+	Submitter              string       `xml:"submitter,attr,omitempty"`
+	Platform               string       `xml:"platform,attr,omitempty"`
+	LifecycleStage         string       `xml:"lifecycle_stage,attr,omitempty"`
+	ResultsReady           bool         `xml:"results_ready,attr"`
+	PolicyName             string       `xml:"policy_name,attr,omitempty"`
+	PolicyComplianceStatus string       `xml:"policy_compliance_status,attr,omitempty"`
+	AnalysisUnit           AnalysisUnit `xml:"analysis_unit"`
+}
+
+// AnalysisUnit represents the static analysis of a build
+type AnalysisUnit struct {
+	AnalysisType  string `xml:"analysis_type,attr"`
+	Status        string `xml:"status,attr"` // e.g. Incomplete, Pre-Scan Submitted, Scan In Process, Results Ready
+	PublishedDate string `xml:"published_date,attr,omitempty"`
+	EngineVersion string `xml:"engine_version,attr,omitempty"`
+}
+
+// FileList represents the filelist document returned by uploadfile.do
+type FileList struct {
+	XMLName   xml.Name       `xml:"filelist"`
+	AppID     int64          `xml:"app_id,attr"`
+	SandboxID int64          `xml:"sandbox_id,attr,omitempty"`
+	BuildID   int64          `xml:"build_id,attr"`
+	Files     []UploadedFile `xml:"file"`
+}
+
+// UploadedFile represents a file uploaded to a build
+type UploadedFile struct {
+	FileID     int64  `xml:"file_id,attr"`
+	FileName   string `xml:"file_name,attr"`
+	FileStatus string `xml:"file_status,attr"`
+}
+
+// BuildList represents the buildlist document returned by getbuildlist.do and deletebuild.do
+type BuildList struct {
+	XMLName   xml.Name       `xml:"buildlist"`
+	AccountID int64          `xml:"account_id,attr,omitempty"`
+	AppID     int64          `xml:"app_id,attr"`
+	SandboxID int64          `xml:"sandbox_id,attr,omitempty"`
+	Builds    []BuildSummary `xml:"build"`
+}
+
+// BuildSummary represents a build in a build list
+type BuildSummary struct {
+	BuildID           int64  `xml:"build_id,attr"`
+	Version           string `xml:"version,attr"`
+	PolicyUpdatedDate string `xml:"policy_updated_date,attr,omitempty"`
+}
+
+// DetailedReport represents the detailedreport document returned by detailedreport.do:
+// every flaw of a build grouped by severity, category and CWE, with the build's SCA components
+type DetailedReport struct {
+	XMLName                xml.Name                     `xml:"detailedreport"`
+	AccountID              int64                        `xml:"account_id,attr,omitempty"`
+	AppName                string                       `xml:"app_name,attr"`
+	AppID                  int64                        `xml:"app_id,attr"`
+	SandboxID              int64                        `xml:"sandbox_id,attr,omitempty"`
+	BuildID                int64                        `xml:"build_id,attr"`
+	Version                string                       `xml:"version,attr"`
+	Submitter              string                       `xml:"submitter,attr,omitempty"`
+	GenerationDate         string                       `xml:"generation_date,attr,omitempty"`
+	PolicyName             string                       `xml:"policy_name,attr,omitempty"`
+	PolicyComplianceStatus string                       `xml:"policy_compliance_status,attr,omitempty"`
+	TotalFlaws             int                          `xml:"total_flaws,attr"`
+	FlawsNotMitigated      int                          `xml:"flaws_not_mitigated,attr"`
+	IsLatestBuild          bool                         `xml:"is_latest_build,attr"`
+	StaticAnalysis         *ReportAnalysis              `xml:"static-analysis"`
+	DynamicAnalysis        *ReportAnalysis              `xml:"dynamic-analysis"`
+	Severities             []ReportSeverity             `xml:"severity"`
+	FlawStatus             *FlawStatus                  `xml:"flaw-status"`
+	SCA                    *SoftwareCompositionAnalysis `xml:"software_composition_analysis"`
+}
+
+// ReportAnalysis represents the static or dynamic analysis of a build and the modules it covered
+type ReportAnalysis struct {
+	Rating        string         `xml:"rating,attr,omitempty"`
+	Score         int            `xml:"score,attr"`
+	SubmittedDate string         `xml:"submitted_date,attr,omitempty"`
+	PublishedDate string         `xml:"published_date,attr,omitempty"`
+	Modules       []ReportModule `xml:"modules>module"`
+}
+
+// ReportModule represents a scanned module
+type ReportModule struct {
+	Name     string `xml:"name,attr"`
+	Compiler string `xml:"compiler,attr,omitempty"`
+	OS       string `xml:"os,attr,omitempty"`
+	Score    int    `xml:"score,attr"`
+}
+
+// ReportSeverity groups the report's categories by severity level (0-5)
+type ReportSeverity struct {
+	Level      int              `xml:"level,attr"`
+	Categories []ReportCategory `xml:"category"`
+}
+
+// ReportCategory groups the report's CWEs by Veracode category
+type ReportCategory struct {
+	CategoryID   int         `xml:"categoryid,attr"`
+	CategoryName string      `xml:"categoryname,attr"`
+	CWEs         []ReportCWE `xml:"cwe"`
+}
+
+// ReportCWE holds the static and dynamic flaws reported for a CWE
+type ReportCWE struct {
+	CWEID        int    `xml:"cweid,attr"`
+	CWEName      string `xml:"cwename,attr"`
+	StaticFlaws  []Flaw `xml:"staticflaws>flaw"`
+	DynamicFlaws []Flaw `xml:"dynamicflaws>flaw"`
+}
+
+// Flaw represents a static or dynamic flaw in the detailed report.
+// Static flaws carry the source location; dynamic flaws carry the URL and parameter.
+type Flaw struct {
+	IssueID                 int64              `xml:"issueid,attr"`
+	Severity                int                `xml:"severity,attr"`
+	CategoryName            string             `xml:"categoryname,attr"`
+	CWEID                   int                `xml:"cweid,attr"`
+	Module                  string             `xml:"module,attr,omitempty"`
+	Type                    string             `xml:"type,attr,omitempty"`
+	Description             string             `xml:"description,attr,omitempty"`
+	ExploitLevel            string             `xml:"exploitLevel,attr,omitempty"`
+	RemediationEffort       int                `xml:"remediationeffort,attr,omitempty"`
+	RemediationStatus       string             `xml:"remediation_status,attr,omitempty"` // e.g. New, Open, Reopened, Fixed
+	MitigationStatus        string             `xml:"mitigation_status,attr,omitempty"`  // none, proposed, accepted, rejected
+	MitigationStatusDesc    string             `xml:"mitigation_status_desc,attr,omitempty"`
+	AffectsPolicyCompliance bool               `xml:"affects_policy_compliance,attr"`
+	DateFirstOccurrence     string             `xml:"date_first_occurrence,attr,omitempty"`
+	GracePeriodExpires      string             `xml:"grace_period_expires,attr,omitempty"`
+	SourceFile              string             `xml:"sourcefile,attr,omitempty"`
+	SourceFilePath          string             `xml:"sourcefilepath,attr,omitempty"`
+	Line                    int                `xml:"line,attr,omitempty"`
+	FunctionPrototype       string             `xml:"functionprototype,attr,omitempty"`
+	URL                     string             `xml:"url,attr,omitempty"`
+	VulnParameter           string             `xml:"vuln_parameter,attr,omitempty"`
+	Mitigations             []ReportMitigation `xml:"mitigations>mitigation"`
+}
+
+// ReportMitigation represents an entry in a flaw's mitigation history
+type ReportMitigation struct {
+	Action      string `xml:"action,attr"`
+	Description string `xml:"description,attr,omitempty"`
+	User        string `xml:"user,attr,omitempty"`
+	Date        string `xml:"date,attr,omitempty"`
+}
+
+// FlawStatus summarizes how the build's flaws changed since the previous build
+type FlawStatus struct {
+	New          int `xml:"new,attr"`
+	Reopen       int `xml:"reopen,attr"`
+	Open         int `xml:"open,attr"`
+	Fixed        int `xml:"fixed,attr"`
+	Total        int `xml:"total,attr"`
+	NotMitigated int `xml:"not_mitigated,attr"`
+}
+
+// SoftwareCompositionAnalysis holds the build's third-party components. Despite the element name,
+// vulnerable_components lists every component found, including those with no known vulnerabilities.
+type SoftwareCompositionAnalysis struct {
+	ThirdPartyComponents     int               `xml:"third_party_components,attr"`
+	ViolatePolicy            bool              `xml:"violate_policy,attr"`
+	ComponentsViolatedPolicy int               `xml:"components_violated_policy,attr"`
+	Components               []ReportComponent `xml:"vulnerable_components>component"`
+}
+
+// ReportComponent represents a third-party component, its licenses and its vulnerabilities
+type ReportComponent struct {
+	ComponentID             string                `xml:"component_id,attr"`
+	FileName                string                `xml:"file_name,attr"`
+	Library                 string                `xml:"library,attr,omitempty"`
+	Version                 string                `xml:"version,attr,omitempty"`
+	Vendor                  string                `xml:"vendor,attr,omitempty"`
+	MaxCVSSScore            float64               `xml:"max_cvss_score,attr,omitempty"`
+	AffectsPolicyCompliance bool                  `xml:"component_affects_policy_compliance,attr"`
+	FilePaths               []ReportFilePath      `xml:"file_paths>file_path"`
+	Licenses                []ReportLicense       `xml:"licenses>license"`
+	Vulnerabilities         []ReportVulnerability `xml:"vulnerabilities>vulnerability"`
+}
+
+// ReportLicense is a license of a third-party component
+type ReportLicense struct {
+	Name       string `xml:"name,attr"`
+	SPDXID     string `xml:"spdx_id,attr,omitempty"`
+	URL        string `xml:"license_url,attr,omitempty"`
+	RiskRating string `xml:"risk_rating,attr,omitempty"` // Numeric, higher is riskier
+}
+
+// ReportFilePath is a location of a component within the uploaded artifacts
+type ReportFilePath struct {
+	Value string `xml:"value,attr"`
+}
+
+// ReportVulnerability represents a known vulnerability in a third-party component
+type ReportVulnerability struct {
+	CVEID                   string  `xml:"cve_id,attr"`
+	CVSSScore               float64 `xml:"cvss_score,attr"`
+	Severity                int     `xml:"severity,attr"`
+	CWEID                   string  `xml:"cwe_id,attr,omitempty"`
+	Summary                 string  `xml:"cve_summary,attr,omitempty"`
+	Mitigation              bool    `xml:"mitigation,attr"`
+	AffectsPolicyCompliance bool    `xml:"vulnerability_affects_policy_compliance,attr"`
+}
+
+// StaticFlaws returns every static flaw in the report, most severe first
+func (r *DetailedReport) StaticFlaws() []Flaw {
+	var flaws []Flaw
+	for _, severity := range r.Severities {
+		for _, category := range severity.Categories {
+			for _, cwe := range category.CWEs {
+				flaws = append(flaws, cwe.StaticFlaws...)
+			}
+		}
+	}
+	return flaws
+}
+
+// DynamicFlaws returns every dynamic flaw in the report, most severe first
+func (r *DetailedReport) DynamicFlaws() []Flaw {
+	var flaws []Flaw
+	for _, severity := range r.Severities {
+		for _, category := range severity.Categories {
+			for _, cwe := range category.CWEs {
+				flaws = append(flaws, cwe.DynamicFlaws...)
+			}
+		}
+	}
+	return flaws
+}
@@ -0,0 +1,113 @@
+package xml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/credentials"
+)
+
+// newTestClient returns a client that signs requests with fixed credentials and sends them to baseURL
+func newTestClient(baseURL string) *Client {
+	return &Client{
+		creds:   credentials.NewStaticProvider("test-id", "0123456789abcdef0123456789abcdef"),
+		baseURL: baseURL,
+		client:  http.DefaultClient,
+	}
+}
+
+func TestUpdateMitigationInfo(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotQuery map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		gotQuery = map[string]string{}
+		for key := range r.URL.Query() {
+			gotQuery[key] = r.URL.Query().Get(key)
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "VERACODE-HMAC-SHA-256") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<mitigationinfo xmlns="https://analysiscenter.veracode.com/schema/mitigationinfo/1.0" mitigationinfo_version="1.0" build_id="123">
+  <issue flaw_id="7" category="SQL Injection">
+    <mitigation_action action="appdesign" desc="Mitigate by Design" reviewer="dev@example.com" date="2026-10-19 10:00:00" comment="Input is allowlisted"/>
+  </issue>
+  <error type="not_found" flaw_id_list="99"/>
+</mitigationinfo>`)
+	}))
+	defer server.Close()
+
+	info, err := newTestClient(server.URL).UpdateMitigationInfo(context.Background(), 123, ActionAppDesign, "Input is allowlisted", []int64{7, 99})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost || gotPath != "/updatemitigationinfo.do" {
+		t.Errorf("Expected POST /updatemitigationinfo.do, got %s %s", gotMethod, gotPath)
+	}
+	expectedQuery := map[string]string{"build_id": "123", "action": "appdesign", "comment": "Input is allowlisted", "flaw_id_list": "7,99"}
+	for key, expected := range expectedQuery {
+		if gotQuery[key] != expected {
+			t.Errorf("Expected %s=%q, got %q", key, expected, gotQuery[key])
+		}
+	}
+
+	if info.BuildID != 123 || len(info.Issues) != 1 || info.Issues[0].MitigationActions[0].Action != "appdesign" {
+		t.Errorf("Unexpected mitigation info: %+v", info)
+	}
+	if len(info.Errors) != 1 || info.Errors[0].FlawIDList != "99" {
+		t.Errorf("Expected an error for flaw 99, got %+v", info.Errors)
+	}
+}
+
+func TestUpdateMitigationInfo_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><error>No permission to approve mitigations</error>`)
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server.URL).UpdateMitigationInfo(context.Background(), 123, ActionAccepted, "Approved", []int64{7})
+	if err == nil || !strings.Contains(err.Error(), "No permission to approve mitigations") {
+		t.Errorf("Expected the API error message, got %v", err)
+	}
+}
+
+func TestUpdateMitigationInfo_Validation(t *testing.T) {
+	client := newTestClient("http://127.0.0.1:0")
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		action  ActionType
+		comment string
+		flawIDs []int64
+	}{
+		{"no flaws", ActionFP, "comment", nil},
+		{"unsupported action", ActionRemediated, "comment", []int64{1}},
+		{"empty comment", ActionFP, "  ", []int64{1}},
+		{"comment too long", ActionFP, strings.Repeat("a", MaxMitigationCommentLength+1), []int64{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.UpdateMitigationInfo(ctx, 1, tt.action, tt.comment, tt.flawIDs); err == nil {
+				t.Error("Expected a validation error")
+			}
+		})
+	}
+}
+
+func TestIsReviewAction(t *testing.T) {
+	if !IsReviewAction(ActionAccepted) || !IsReviewAction(ActionRejected) {
+		t.Error("Expected accepted and rejected to be review actions")
+	}
+	if IsReviewAction(ActionFP) || IsReviewAction(ActionAppDesign) {
+		t.Error("Expected proposals not to be review actions")
+	}
+}
@@ -1,6 +1,7 @@
 package credentials
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,11 +11,17 @@ import (
 
 // VeracodeConfig represents the structure of the veracode.yml file
 type VeracodeConfig struct {
-	API struct {
-		KeyID     string `yaml:"key-id"`
-		KeySecret string `yaml:"key-secret"`
-		BaseURL   string `yaml:"override-api-base-url,omitempty"`
-	} `yaml:"api"`
+	API APIConfig `yaml:"api"`
+	// Profiles holds further named credentials, e.g. for a workspace's credential_profile
+	Profiles map[string]APIConfig `yaml:"profiles,omitempty"`
+}
+
+// APIConfig is one set of API credentials in veracode.yml
+type APIConfig struct {
+	KeyID             string `yaml:"key-id"`
+	KeySecret         string `yaml:"key-secret"`
+	BaseURL           string `yaml:"override-api-base-url,omitempty"`
+	CredentialProcess string `yaml:"credential-process,omitempty"`
 }
 
 const (
@@ -28,47 +35,151 @@ const (
 
 // GetCredentials retrieves Veracode API credentials from ~/.veracode/veracode.yml
 // Falls back to environment variables VERACODE_API_ID and VERACODE_API_KEY if file doesn't exist
+// If a credential-process is configured, the command is run and its output used instead
 // Returns apiID, apiSecret, baseURL, and error. baseURL will be DefaultBaseURL if not specified.
 func GetCredentials() (apiID, apiSecret, baseURL string, err error) {
+	apiID, apiSecret, baseURL, _, err = GetCredentialsWithFallback()
+	return apiID, apiSecret, baseURL, err
+}
+
+// GetCredentialProvider resolves the configured credential source and returns a Provider for it.
+// Static key pairs are wrapped in a provider that never changes; a credential-process is wrapped in
+// a caching provider that re-runs the command on expiry or after Invalidate.
+// The provider is queried once up front so configuration errors surface at client construction.
+func GetCredentialProvider() (Provider, string, error) {
+	return GetProfileCredentialProvider("")
+}
+
+// GetProfileCredentialProvider is GetCredentialProvider for a named profile under "profiles" in
+// ~/.veracode/veracode.yml. A named profile must exist; it never falls back to other credentials.
+// An empty profile selects the default credentials.
+func GetProfileCredentialProvider(profile string) (Provider, string, error) {
+	src, err := resolveCredentialSource(profile)
+	if err != nil {
+		return nil, "", err
+	}
+
+	provider := src.provider()
+	apiID, _, err := provider.Retrieve(context.Background())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return provider, src.resolveBaseURL(apiID), nil
+}
+
+// credentialSource describes where credentials were found, before any credential process has run
+type credentialSource struct {
+	apiID     string
+	apiSecret string
+	baseURL   string
+	process   string
+	origin    string
+}
+
+// provider returns the Provider backing this source
+func (s *credentialSource) provider() Provider {
+	if s.process != "" {
+		return processProviderFor(s.process)
+	}
+	return NewStaticProvider(s.apiID, s.apiSecret)
+}
+
+// resolveBaseURL returns the explicit base URL, or auto-detects it from the API key ID
+func (s *credentialSource) resolveBaseURL(apiID string) string {
+	if s.baseURL != "" {
+		return s.baseURL
+	}
+	return detectRegionFromKeyID(apiID)
+}
+
+// resolveCredentialSource locates credentials in ~/.veracode/veracode.yml, then the environment.
+// A named profile is only looked up in the file.
+func resolveCredentialSource(profile string) (*credentialSource, error) {
 	// Try to read from ~/.veracode/veracode.yml first
 	homeDir, err := os.UserHomeDir()
+	if profile != "" {
+		if err != nil {
+			return nil, fmt.Errorf("credential profile %q needs ~/.veracode/veracode.yml: %w", profile, err)
+		}
+		return resolveProfileSource(filepath.Join(homeDir, ".veracode", "veracode.yml"), profile)
+	}
 	if err == nil {
 		configPath := filepath.Join(homeDir, ".veracode", "veracode.yml")
-		apiID, apiSecret, baseURL, err = readConfigFile(configPath)
-		if err == nil && apiID != "" && apiSecret != "" {
-
-			return apiID, apiSecret, baseURL, nil
+		config, err := readConfig(configPath)
+		if err == nil {
+			if src := apiConfigSource(config.API); src != nil {
+				return src, nil
+			}
 		}
 	}
 
 	// Fall back to environment variables
-	apiID = os.Getenv("VERACODE_API_ID")
-	apiSecret = os.Getenv("VERACODE_API_KEY")
-	baseURL = os.Getenv("VERACODE_OVERRIDE_API_BASE_URL")
-
-	if baseURL == "" {
-		baseURL = detectRegionFromKeyID(apiID)
+	baseURL := os.Getenv("VERACODE_OVERRIDE_API_BASE_URL")
+	if process := os.Getenv("VERACODE_CREDENTIAL_PROCESS"); process != "" {
+		return &credentialSource{process: process, baseURL: baseURL, origin: "env"}, nil
 	}
 
+	apiID := os.Getenv("VERACODE_API_ID")
+	apiSecret := os.Getenv("VERACODE_API_KEY")
 	if apiID == "" || apiSecret == "" {
-		return "", "", "", fmt.Errorf("Veracode credentials not found. " +
-			"Please create ~/.veracode/veracode.yml with key-id and key-secret" +
+		return nil, fmt.Errorf("Veracode credentials not found. " +
+			"Please create ~/.veracode/veracode.yml with key-id and key-secret (or credential-process) " +
 			"or set VERACODE_API_ID and VERACODE_API_KEY environment variables")
 	}
 
-	return apiID, apiSecret, baseURL, nil
+	return &credentialSource{apiID: apiID, apiSecret: apiSecret, baseURL: baseURL, origin: "env"}, nil
 }
 
-// readConfigFile reads and parses the veracode.yml configuration file
-func readConfigFile(path string) (string, string, string, error) {
+// resolveProfileSource reads the credentials of a named profile from the configuration file at configPath
+func resolveProfileSource(configPath, profile string) (*credentialSource, error) {
+	config, err := readConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("credential profile %q needs %s: %w", profile, configPath, err)
+	}
+	api, ok := config.Profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("credential profile %q not found in %s. Add it under \"profiles\" with key-id and key-secret (or credential-process)", profile, configPath)
+	}
+	src := apiConfigSource(api)
+	if src == nil {
+		return nil, fmt.Errorf("credential profile %q in %s has no key-id and key-secret (or credential-process)", profile, configPath)
+	}
+	return src, nil
+}
+
+// apiConfigSource returns the source of a set of credentials from the file, or nil if it is incomplete.
+// A credential-process takes precedence over a key pair.
+func apiConfigSource(api APIConfig) *credentialSource {
+	if api.CredentialProcess != "" {
+		return &credentialSource{process: api.CredentialProcess, baseURL: api.BaseURL, origin: "file"}
+	}
+	if api.KeyID != "" && api.KeySecret != "" {
+		return &credentialSource{apiID: api.KeyID, apiSecret: api.KeySecret, baseURL: api.BaseURL, origin: "file"}
+	}
+	return nil
+}
+
+// readConfig reads and parses the veracode.yml configuration file
+func readConfig(path string) (*VeracodeConfig, error) {
 	data, err := os.ReadFile(path) // nolint:gosec // Intentional file read from user config
 	if err != nil {
-		return "", "", "", err
+		return nil, err
 	}
 
 	var config VeracodeConfig
 	if err := yaml.Unmarshal(data, &config); err != nil {
-		return "", "", "", fmt.Errorf("failed to parse %s: %w", path, err)
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return &config, nil
+}
+
+// readConfigFile reads and parses the veracode.yml configuration file
+func readConfigFile(path string) (string, string, string, error) {
+	config, err := readConfig(path)
+	if err != nil {
+		return "", "", "", err
 	}
 
 	baseURL := config.API.BaseURL
@@ -92,31 +203,15 @@ func detectRegionFromKeyID(apiID string) string {
 // GetCredentialsWithFallback retrieves credentials with custom fallback logic
 // Returns apiID, apiSecret, baseURL, source ("file" or "env"), and error
 func GetCredentialsWithFallback() (apiID, apiSecret, baseURL, source string, err error) {
-	// Try to read from ~/.veracode/veracode.yml first
-	homeDir, err := os.UserHomeDir()
-	if err == nil {
-		configPath := filepath.Join(homeDir, ".veracode", "veracode.yml")
-		apiID, apiSecret, baseURL, err = readConfigFile(configPath)
-		if err == nil && apiID != "" && apiSecret != "" {
-
-			return apiID, apiSecret, baseURL, "file", nil
-		}
-	}
-
-	// Fall back to environment variables
-	apiID = os.Getenv("VERACODE_API_ID")
-	apiSecret = os.Getenv("VERACODE_API_KEY")
-	baseURL = os.Getenv("VERACODE_OVERRIDE_API_BASE_URL")
-
-	if baseURL == "" {
-		baseURL = detectRegionFromKeyID(apiID)
+	src, err := resolveCredentialSource("")
+	if err != nil {
+		return "", "", "", "", err
 	}
 
-	if apiID == "" || apiSecret == "" {
-		return "", "", "", "", fmt.Errorf("Veracode credentials not found. " +
-			"Please create ~/.veracode/veracode.yml with key-id and key-secret " +
-			"or set VERACODE_API_ID and VERACODE_API_KEY environment variables")
+	apiID, apiSecret, err = src.provider().Retrieve(context.Background())
+	if err != nil {
+		return "", "", "", "", err
 	}
 
-	return apiID, apiSecret, baseURL, "env", nil
+	return apiID, apiSecret, src.resolveBaseURL(apiID), src.origin, nil
 }
@@ -3,6 +3,7 @@ package credentials
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -520,3 +521,42 @@ func TestDetectRegionFromKeyID(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveProfileSource(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "veracode.yml")
+	config := `api:
+  key-id: default-id
+  key-secret: default-secret
+profiles:
+  team-a:
+    key-id: vera01ei-team-a-id
+    key-secret: team-a-secret
+  team-b:
+    credential-process: op read op://vault/team-b/credentials
+  empty:
+    override-api-base-url: https://api.veracode.us
+`
+	if err := os.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	src, err := resolveProfileSource(configPath, "team-a")
+	if err != nil {
+		t.Fatalf("resolveProfileSource failed: %v", err)
+	}
+	if src.apiID != "vera01ei-team-a-id" || src.apiSecret != "team-a-secret" || src.resolveBaseURL(src.apiID) != EUBaseURL {
+		t.Errorf("Expected the team-a key pair in the EU region, got %+v", src)
+	}
+
+	src, err = resolveProfileSource(configPath, "team-b")
+	if err != nil || src.process != "op read op://vault/team-b/credentials" {
+		t.Errorf("Expected the team-b credential process, got %+v, %v", src, err)
+	}
+
+	if _, err := resolveProfileSource(configPath, "empty"); err == nil || !strings.Contains(err.Error(), "has no key-id") {
+		t.Errorf("Expected an incomplete profile error, got %v", err)
+	}
+	if _, err := resolveProfileSource(configPath, "team-c"); err == nil || !strings.Contains(err.Error(), `credential profile "team-c" not found`) {
+		t.Errorf("Expected a missing profile error, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// credentialProcessTimeout bounds how long a credential-process command may run
+	credentialProcessTimeout = 30 * time.Second
+	// credentialExpiryWindow refreshes process credentials slightly before they expire
+	credentialExpiryWindow = 1 * time.Minute
+)
+
+// Provider supplies Veracode API credentials at request time.
+// The REST and XML clients call Retrieve before signing each request and
+// Invalidate when the API rejects the credentials with 401 Unauthorized.
+type Provider interface {
+	Retrieve(ctx context.Context) (apiID, apiSecret string, err error)
+	Invalidate()
+}
+
+// ProcessCredentials is the JSON document a credential-process command writes to stdout
+type ProcessCredentials struct {
+	KeyID      string     `json:"key_id"`
+	KeySecret  string     `json:"key_secret"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// staticProvider returns a fixed key pair from veracode.yml or the environment
+type staticProvider struct {
+	apiID     string
+	apiSecret string
+}
+
+// NewStaticProvider creates a Provider that always returns the given key pair
+func NewStaticProvider(apiID, apiSecret string) Provider {
+	return &staticProvider{apiID: apiID, apiSecret: apiSecret}
+}
+
+// Retrieve returns the configured key pair
+func (p *staticProvider) Retrieve(ctx context.Context) (string, string, error) {
+	return p.apiID, p.apiSecret, nil
+}
+
+// Invalidate is a no-op; static credentials cannot be refreshed
+func (p *staticProvider) Invalidate() {}
+
+// ProcessProvider obtains credentials by running an external command, in the style of
+// AWS credential_process. Credentials are cached until their expiration (if any) or
+// until Invalidate is called, so vault, 1Password CLI or internal tooling is only
+// invoked when needed.
+type ProcessProvider struct {
+	command string
+	now     func() time.Time
+
+	mu     sync.Mutex
+	cached *ProcessCredentials
+}
+
+// NewProcessProvider creates a Provider that runs command to obtain credentials
+func NewProcessProvider(command string) *ProcessProvider {
+	return &ProcessProvider{
+		command: command,
+		now:     time.Now,
+	}
+}
+
+// processProviders shares one ProcessProvider per command, so the REST and XML
+// clients reuse the same cached credentials rather than each running the command
+var (
+	processProvidersMu sync.Mutex
+	processProviders   = map[string]*ProcessProvider{}
+)
+
+// processProviderFor returns the shared ProcessProvider for command
+func processProviderFor(command string) *ProcessProvider {
+	processProvidersMu.Lock()
+	defer processProvidersMu.Unlock()
+
+	if p, ok := processProviders[command]; ok {
+		return p
+	}
+	p := NewProcessProvider(command)
+	processProviders[command] = p
+	return p
+}
+
+// Retrieve returns cached credentials, running the credential process if none are cached or they have expired
+func (p *ProcessProvider) Retrieve(ctx context.Context) (string, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cached != nil && !p.expired(p.cached) {
+		return p.cached.KeyID, p.cached.KeySecret, nil
+	}
+
+	creds, err := runCredentialProcess(ctx, p.command)
+	if err != nil {
+		return "", "", err
+	}
+
+	p.cached = creds
+	return creds.KeyID, creds.KeySecret, nil
+}
+
+// Invalidate discards cached credentials so the next Retrieve re-runs the credential process
+func (p *ProcessProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cached = nil
+}
+
+// expired reports whether creds are at or near their expiration time
+func (p *ProcessProvider) expired(creds *ProcessCredentials) bool {
+	if creds.Expiration == nil {
+		return false
+	}
+	return !p.now().Add(credentialExpiryWindow).Before(*creds.Expiration)
+}
+
+// runCredentialProcess executes command through the platform shell and parses its stdout
+func runCredentialProcess(ctx context.Context, command string) (*ProcessCredentials, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command) // nolint:gosec // Command comes from the user's own config
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command) // nolint:gosec // Command comes from the user's own config
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return nil, fmt.Errorf("credential-process failed: %w", err)
+		}
+		return nil, fmt.Errorf("credential-process failed: %w: %s", err, msg)
+	}
+
+	return parseProcessCredentials(stdout.Bytes())
+}
+
+// parseProcessCredentials decodes and validates credential-process output.
+// The output is never echoed in errors as it contains the secret.
+func parseProcessCredentials(output []byte) (*ProcessCredentials, error) {
+	var creds ProcessCredentials
+	if err := json.Unmarshal(bytes.TrimSpace(output), &creds); err != nil {
+		return nil, fmt.Errorf("credential-process returned invalid JSON: %w", err)
+	}
+
+	if creds.KeyID == "" || creds.KeySecret == "" {
+		return nil, fmt.Errorf("credential-process output must include key_id and key_secret")
+	}
+
+	return &creds, nil
+}
@@ -0,0 +1,169 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countingCommand returns a shell command that records each invocation in a file and
+// prints the given JSON document, plus a function that reports the invocation count
+func countingCommand(t *testing.T, output string) (string, func() int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("credential-process tests use a POSIX shell")
+	}
+
+	tempDir := t.TempDir()
+	countFile := filepath.Join(tempDir, "count")
+	outFile := filepath.Join(tempDir, "out.json")
+	if err := os.WriteFile(outFile, []byte(output), 0600); err != nil {
+		t.Fatalf("Failed to write output file: %v", err)
+	}
+
+	command := fmt.Sprintf("echo x >> '%s'; cat '%s'", countFile, outFile)
+	count := func() int {
+		data, err := os.ReadFile(countFile)
+		if err != nil {
+			return 0
+		}
+		return strings.Count(string(data), "x")
+	}
+	return command, count
+}
+
+func TestProcessProvider_CachesUntilInvalidated(t *testing.T) {
+	command, count := countingCommand(t, `{"key_id": "proc-id", "key_secret": "proc-secret"}`)
+	provider := NewProcessProvider(command)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		apiID, apiSecret, err := provider.Retrieve(ctx)
+		if err != nil {
+			t.Fatalf("Retrieve failed: %v", err)
+		}
+		if apiID != "proc-id" || apiSecret != "proc-secret" {
+			t.Errorf("Unexpected credentials: %s/%s", apiID, apiSecret)
+		}
+	}
+
+	if got := count(); got != 1 {
+		t.Errorf("Expected credential process to run once, ran %d times", got)
+	}
+
+	provider.Invalidate()
+	if _, _, err := provider.Retrieve(ctx); err != nil {
+		t.Fatalf("Retrieve after Invalidate failed: %v", err)
+	}
+	if got := count(); got != 2 {
+		t.Errorf("Expected credential process to run again after Invalidate, ran %d times", got)
+	}
+}
+
+func TestProcessProvider_RefreshesOnExpiry(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	output := fmt.Sprintf(`{"key_id": "proc-id", "key_secret": "proc-secret", "expiration": %q}`,
+		expiration.Format(time.RFC3339))
+	command, count := countingCommand(t, output)
+
+	provider := NewProcessProvider(command)
+	now := expiration.Add(-time.Hour)
+	provider.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, _, err := provider.Retrieve(ctx); err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	if _, _, err := provider.Retrieve(ctx); err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	if got := count(); got != 1 {
+		t.Errorf("Expected cached credentials before expiry, process ran %d times", got)
+	}
+
+	// Within the expiry window the credentials are treated as expired
+	now = expiration.Add(-credentialExpiryWindow / 2)
+	if _, _, err := provider.Retrieve(ctx); err != nil {
+		t.Fatalf("Retrieve failed: %v", err)
+	}
+	if got := count(); got != 2 {
+		t.Errorf("Expected credential process to re-run near expiry, ran %d times", got)
+	}
+}
+
+func TestProcessProvider_CommandFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential-process tests use a POSIX shell")
+	}
+
+	provider := NewProcessProvider("echo 'vault is sealed' >&2; exit 3")
+	_, _, err := provider.Retrieve(context.Background())
+	if err == nil {
+		t.Fatal("Expected error from failing credential process")
+	}
+	if !strings.Contains(err.Error(), "vault is sealed") {
+		t.Errorf("Expected stderr in error, got: %v", err)
+	}
+}
+
+func TestParseProcessCredentials(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		expectError bool
+	}{
+		{name: "valid", output: `{"key_id": "id", "key_secret": "secret"}`},
+		{name: "valid with expiration", output: `{"key_id": "id", "key_secret": "secret", "expiration": "2030-01-01T00:00:00Z"}`},
+		{name: "trailing newline", output: "{\"key_id\": \"id\", \"key_secret\": \"secret\"}\n"},
+		{name: "missing secret", output: `{"key_id": "id"}`, expectError: true},
+		{name: "missing id", output: `{"key_secret": "secret"}`, expectError: true},
+		{name: "not json", output: `key_id=id`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := parseProcessCredentials([]byte(tt.output))
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if creds.KeyID != "id" || creds.KeySecret != "secret" {
+				t.Errorf("Unexpected credentials: %+v", creds)
+			}
+		})
+	}
+}
+
+func TestReadConfig_CredentialProcess(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "veracode.yml")
+	config := `api:
+  credential-process: op read op://vault/veracode/credentials
+  override-api-base-url: https://api.veracode.eu
+`
+	if err := os.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	parsed, err := readConfig(configPath)
+	if err != nil {
+		t.Fatalf("readConfig failed: %v", err)
+	}
+
+	if parsed.API.CredentialProcess != "op read op://vault/veracode/credentials" {
+		t.Errorf("Unexpected credential-process: %q", parsed.API.CredentialProcess)
+	}
+	if parsed.API.KeySecret != "" {
+		t.Errorf("Expected no key-secret, got %q", parsed.API.KeySecret)
+	}
+}
@@ -0,0 +1,285 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const ApplicationSummaryToolName = "application-summary"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(ApplicationSummaryToolName, handleApplicationSummary)
+}
+
+// ApplicationSummaryRequest represents the parsed parameters for application-summary
+type ApplicationSummaryRequest struct {
+	ApplicationPath string `json:"application_path"`
+	AppProfile      string `json:"app_profile,omitempty"`
+}
+
+// MCPApplicationSummary is an application's security posture: its profile, latest scans and finding counts
+type MCPApplicationSummary struct {
+	Application MCPApplicationProfile       `json:"application"`
+	Findings    MCPFindingCounts            `json:"findings"`              // Totals across every scan type
+	ByScanType  map[string]MCPFindingCounts `json:"findings_by_scan_type"` // STATIC, DYNAMIC, SCA and MANUAL
+	Warnings    []string                    `json:"warnings,omitempty"`
+}
+
+// MCPFindingCounts aggregates an application's findings
+type MCPFindingCounts struct {
+	Total              int            `json:"total"`
+	Open               int            `json:"open"`                // Open and not mitigated
+	Mitigated          int            `json:"mitigated"`           // Mitigation approved
+	MitigationProposed int            `json:"mitigation_proposed"` // Open, with a mitigation awaiting review
+	Fixed              int            `json:"fixed"`               // Closed without a mitigation
+	PolicyViolations   int            `json:"policy_violations"`
+	OpenBySeverity     map[string]int `json:"open_by_severity"`
+}
+
+// parseApplicationSummaryRequest extracts and validates parameters from the raw args map
+func parseApplicationSummaryRequest(args map[string]interface{}) (*ApplicationSummaryRequest, error) {
+	req := &ApplicationSummaryRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+
+	return req, nil
+}
+
+func handleApplicationSummary(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseApplicationSummaryRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return applicationSummaryError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 3: Look up the application profile, policy status and latest scans
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return applicationSummaryError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+	}
+
+	summary := &MCPApplicationSummary{
+		Application: convertApplicationProfile(*application),
+		Findings:    newFindingCounts(),
+		ByScanType:  make(map[string]MCPFindingCounts),
+	}
+
+	// Step 4: Count the policy scan's findings of every scan type
+	countApplicationFindings(ctx, client, application.GetGuid(), summary)
+
+	return formatApplicationSummaryResponse(summary), nil
+}
+
+// countApplicationFindings adds the counts of the policy scan's findings of every scan type to summary,
+// streaming every page so all findings are counted. A scan type that fails is left out with a warning.
+func countApplicationFindings(ctx context.Context, client api.Client, applicationGUID string, summary *MCPApplicationSummary) {
+	findingsReq := api.FindingsRequest{AppProfile: applicationGUID}
+	for _, scanType := range policyScanTypes {
+		counts := newFindingCounts()
+		var iterErr error
+		for finding, err := range client.IterateFindings(ctx, findingsReq, scanType) {
+			if err != nil {
+				iterErr = err
+				break
+			}
+			counts.add(finding)
+		}
+		if iterErr != nil {
+			log.Printf("Application summary: failed to retrieve %s findings: %v", scanType, iterErr)
+			summary.Warnings = append(summary.Warnings, fmt.Sprintf("Could not retrieve %s findings, so the totals exclude them: %v", scanType, iterErr))
+			continue
+		}
+		summary.ByScanType[scanType] = counts
+		summary.Findings.merge(counts)
+	}
+}
+
+// applicationSummaryError formats an error as an MCP tool response
+func applicationSummaryError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Application Summary - Error\n===========================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// newFindingCounts returns empty counts with every severity present
+func newFindingCounts() MCPFindingCounts {
+	counts := MCPFindingCounts{OpenBySeverity: make(map[string]int)}
+	for _, severity := range summarySeverities {
+		counts.OpenBySeverity[string(severity)] = 0
+	}
+	return counts
+}
+
+// summarySeverities are the severities reported by the summary, most severe first
+var summarySeverities = []SeverityLevel{SeverityVeryHigh, SeverityHigh, SeverityMedium, SeverityLow, SeverityVeryLow, SeverityInformational}
+
+// add counts a finding
+func (c *MCPFindingCounts) add(finding api.Finding) {
+	c.Total++
+
+	status := StatusUnknown
+	switch strings.ToUpper(finding.Status) {
+	case "OPEN", "NEW":
+		status = StatusOpen
+	case "CLOSED":
+		status = StatusClosed
+	}
+	resolution := strings.ToUpper(finding.ResolutionStatus)
+
+	switch {
+	case resolution == string(MitigationApproved):
+		c.Mitigated++
+	case status == StatusClosed:
+		c.Fixed++
+	default:
+		c.Open++
+		if resolution == string(MitigationProposed) {
+			c.MitigationProposed++
+		}
+		severity := finding.SeverityScore
+		c.OpenBySeverity[string(TransformSeverity(&severity))]++
+	}
+
+	violatesPolicy := finding.ViolatesPolicy
+	if DeterminesPolicyViolation(status, finding.ResolutionStatus, &violatesPolicy) {
+		c.PolicyViolations++
+	}
+}
+
+// merge adds other's counts to c
+func (c *MCPFindingCounts) merge(other MCPFindingCounts) {
+	c.Total += other.Total
+	c.Open += other.Open
+	c.Mitigated += other.Mitigated
+	c.MitigationProposed += other.MitigationProposed
+	c.Fixed += other.Fixed
+	c.PolicyViolations += other.PolicyViolations
+	for severity, count := range other.OpenBySeverity {
+		c.OpenBySeverity[severity] += count
+	}
+}
+
+// formatApplicationSummaryResponse returns the summary as structured content, with a markdown digest and the JSON as text
+func formatApplicationSummaryResponse(summary *MCPApplicationSummary) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format application summary: %v", err)}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{
+			{
+				"type": "text",
+				"text": applicationSummaryDigest(summary),
+			},
+			{
+				"type": "text",
+				"text": string(responseJSON),
+			},
+		},
+		"structuredContent": summary,
+	}
+}
+
+// applicationSummaryDigest renders a short markdown overview of the summary
+func applicationSummaryDigest(summary *MCPApplicationSummary) string {
+	app := summary.Application
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## %s\n\n", app.Name)
+	if app.Policy != "" {
+		fmt.Fprintf(&b, "- **Policy:** %s — **%s**\n", app.Policy, app.ComplianceStatus)
+	}
+	if app.BusinessCriticality != "" {
+		fmt.Fprintf(&b, "- **Business criticality:** %s\n", app.BusinessCriticality)
+	}
+	if len(app.Teams) > 0 {
+		fmt.Fprintf(&b, "- **Teams:** %s\n", strings.Join(app.Teams, ", "))
+	}
+	if app.LastCompletedScan != "" {
+		fmt.Fprintf(&b, "- **Last completed scan:** %s\n", app.LastCompletedScan)
+	}
+
+	if len(app.Scans) > 0 {
+		b.WriteString("\n| Scan | Status | Published |\n|---|---|---|\n")
+		for _, scan := range app.Scans {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", scan.ScanType, scan.Status, scan.Published)
+		}
+	}
+
+	b.WriteString("\n| Findings | Open | Policy violations | Mitigated | Fixed | Very High | High | Medium |\n|---|---|---|---|---|---|---|---|\n")
+	for _, scanType := range policyScanTypes {
+		if counts, ok := summary.ByScanType[scanType]; ok {
+			writeFindingCountsRow(&b, scanType, counts)
+		}
+	}
+	writeFindingCountsRow(&b, "**Total**", summary.Findings)
+
+	for _, warning := range summary.Warnings {
+		fmt.Fprintf(&b, "\n⚠️ %s\n", warning)
+	}
+
+	return b.String()
+}
+
+// writeFindingCountsRow writes one row of the digest's findings table
+func writeFindingCountsRow(b *strings.Builder, label string, counts MCPFindingCounts) {
+	fmt.Fprintf(b, "| %s | %d | %d | %d | %d | %d | %d | %d |\n",
+		label,
+		counts.Open,
+		counts.PolicyViolations,
+		counts.Mitigated,
+		counts.Fixed,
+		counts.OpenBySeverity[string(SeverityVeryHigh)],
+		counts.OpenBySeverity[string(SeverityHigh)],
+		counts.OpenBySeverity[string(SeverityMedium)],
+	)
+}
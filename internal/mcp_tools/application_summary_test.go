@@ -0,0 +1,121 @@
+package mcp_tools
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+func TestFindingCounts_Add(t *testing.T) {
+	counts := newFindingCounts()
+
+	counts.add(api.Finding{Status: "OPEN", SeverityScore: 5, ViolatesPolicy: true})
+	counts.add(api.Finding{Status: "OPEN", SeverityScore: 3, ResolutionStatus: "PROPOSED", ViolatesPolicy: true})
+	counts.add(api.Finding{Status: "OPEN", SeverityScore: 4, ResolutionStatus: "APPROVED"})
+	counts.add(api.Finding{Status: "CLOSED", SeverityScore: 4, ViolatesPolicy: true})
+
+	if counts.Total != 4 || counts.Open != 2 || counts.Mitigated != 1 || counts.Fixed != 1 {
+		t.Errorf("Unexpected counts: %+v", counts)
+	}
+	if counts.MitigationProposed != 1 {
+		t.Errorf("Expected one proposed mitigation, got %d", counts.MitigationProposed)
+	}
+	if counts.PolicyViolations != 2 {
+		t.Errorf("Expected closed findings not to count as policy violations, got %d", counts.PolicyViolations)
+	}
+	if counts.OpenBySeverity[string(SeverityVeryHigh)] != 1 || counts.OpenBySeverity[string(SeverityMedium)] != 1 || counts.OpenBySeverity[string(SeverityHigh)] != 0 {
+		t.Errorf("Expected only open findings counted by severity, got %v", counts.OpenBySeverity)
+	}
+}
+
+func TestFindingCounts_Merge(t *testing.T) {
+	static := newFindingCounts()
+	static.add(api.Finding{Status: "OPEN", SeverityScore: 4, ViolatesPolicy: true})
+	sca := newFindingCounts()
+	sca.add(api.Finding{Status: "OPEN", SeverityScore: 4})
+
+	total := newFindingCounts()
+	total.merge(static)
+	total.merge(sca)
+
+	if total.Total != 2 || total.Open != 2 || total.PolicyViolations != 1 || total.OpenBySeverity[string(SeverityHigh)] != 2 {
+		t.Errorf("Unexpected merged counts: %+v", total)
+	}
+}
+
+func TestFormatApplicationSummaryResponse(t *testing.T) {
+	static := newFindingCounts()
+	static.add(api.Finding{Status: "OPEN", SeverityScore: 5, ViolatesPolicy: true})
+	summary := &MCPApplicationSummary{
+		Application: MCPApplicationProfile{
+			Name:             "MyApp",
+			Policy:           "Veracode Recommended High",
+			ComplianceStatus: "DID_NOT_PASS",
+			Teams:            []string{"Payments"},
+			Scans:            []MCPLatestScan{{ScanType: "STATIC", Status: "PUBLISHED", Published: "2026-02-03T04:05:06Z"}},
+		},
+		Findings:   newFindingCounts(),
+		ByScanType: map[string]MCPFindingCounts{"STATIC": static},
+		Warnings:   []string{"Could not retrieve SCA findings"},
+	}
+	summary.Findings.merge(static)
+
+	result := formatApplicationSummaryResponse(summary)
+
+	if result["structuredContent"] != summary {
+		t.Error("Expected the summary as structured content")
+	}
+	content := result["content"].([]map[string]interface{})
+	if len(content) != 2 {
+		t.Fatalf("Expected a digest and the JSON, got %d content blocks", len(content))
+	}
+
+	digest := content[0]["text"].(string)
+	for _, want := range []string{"## MyApp", "Veracode Recommended High — **DID_NOT_PASS**", "| STATIC | PUBLISHED |", "| STATIC | 1 | 1 | 0 | 0 | 1 |", "| **Total** | 1 |", "Could not retrieve SCA findings"} {
+		if !strings.Contains(digest, want) {
+			t.Errorf("Expected digest to contain %q, got:\n%s", want, digest)
+		}
+	}
+	if strings.Contains(digest, "| DYNAMIC |") {
+		t.Error("Expected scan types that failed or weren't fetched to be left out of the table")
+	}
+}
+
+// summaryFindingsClient streams 1500 open STATIC findings and fails for SCA
+type summaryFindingsClient struct {
+	api.Client
+}
+
+func (c *summaryFindingsClient) IterateFindings(ctx context.Context, req api.FindingsRequest, scanType string) iter.Seq2[api.Finding, error] {
+	return func(yield func(api.Finding, error) bool) {
+		switch scanType {
+		case "STATIC":
+			for i := 0; i < 1500; i++ {
+				if !yield(api.Finding{Status: "OPEN", SeverityScore: 5}, nil) {
+					return
+				}
+			}
+		case "SCA":
+			yield(api.Finding{}, errors.New("service unavailable"))
+		}
+	}
+}
+
+func TestCountApplicationFindings_CountsEveryFindingAndReportsFailures(t *testing.T) {
+	summary := &MCPApplicationSummary{Findings: newFindingCounts(), ByScanType: make(map[string]MCPFindingCounts)}
+	countApplicationFindings(context.Background(), &summaryFindingsClient{}, "app-guid", summary)
+
+	if summary.Findings.Total != 1500 || summary.ByScanType["STATIC"].Open != 1500 {
+		t.Errorf("Expected every streamed finding to be counted, got %+v", summary.Findings)
+	}
+	if _, ok := summary.ByScanType["SCA"]; ok {
+		t.Error("Expected the failed scan type to be left out")
+	}
+	if len(summary.Warnings) != 1 || !strings.Contains(summary.Warnings[0], "Could not retrieve SCA findings") {
+		t.Errorf("Expected a warning for the failed scan type, got %v", summary.Warnings)
+	}
+}
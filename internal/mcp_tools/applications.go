@@ -0,0 +1,326 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const ApplicationsToolName = "applications"
+
+// Allowed values for the applications tool's enum filters
+var (
+	policyComplianceStatuses = []string{"PASSED", "CONDITIONAL_PASS", "DID_NOT_PASS", "NOT_ASSESSED", "DETERMINING", "VENDOR_REVIEW"}
+	applicationScanTypes     = []string{"STATIC", "DYNAMIC", "MANUAL"}
+	applicationScanStatuses  = []string{
+		"CREATED", "UNPUBLISHED", "DELETED", "PARTIAL_PUBLISH", "PARTIAL_UNPUBLISH", "INCOMPLETE", "SCAN_SUBMITTED",
+		"IN_QUEUE", "STOPPING", "PAUSING", "IN_PROGRESS", "ANALYSIS_ERRORS", "SCAN_CANCELED", "INTERNAL_REVIEW",
+		"VERIFYING_RESULTS", "SUBMITTED_FOR_NTO_PRE_SCAN", "SUBMITTED_FOR_DYNAMIC_PRE_SCAN", "PRE_SCAN_FAILED",
+		"READY_TO_SUBMIT", "NTO_PENDING_SUBMISSION", "PRE_SCAN_COMPLETE", "MODULE_SELECTION_REQUIRED",
+		"PENDING_VENDOR_ACCEPTANCE", "SHOW_OSRDB", "PUBLISHED", "PUBLISHED_TO_VENDOR", "PUBLISHED_TO_ENTERPRISE",
+		"PENDING_ACCOUNT_APPROVAL", "PENDING_LEGAL_AGREEMENT", "SCAN_IN_PROGRESS", "SCAN_IN_PROGRESS_PARTIAL_RESULTS_READY",
+		"PROMOTE_IN_PROGRESS", "PRE_SCAN_CANCELED", "NTO_PRE_SCAN_CANCELED", "SCAN_HELD_APPROVAL",
+		"SCAN_HELD_LOGIN_INSTRUCTIONS", "SCAN_HELD_LOGIN", "SCAN_HELD_INSTRUCTIONS", "SCAN_HELD_HOLDS_FINISHED",
+		"SCAN_REQUESTED", "TIMEFRAMEPENDING_ID", "PAUSED_ID", "STATIC_VALIDATING_UPLOAD", "PUBLISHED_TO_ENTERPRISEINT",
+	}
+)
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(ApplicationsToolName, handleSearchApplications)
+}
+
+// ApplicationsRequest represents the parsed parameters for applications
+type ApplicationsRequest struct {
+	Name                         string     `json:"name,omitempty"`
+	BusinessUnit                 string     `json:"business_unit,omitempty"`
+	Team                         string     `json:"team,omitempty"`
+	Tag                          string     `json:"tag,omitempty"`
+	Policy                       string     `json:"policy,omitempty"`
+	PolicyCompliance             string     `json:"policy_compliance,omitempty"`
+	PolicyComplianceCheckedAfter *time.Time `json:"policy_compliance_checked_after,omitempty"`
+	ScanType                     string     `json:"scan_type,omitempty"`
+	ScanStatus                   []string   `json:"scan_status,omitempty"`
+	ModifiedAfter                *time.Time `json:"modified_after,omitempty"`
+	CustomFieldNames             []string   `json:"custom_field_names,omitempty"`
+	CustomFieldValues            []string   `json:"custom_field_values,omitempty"`
+	Size                         int        `json:"size,omitempty"`
+	Page                         int        `json:"page,omitempty"`
+}
+
+// MCPApplicationsResponse represents the applications tool response
+type MCPApplicationsResponse struct {
+	Applications []MCPApplicationProfile `json:"applications"`
+	Pagination   *MCPPagination          `json:"pagination,omitempty"`
+}
+
+// MCPApplicationProfile summarises an application profile found by the applications tool
+type MCPApplicationProfile struct {
+	GUID                string           `json:"guid"` // Pass as app_profile to the other platform tools
+	Name                string           `json:"name"`
+	ID                  int32            `json:"id,omitempty"` // Legacy application ID
+	Description         string           `json:"description,omitempty"`
+	BusinessCriticality string           `json:"business_criticality,omitempty"`
+	BusinessUnit        string           `json:"business_unit,omitempty"`
+	Teams               []string         `json:"teams,omitempty"`
+	Tags                string           `json:"tags,omitempty"`
+	Policy              string           `json:"policy,omitempty"`
+	ComplianceStatus    string           `json:"compliance_status,omitempty"`
+	LastCompletedScan   string           `json:"last_completed_scan,omitempty"` // RFC3339
+	Scans               []MCPLatestScan  `json:"scans,omitempty"`
+	CustomFields        []MCPCustomField `json:"custom_fields,omitempty"`
+}
+
+// MCPLatestScan is the latest scan of one scan type on an application
+type MCPLatestScan struct {
+	ScanType  string `json:"scan_type"`
+	Status    string `json:"status,omitempty"`
+	Published string `json:"published,omitempty"` // RFC3339
+}
+
+// MCPCustomField is a custom field set on an application profile
+type MCPCustomField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// parseApplicationsRequest extracts and validates parameters from the raw args map
+func parseApplicationsRequest(args map[string]interface{}) (*ApplicationsRequest, error) {
+	req := &ApplicationsRequest{}
+
+	// Extract optional text filters
+	req.Name, _ = extractOptionalString(args, "name")
+	req.BusinessUnit, _ = extractOptionalString(args, "business_unit")
+	req.Team, _ = extractOptionalString(args, "team")
+	req.Tag, _ = extractOptionalString(args, "tag")
+	req.Policy, _ = extractOptionalString(args, "policy")
+	req.Size = extractInt(args, "page_size", 50)
+	req.Page = extractInt(args, "page", 0)
+
+	// Extract enum filters
+	var err error
+	req.PolicyCompliance, err = extractOptionalEnum(args, "policy_compliance", policyComplianceStatuses)
+	if err != nil {
+		return nil, err
+	}
+	req.ScanType, err = extractOptionalEnum(args, "scan_type", applicationScanTypes)
+	if err != nil {
+		return nil, err
+	}
+	req.ScanStatus, err = extractStringList(args, "scan_status")
+	if err != nil {
+		return nil, err
+	}
+	for i, status := range req.ScanStatus {
+		req.ScanStatus[i] = strings.ToUpper(status)
+		if !slices.Contains(applicationScanStatuses, req.ScanStatus[i]) {
+			return nil, fmt.Errorf("scan_status contains an unknown scan status %q", status)
+		}
+	}
+
+	// Extract date filters
+	req.ModifiedAfter, err = extractOptionalDate(args, "modified_after")
+	if err != nil {
+		return nil, err
+	}
+	req.PolicyComplianceCheckedAfter, err = extractOptionalDate(args, "policy_compliance_checked_after")
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract custom field filters
+	req.CustomFieldNames, err = extractStringList(args, "custom_field_names")
+	if err != nil {
+		return nil, err
+	}
+	req.CustomFieldValues, err = extractStringList(args, "custom_field_values")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.CustomFieldValues) > 0 && len(req.CustomFieldNames) == 0 {
+		return nil, fmt.Errorf("custom_field_values requires custom_field_names")
+	}
+
+	// Validate pagination bounds
+	if err := validatePaginationParams(req.Size, req.Page); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func handleSearchApplications(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseApplicationsRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	client, err := api.NewClient()
+	if err != nil {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf("Applications - Error\n====================\n\nError: Failed to create API client\n\n%v\n\nPlease ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.", err),
+			}},
+		}, nil
+	}
+
+	resp, err := client.SearchApplications(ctx, api.ApplicationsRequest{
+		Name:                         req.Name,
+		BusinessUnit:                 req.BusinessUnit,
+		Team:                         req.Team,
+		Tag:                          req.Tag,
+		Policy:                       req.Policy,
+		PolicyCompliance:             req.PolicyCompliance,
+		PolicyComplianceCheckedAfter: req.PolicyComplianceCheckedAfter,
+		ScanType:                     req.ScanType,
+		ScanStatus:                   req.ScanStatus,
+		ModifiedAfter:                req.ModifiedAfter,
+		CustomFieldNames:             req.CustomFieldNames,
+		CustomFieldValues:            req.CustomFieldValues,
+		Page:                         req.Page,
+		Size:                         req.Size,
+	})
+	if err != nil {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf("Applications - Error\n====================\n\nError: Failed to search applications\n\n%v\n\nPlease verify API credentials have permission to view application profiles.", err),
+			}},
+		}, nil
+	}
+
+	return formatApplicationsResponse(resp), nil
+}
+
+// isApplicationGUID reports whether appProfile looks like a GUID (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+// rather than an application name
+func isApplicationGUID(appProfile string) bool {
+	return len(appProfile) == 36 && appProfile[8] == '-' && appProfile[13] == '-' && appProfile[18] == '-' && appProfile[23] == '-'
+}
+
+// resolveApplication retrieves an application by GUID or by name
+func resolveApplication(ctx context.Context, client api.Client, appProfile string) (*applications.Application, error) {
+	if isApplicationGUID(appProfile) {
+		return client.GetApplication(ctx, appProfile)
+	}
+	return client.GetApplicationByName(ctx, appProfile)
+}
+
+// newWorkspaceClient creates an API client with the credentials of the workspace applicationPath belongs to:
+// the profile its .veracode-workspace.json names in credential_profile, otherwise the default credentials.
+// A missing or unreadable workspace file leaves the default credentials; the tools report problems with it.
+func newWorkspaceClient(applicationPath string) (api.Client, error) {
+	workspaceFile, err := workspace.FindWorkspaceFile(applicationPath)
+	if err != nil {
+		return api.NewClient()
+	}
+	config, err := workspace.ReadWorkspaceFile(workspaceFile)
+	if err != nil || config.CredentialProfile == "" {
+		return api.NewClient()
+	}
+	return api.NewClientForProfile(config.CredentialProfile)
+}
+
+// convertApplicationProfile converts an Applications API application into an MCPApplicationProfile
+func convertApplicationProfile(application applications.Application) MCPApplicationProfile {
+	profile := MCPApplicationProfile{
+		GUID: application.GetGuid(),
+		ID:   application.GetId(),
+	}
+	if application.LastCompletedScanDate != nil {
+		profile.LastCompletedScan = application.LastCompletedScanDate.Format(time.RFC3339)
+	}
+
+	if appProfile := application.Profile; appProfile != nil {
+		profile.Name = appProfile.GetName()
+		profile.Description = appProfile.GetDescription()
+		profile.BusinessCriticality = appProfile.GetBusinessCriticality()
+		profile.Tags = appProfile.GetTags()
+		if appProfile.BusinessUnit != nil {
+			profile.BusinessUnit = appProfile.BusinessUnit.GetName()
+		}
+		for _, team := range appProfile.Teams {
+			if team.TeamName != nil {
+				profile.Teams = append(profile.Teams, *team.TeamName)
+			}
+		}
+		for _, field := range appProfile.CustomFields {
+			if field.Name != nil && field.GetValue() != "" {
+				profile.CustomFields = append(profile.CustomFields, MCPCustomField{Name: *field.Name, Value: field.GetValue()})
+			}
+		}
+		if appPolicy := applicationPolicy(&application); appPolicy != nil {
+			profile.Policy = appPolicy.GetName()
+			profile.ComplianceStatus = appPolicy.GetPolicyComplianceStatus()
+		}
+	}
+
+	for _, scan := range application.Scans {
+		latest := MCPLatestScan{
+			ScanType: scan.GetScanType(),
+			Status:   scan.GetStatus(),
+		}
+		if scan.ModifiedDate != nil {
+			latest.Published = scan.ModifiedDate.Format(time.RFC3339)
+		}
+		profile.Scans = append(profile.Scans, latest)
+	}
+
+	return profile
+}
+
+// formatApplicationsResponse formats a page of applications into an MCP tool response
+func formatApplicationsResponse(resp *applications.PagedResourceOfApplication) map[string]interface{} {
+	response := MCPApplicationsResponse{
+		Applications: []MCPApplicationProfile{},
+	}
+	if resp.Embedded != nil {
+		for _, application := range resp.Embedded.Applications {
+			response.Applications = append(response.Applications, convertApplicationProfile(application))
+		}
+	}
+	if page := resp.Page; page != nil {
+		response.Pagination = &MCPPagination{
+			CurrentPage:   int(page.GetNumber()),
+			TotalPages:    int(page.GetTotalPages()),
+			PageSize:      int(page.GetSize()),
+			TotalElements: int(page.GetTotalElements()),
+			HasNext:       page.GetNumber()+1 < page.GetTotalPages(),
+			HasPrevious:   page.GetNumber() > 0,
+		}
+	}
+
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format applications: %v", err)}
+	}
+
+	var summary string
+	switch {
+	case len(response.Applications) == 0:
+		summary = "No applications matched the filters.\n\n"
+	case response.Pagination != nil:
+		summary = fmt.Sprintf("Found %d applications (showing %d). Pass an application's name or guid as app_profile to the other Veracode tools.\n\n",
+			response.Pagination.TotalElements, len(response.Applications))
+	default:
+		summary = fmt.Sprintf("Found %d applications. Pass an application's name or guid as app_profile to the other Veracode tools.\n\n", len(response.Applications))
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + string(responseJSON),
+		}},
+	}
+}
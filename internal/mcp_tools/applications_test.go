@@ -0,0 +1,155 @@
+package mcp_tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+func TestParseApplicationsRequest(t *testing.T) {
+	req, err := parseApplicationsRequest(map[string]interface{}{
+		"name":              "Verademo",
+		"policy_compliance": "did_not_pass",
+		"scan_type":         "static",
+		"scan_status":       []interface{}{"published", "IN_PROGRESS"},
+		"modified_after":    "2026-01-15",
+		"page_size":         float64(25),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if req.Name != "Verademo" || req.PolicyCompliance != "DID_NOT_PASS" || req.ScanType != "STATIC" {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+	if len(req.ScanStatus) != 2 || req.ScanStatus[0] != "PUBLISHED" {
+		t.Errorf("Expected upper-cased scan statuses, got %v", req.ScanStatus)
+	}
+	if req.ModifiedAfter == nil || !req.ModifiedAfter.Equal(time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected modified_after: %v", req.ModifiedAfter)
+	}
+	if req.Size != 25 || req.Page != 0 {
+		t.Errorf("Unexpected pagination: size=%d page=%d", req.Size, req.Page)
+	}
+}
+
+func TestParseApplicationsRequest_Defaults(t *testing.T) {
+	req, err := parseApplicationsRequest(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if req.Size != 50 {
+		t.Errorf("Expected the API's default page size of 50, got %d", req.Size)
+	}
+}
+
+func TestParseApplicationsRequest_Validation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"bad compliance":         {"policy_compliance": "FAILED"},
+		"bad scan type":          {"scan_type": "SCA"},
+		"bad scan status":        {"scan_status": []interface{}{"DONE"}},
+		"bad date":               {"modified_after": "01/15/2026"},
+		"values without names":   {"custom_field_values": []interface{}{"Alice"}},
+		"page size out of range": {"page_size": float64(501)},
+	}
+
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseApplicationsRequest(args); err == nil {
+				t.Errorf("Expected error for %s", name)
+			}
+		})
+	}
+}
+
+func TestConvertApplicationProfile(t *testing.T) {
+	scanned := time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)
+	application := applications.Application{
+		Guid:                  stringPtr("app-guid"),
+		Id:                    int32Ptr(42),
+		LastCompletedScanDate: &scanned,
+		Profile: &applications.ApplicationProfile{
+			Name:                stringPtr("MyApp"),
+			BusinessCriticality: stringPtr("HIGH"),
+			BusinessUnit:        &applications.BusinessUnit{Name: stringPtr("Retail")},
+			Teams:               []applications.AppTeam{{TeamName: stringPtr("Payments")}},
+			CustomFields:        []applications.CustomNameValue{{Name: stringPtr("Owner"), Value: stringPtr("alice")}, {Name: stringPtr("Empty")}},
+			Policies: []applications.AppPolicy{
+				{Name: stringPtr("Other"), PolicyComplianceStatus: stringPtr("PASSED")},
+				{Name: stringPtr("Default"), IsDefault: boolPtr(true), PolicyComplianceStatus: stringPtr("DID_NOT_PASS")},
+			},
+		},
+		Scans: []applications.ApplicationScan{{ScanType: stringPtr("STATIC"), Status: stringPtr("PUBLISHED"), ModifiedDate: &scanned}},
+	}
+
+	profile := convertApplicationProfile(application)
+
+	if profile.GUID != "app-guid" || profile.Name != "MyApp" || profile.ID != 42 {
+		t.Errorf("Unexpected identity: %+v", profile)
+	}
+	if profile.BusinessUnit != "Retail" || len(profile.Teams) != 1 || len(profile.CustomFields) != 1 {
+		t.Errorf("Unexpected profile details: %+v", profile)
+	}
+	if profile.Policy != "Default" || profile.ComplianceStatus != "DID_NOT_PASS" {
+		t.Errorf("Expected the default policy, got %s (%s)", profile.Policy, profile.ComplianceStatus)
+	}
+	if profile.LastCompletedScan != "2026-02-03T04:05:06Z" || len(profile.Scans) != 1 || profile.Scans[0].Published != profile.LastCompletedScan {
+		t.Errorf("Unexpected scan dates: %+v", profile)
+	}
+}
+
+func TestFormatApplicationsResponse_Empty(t *testing.T) {
+	result := formatApplicationsResponse(&applications.PagedResourceOfApplication{})
+
+	text := result["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.HasPrefix(text, "No applications matched") || !strings.Contains(text, `"applications": []`) {
+		t.Errorf("Unexpected empty response: %s", text)
+	}
+}
+
+func TestIsApplicationGUID(t *testing.T) {
+	if !isApplicationGUID("65c204e5-a74c-4b68-a62a-4bfdc08e27af") {
+		t.Error("Expected a GUID to be recognised")
+	}
+	if isApplicationGUID("MCPVerademo-NET") {
+		t.Error("Expected an application name not to be treated as a GUID")
+	}
+}
+
+func TestNewWorkspaceClient_UsesCredentialProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	if err := os.MkdirAll(filepath.Join(home, ".veracode"), 0750); err != nil {
+		t.Fatal(err)
+	}
+	config := "profiles:\n  team-a:\n    key-id: team-a-id\n    key-secret: team-a-secret\n"
+	if err := os.WriteFile(filepath.Join(home, ".veracode", "veracode.yml"), []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	appPath := t.TempDir()
+	writeProfile := func(profile string) {
+		content := fmt.Sprintf(`{"name": "App", "credential_profile": %q}`, profile)
+		if err := os.WriteFile(filepath.Join(appPath, workspace.WorkspaceFileName), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeProfile("team-a")
+	if _, err := newWorkspaceClient(appPath); err != nil {
+		t.Errorf("Expected the team-a profile to be used, got: %v", err)
+	}
+
+	// A profile that doesn't exist never falls back to other credentials
+	writeProfile("team-b")
+	if _, err := newWorkspaceClient(appPath); err == nil || !strings.Contains(err.Error(), `credential profile "team-b" not found`) {
+		t.Errorf("Expected a missing profile error, got: %v", err)
+	}
+}
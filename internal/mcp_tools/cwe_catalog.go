@@ -0,0 +1,99 @@
+package mcp_tools
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// cweCatalogJSON is an offline snapshot of the CWEs Veracode commonly reports, used when the
+// CWE API is unavailable and to name the weakness of every finding without an API call
+//
+//go:embed cwe_catalog.json
+var cweCatalogJSON []byte
+
+// CWECatalogEntry is a CWE from the embedded catalog snapshot
+type CWECatalogEntry struct {
+	ID             int32  `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Category       string `json:"category"`
+	Recommendation string `json:"recommendation,omitempty"`
+}
+
+var (
+	cweCatalogOnce       sync.Once
+	cweCatalog           map[int32]CWECatalogEntry
+	cweCatalogByCategory map[string][]int32
+)
+
+// loadCWECatalog parses the embedded catalog once
+func loadCWECatalog() {
+	cweCatalogOnce.Do(func() {
+		cweCatalog = make(map[int32]CWECatalogEntry)
+		cweCatalogByCategory = make(map[string][]int32)
+
+		var snapshot struct {
+			CWEs []CWECatalogEntry `json:"cwes"`
+		}
+		if err := json.Unmarshal(cweCatalogJSON, &snapshot); err != nil {
+			log.Printf("Failed to parse embedded CWE catalog: %v", err)
+			return
+		}
+
+		for _, entry := range snapshot.CWEs {
+			cweCatalog[entry.ID] = entry
+			cweCatalogByCategory[entry.Category] = append(cweCatalogByCategory[entry.Category], entry.ID)
+		}
+		for _, ids := range cweCatalogByCategory {
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		}
+	})
+}
+
+// lookupCWE returns the catalog entry for a CWE
+func lookupCWE(cweID int32) (CWECatalogEntry, bool) {
+	loadCWECatalog()
+	entry, ok := cweCatalog[cweID]
+	return entry, ok
+}
+
+// CWEName returns the catalog name of a CWE, or "" if the catalog doesn't include it
+func CWEName(cweID int32) string {
+	entry, _ := lookupCWE(cweID)
+	return entry.Name
+}
+
+// relatedCWEs returns the other catalog CWEs in the same category as cweID
+func relatedCWEs(cweID int32) []int32 {
+	entry, ok := lookupCWE(cweID)
+	if !ok || entry.Category == "" {
+		return nil
+	}
+
+	var related []int32
+	for _, id := range cweCatalogByCategory[entry.Category] {
+		if id != cweID {
+			related = append(related, id)
+		}
+	}
+	return related
+}
+
+// searchCWECatalog returns the catalog CWEs whose name or category contains query (case-insensitive), ordered by ID
+func searchCWECatalog(query string) []CWECatalogEntry {
+	loadCWECatalog()
+	query = strings.ToLower(query)
+
+	var matches []CWECatalogEntry
+	for _, entry := range cweCatalog {
+		if strings.Contains(strings.ToLower(entry.Name), query) || strings.Contains(strings.ToLower(entry.Category), query) {
+			matches = append(matches, entry)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches
+}
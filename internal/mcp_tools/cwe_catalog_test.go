@@ -0,0 +1,81 @@
+package mcp_tools
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCWECatalog_CoversRemediationGuidance(t *testing.T) {
+	entries, err := remediationGuidanceFS.ReadDir("remediation_guidance")
+	if err != nil {
+		t.Fatalf("Failed to read remediation guidance: %v", err)
+	}
+
+	for _, dirEntry := range entries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+		id, err := strconv.Atoi(dirEntry.Name())
+		if err != nil {
+			continue
+		}
+		entry, ok := lookupCWE(int32(id)) // #nosec G115 - directory names are small CWE numbers
+		if !ok {
+			t.Errorf("CWE-%d has remediation guidance but no catalog entry", id)
+			continue
+		}
+		if entry.Name == "" || entry.Description == "" || entry.Category == "" {
+			t.Errorf("CWE-%d catalog entry is incomplete: %+v", id, entry)
+		}
+	}
+}
+
+func TestCWEName(t *testing.T) {
+	if name := CWEName(89); name != "Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')" {
+		t.Errorf("Unexpected name for CWE-89: %q", name)
+	}
+	if name := CWEName(99999); name != "" {
+		t.Errorf("Expected no name for an unknown CWE, got %q", name)
+	}
+}
+
+func TestRelatedCWEs(t *testing.T) {
+	related := relatedCWEs(78)
+
+	found := map[int32]bool{}
+	for _, id := range related {
+		found[id] = true
+	}
+	if !found[77] || !found[88] {
+		t.Errorf("Expected CWE-77 and CWE-88 to be related to CWE-78, got %v", related)
+	}
+	if found[78] {
+		t.Error("Expected a CWE not to be related to itself")
+	}
+}
+
+func TestSearchCWECatalog(t *testing.T) {
+	matches := searchCWECatalog("sql")
+	if len(matches) < 2 {
+		t.Fatalf("Expected at least CWE-89 and CWE-564, got %v", matches)
+	}
+	for i := 1; i < len(matches); i++ {
+		if matches[i-1].ID >= matches[i].ID {
+			t.Fatalf("Expected matches ordered by ID, got %d before %d", matches[i-1].ID, matches[i].ID)
+		}
+	}
+}
+
+func TestTransformWeaknessType(t *testing.T) {
+	known := int32(79)
+	if got := TransformWeaknessType(&known); got != "CWE-79: Improper Neutralization of Input During Web Page Generation ('Cross-site Scripting')" {
+		t.Errorf("Unexpected weakness type: %q", got)
+	}
+	unknown := int32(99999)
+	if got := TransformWeaknessType(&unknown); got != "CWE-99999" {
+		t.Errorf("Expected the bare CWE ID for an unknown CWE, got %q", got)
+	}
+	if got := TransformWeaknessType(nil); got != "Unknown" {
+		t.Errorf("Expected Unknown for nil, got %q", got)
+	}
+}
@@ -0,0 +1,282 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
+)
+
+const CWEInfoToolName = "cwe-info"
+
+// maxCWESearchResults caps the number of CWEs a name search returns
+const maxCWESearchResults = 25
+
+// CWE information sources reported in the response
+const (
+	cweSourceAPI     = "veracode_api"
+	cweSourceCatalog = "offline_catalog"
+)
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(CWEInfoToolName, handleGetCWEInfo)
+}
+
+// CWEInfoRequest represents the parsed parameters for cwe-info
+type CWEInfoRequest struct {
+	CWEID int32  `json:"cwe_id,omitempty"`
+	Query string `json:"query,omitempty"`
+}
+
+// MCPCWEInfo represents a CWE in the cwe-info response
+type MCPCWEInfo struct {
+	ID                int32           `json:"cwe_id"`
+	Name              string          `json:"name"`
+	Description       string          `json:"description,omitempty"`
+	RemediationEffort int32           `json:"remediation_effort,omitempty"` // 1 (trivial) to 5 (complex); only available from the CWE API
+	Recommendation    string          `json:"recommendation,omitempty"`
+	Severity          *int32          `json:"severity,omitempty"`
+	Category          *MCPCWECategory `json:"category,omitempty"`
+	RelatedCWEs       []MCPRelatedCWE `json:"related_cwes,omitempty"` // Other CWEs in the same category
+	References        []Reference     `json:"references,omitempty"`
+	Source            string          `json:"source"` // veracode_api or offline_catalog
+}
+
+// MCPCWECategory represents the Veracode finding category of a CWE
+type MCPCWECategory struct {
+	ID             int32  `json:"id,omitempty"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	Recommendation string `json:"recommendation,omitempty"`
+}
+
+// MCPRelatedCWE represents a CWE referenced by ID and name
+type MCPRelatedCWE struct {
+	ID       int32  `json:"cwe_id"`
+	Name     string `json:"name"`
+	Category string `json:"category,omitempty"`
+}
+
+// parseCWEInfoRequest extracts and validates parameters from the raw args map
+func parseCWEInfoRequest(args map[string]interface{}) (*CWEInfoRequest, error) {
+	req := &CWEInfoRequest{}
+
+	// cwe_id may be a number (89) or a string ("89" or "CWE-89")
+	switch v := args["cwe_id"].(type) {
+	case float64:
+		if v <= 0 || v > 2147483647 {
+			return nil, fmt.Errorf("cwe_id must be a positive integer, got %v", v)
+		}
+		req.CWEID = int32(v)
+	case string:
+		trimmed := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(v)), "CWE-")
+		if trimmed != "" {
+			id, err := strconv.ParseInt(trimmed, 10, 32)
+			if err != nil || id <= 0 {
+				return nil, fmt.Errorf("cwe_id must be a CWE number such as 89 or CWE-89, got %q", v)
+			}
+			req.CWEID = int32(id)
+		}
+	}
+
+	req.Query, _ = extractOptionalString(args, "query")
+	req.Query = strings.TrimSpace(req.Query)
+
+	if req.CWEID == 0 && req.Query == "" {
+		return nil, fmt.Errorf("either cwe_id or query is required")
+	}
+	if req.CWEID != 0 && req.Query != "" {
+		return nil, fmt.Errorf("provide either cwe_id or query, not both")
+	}
+
+	return req, nil
+}
+
+func handleGetCWEInfo(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseCWEInfoRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// The API is preferred, but the embedded catalog answers when it can't be reached
+	client, err := api.NewClient()
+	if err != nil {
+		log.Printf("CWE info: API client unavailable, using the offline catalog: %v", err)
+		client = nil
+	}
+
+	if req.Query != "" {
+		return formatCWESearchResponse(req.Query, searchCWEs(ctx, client, req.Query)), nil
+	}
+
+	info, err := getCWEInfo(ctx, client, req.CWEID)
+	if err != nil {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf("CWE Information\n===============\n\nCWE: CWE-%d\n\n❌ Error: %v\n", req.CWEID, err),
+			}},
+		}, nil
+	}
+
+	return formatCWEInfoResponse(info), nil
+}
+
+// getCWEInfo describes a CWE from the CWE API, falling back to the offline catalog.
+// The category and related CWEs always come from the catalog, as the CWE API doesn't map CWEs to categories.
+func getCWEInfo(ctx context.Context, client api.Client, cweID int32) (*MCPCWEInfo, error) {
+	entry, inCatalog := lookupCWE(cweID)
+
+	var info *MCPCWEInfo
+	if client != nil {
+		detail, err := client.GetCWE(ctx, cweID)
+		if err != nil {
+			log.Printf("CWE info: CWE API lookup failed for CWE-%d, using the offline catalog: %v", cweID, err)
+		} else {
+			info = cweInfoFromAPI(detail)
+		}
+	}
+
+	if info == nil {
+		if !inCatalog {
+			return nil, fmt.Errorf("CWE-%d is not in the offline catalog and the Veracode CWE API is unavailable", cweID)
+		}
+		info = &MCPCWEInfo{
+			ID:             entry.ID,
+			Name:           entry.Name,
+			Description:    entry.Description,
+			Recommendation: entry.Recommendation,
+			Source:         cweSourceCatalog,
+		}
+	}
+
+	if inCatalog && entry.Category != "" {
+		info.Category = &MCPCWECategory{Name: entry.Category}
+		if client != nil && info.Source == cweSourceAPI {
+			addCategoryDetails(ctx, client, info.Category)
+		}
+		for _, relatedID := range relatedCWEs(cweID) {
+			info.RelatedCWEs = append(info.RelatedCWEs, MCPRelatedCWE{ID: relatedID, Name: CWEName(relatedID)})
+		}
+	}
+
+	return info, nil
+}
+
+// cweInfoFromAPI converts a CWE API response into an MCPCWEInfo
+func cweInfoFromAPI(detail *findings.CweDetail) *MCPCWEInfo {
+	info := &MCPCWEInfo{
+		ID:                detail.GetId(),
+		Name:              detail.GetName(),
+		Description:       detail.GetDescription(),
+		RemediationEffort: detail.GetRemediationEffort(),
+		Recommendation:    detail.GetRecommendation(),
+		Severity:          detail.Severity,
+		Source:            cweSourceAPI,
+	}
+	for _, ref := range detail.References {
+		if ref.Url != nil {
+			info.References = append(info.References, Reference{Name: ref.GetName(), URL: ref.GetUrl()})
+		}
+	}
+	return info
+}
+
+// addCategoryDetails fills in a category's ID, description and recommendation from the finding categories API
+func addCategoryDetails(ctx context.Context, client api.Client, category *MCPCWECategory) {
+	categories, err := client.ListFindingCategories(ctx)
+	if err != nil {
+		log.Printf("CWE info: failed to list finding categories: %v", err)
+		return
+	}
+
+	for _, apiCategory := range categories {
+		if strings.EqualFold(apiCategory.GetName(), category.Name) {
+			category.ID = apiCategory.GetId()
+			category.Description = apiCategory.GetDescription()
+			category.Recommendation = apiCategory.GetRecommendation()
+			return
+		}
+	}
+}
+
+// searchCWEs finds CWEs whose name (or catalog category) contains query, from the CWE API if
+// available and otherwise from the offline catalog
+func searchCWEs(ctx context.Context, client api.Client, query string) []MCPRelatedCWE {
+	lowerQuery := strings.ToLower(query)
+
+	if client != nil {
+		cwes, err := client.ListCWEs(ctx)
+		if err == nil {
+			var matches []MCPRelatedCWE
+			for _, cwe := range cwes {
+				entry, _ := lookupCWE(cwe.GetId())
+				if strings.Contains(strings.ToLower(cwe.GetName()), lowerQuery) || strings.Contains(strings.ToLower(entry.Category), lowerQuery) {
+					matches = append(matches, MCPRelatedCWE{ID: cwe.GetId(), Name: cwe.GetName(), Category: entry.Category})
+				}
+			}
+			return matches
+		}
+		log.Printf("CWE info: CWE API search failed, using the offline catalog: %v", err)
+	}
+
+	var matches []MCPRelatedCWE
+	for _, entry := range searchCWECatalog(query) {
+		matches = append(matches, MCPRelatedCWE{ID: entry.ID, Name: entry.Name, Category: entry.Category})
+	}
+	return matches
+}
+
+// formatCWEInfoResponse formats a single CWE into an MCP tool response
+func formatCWEInfoResponse(info *MCPCWEInfo) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format CWE information: %v", err)}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": string(responseJSON),
+		}},
+	}
+}
+
+// formatCWESearchResponse formats CWE search matches into an MCP tool response
+func formatCWESearchResponse(query string, matches []MCPRelatedCWE) map[string]interface{} {
+	total := len(matches)
+	if len(matches) > maxCWESearchResults {
+		matches = matches[:maxCWESearchResults]
+	}
+
+	response := map[string]interface{}{
+		"query":       query,
+		"total_found": total,
+		"cwes":        matches,
+	}
+	if total > len(matches) {
+		response["truncated"] = true
+	}
+
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format CWE search results: %v", err)}
+	}
+
+	summary := fmt.Sprintf("Found %d CWEs matching %q. Call cwe-info with a cwe_id for full details.\n\n", total, query)
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + string(responseJSON),
+		}},
+	}
+}
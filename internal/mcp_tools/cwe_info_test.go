@@ -0,0 +1,123 @@
+package mcp_tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	findings "github.com/dipsylala/veracode-mcp/api/rest/generated/findings"
+)
+
+// ============================================================================
+// parseCWEInfoRequest tests
+// ============================================================================
+
+func TestParseCWEInfoRequest_CWEIDFormats(t *testing.T) {
+	for _, value := range []interface{}{float64(89), "89", "CWE-89", "cwe-89"} {
+		req, err := parseCWEInfoRequest(map[string]interface{}{"cwe_id": value})
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", value, err)
+		}
+		if req.CWEID != 89 {
+			t.Errorf("Expected CWE 89 for %v, got %d", value, req.CWEID)
+		}
+	}
+}
+
+func TestParseCWEInfoRequest_Validation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"neither":     {},
+		"both":        {"cwe_id": float64(89), "query": "sql"},
+		"non-numeric": {"cwe_id": "CWE-abc"},
+		"negative":    {"cwe_id": float64(-1)},
+		"blank query": {"query": "   "},
+	}
+
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseCWEInfoRequest(args); err == nil {
+				t.Errorf("Expected error for %s", name)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// getCWEInfo / searchCWEs tests (offline catalog)
+// ============================================================================
+
+func TestGetCWEInfo_OfflineCatalog(t *testing.T) {
+	info, err := getCWEInfo(context.Background(), nil, 89)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if info.Source != cweSourceCatalog {
+		t.Errorf("Expected the offline catalog as the source, got %s", info.Source)
+	}
+	if info.Category == nil || info.Category.Name != "SQL Injection" {
+		t.Errorf("Expected the SQL Injection category, got %+v", info.Category)
+	}
+	if info.Description == "" || info.Recommendation == "" {
+		t.Errorf("Expected a description and recommendation, got %+v", info)
+	}
+
+	foundHibernate := false
+	for _, related := range info.RelatedCWEs {
+		if related.ID == 564 && related.Name != "" {
+			foundHibernate = true
+		}
+	}
+	if !foundHibernate {
+		t.Errorf("Expected CWE-564 among the related CWEs, got %v", info.RelatedCWEs)
+	}
+}
+
+func TestGetCWEInfo_UnknownOffline(t *testing.T) {
+	if _, err := getCWEInfo(context.Background(), nil, 99999); err == nil {
+		t.Error("Expected error for a CWE missing from the catalog with no API")
+	}
+}
+
+func TestSearchCWEs_OfflineCatalog(t *testing.T) {
+	matches := searchCWEs(context.Background(), nil, "Cross-Site Scripting")
+	if len(matches) < 3 {
+		t.Fatalf("Expected the XSS category members, got %v", matches)
+	}
+	for _, match := range matches {
+		if match.Category == "" {
+			t.Errorf("Expected every match to carry its category, got %+v", match)
+		}
+	}
+}
+
+func TestCWEInfoFromAPI(t *testing.T) {
+	id, effort, severity := int32(89), int32(2), int32(4)
+	name, url := "SQL Injection", "https://cwe.mitre.org/data/definitions/89.html"
+	detail := &findings.CweDetail{
+		Id:                &id,
+		Name:              &name,
+		RemediationEffort: &effort,
+		Severity:          &severity,
+		References:        []findings.CWEReference{{Name: &name, Url: &url}},
+	}
+
+	info := cweInfoFromAPI(detail)
+	if info.Source != cweSourceAPI || info.RemediationEffort != 2 || info.Severity == nil || *info.Severity != 4 {
+		t.Errorf("Unexpected info: %+v", info)
+	}
+	if len(info.References) != 1 || info.References[0].URL != url {
+		t.Errorf("Expected the MITRE reference, got %v", info.References)
+	}
+}
+
+func TestFormatCWESearchResponse_Truncated(t *testing.T) {
+	matches := make([]MCPRelatedCWE, maxCWESearchResults+5)
+	result := formatCWESearchResponse("injection", matches)
+
+	content := result["content"].([]map[string]interface{})
+	text := content[0]["text"].(string)
+	if !strings.Contains(text, `"truncated": true`) || !strings.Contains(text, "Found 30 CWEs") {
+		t.Errorf("Expected a truncated result of 30 matches, got %s", text)
+	}
+}
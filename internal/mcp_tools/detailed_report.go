@@ -0,0 +1,521 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const DetailedReportToolName = "detailed-report"
+
+// latestReportLookback is how many of the most recent builds are checked for published results
+const latestReportLookback = 5
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(DetailedReportToolName, handleDetailedReport)
+}
+
+// DetailedReportRequest represents the parsed parameters for detailed-report
+type DetailedReportRequest struct {
+	ApplicationPath string
+	AppProfile      string
+	Sandbox         string
+	BuildID         int64
+	Refresh         bool
+	ScanType        string
+	SeverityGte     *int32
+	CWEIDs          []string
+	File            string
+	ViolatesPolicy  bool
+	Size            int
+	Page            int
+}
+
+// MCPDetailedReport is a summary of a build's detailed report and the flaws matching the filters
+type MCPDetailedReport struct {
+	AppProfile             string               `json:"app_profile"`
+	Sandbox                *MCPSandbox          `json:"sandbox,omitempty"`
+	BuildID                int64                `json:"build_id"`
+	ScanName               string               `json:"scan_name"`
+	GenerationDate         string               `json:"generation_date,omitempty"`
+	PolicyName             string               `json:"policy_name,omitempty"`
+	PolicyComplianceStatus string               `json:"policy_compliance_status,omitempty"`
+	ReportFile             string               `json:"report_file"`
+	FromCache              bool                 `json:"from_cache"`
+	Summary                MCPReportSummary     `json:"summary"`
+	Flaws                  []MCPReportFlaw      `json:"flaws"`
+	Pagination             *MCPPagination       `json:"pagination"`
+	Components             []MCPReportComponent `json:"vulnerable_components,omitempty"`
+}
+
+// MCPReportSummary counts the report's flaws and components
+type MCPReportSummary struct {
+	TotalFlaws           int            `json:"total_flaws"`
+	NotMitigated         int            `json:"not_mitigated"`
+	StaticFlaws          int            `json:"static_flaws"`
+	DynamicFlaws         int            `json:"dynamic_flaws"`
+	ViolatingPolicy      int            `json:"violating_policy"`
+	BySeverity           map[string]int `json:"open_by_severity"`
+	New                  int            `json:"new"`
+	Reopened             int            `json:"reopened"`
+	Fixed                int            `json:"fixed"`
+	Modules              []string       `json:"modules,omitempty"`
+	ThirdPartyComponents int            `json:"third_party_components"`
+	VulnerableComponents int            `json:"vulnerable_components"`
+}
+
+// MCPReportFlaw is a static or dynamic flaw from the detailed report
+type MCPReportFlaw struct {
+	FlawID            int64           `json:"flaw_id"`
+	ScanType          string          `json:"scan_type"`
+	Severity          SeverityLevel   `json:"severity"`
+	CweID             int             `json:"cwe_id"`
+	CweName           string          `json:"cwe_name,omitempty"`
+	Category          string          `json:"category"`
+	Module            string          `json:"module,omitempty"`
+	File              string          `json:"file,omitempty"`
+	Line              int             `json:"line,omitempty"`
+	URL               string          `json:"url,omitempty"`
+	Parameter         string          `json:"parameter,omitempty"`
+	Exploitability    string          `json:"exploitability,omitempty"`
+	RemediationStatus string          `json:"remediation_status"`
+	MitigationStatus  string          `json:"mitigation_status"`
+	ViolatesPolicy    bool            `json:"violates_policy"`
+	FirstFound        string          `json:"first_found,omitempty"`
+	MitigationHistory []MCPMitigation `json:"mitigation_history,omitempty"`
+}
+
+// MCPReportComponent is a vulnerable third-party component from the detailed report
+type MCPReportComponent struct {
+	Library         string   `json:"library"`
+	Version         string   `json:"version,omitempty"`
+	FileName        string   `json:"file_name"`
+	MaxCVSSScore    float64  `json:"max_cvss_score"`
+	ViolatesPolicy  bool     `json:"violates_policy"`
+	Vulnerabilities []string `json:"vulnerabilities"`
+}
+
+// exploitabilityLevels names the report's exploitLevel values
+var exploitabilityLevels = map[string]string{
+	"-2": "Very Unlikely",
+	"-1": "Unlikely",
+	"0":  "Neutral",
+	"1":  "Likely",
+	"2":  "Very Likely",
+}
+
+// parseDetailedReportRequest extracts and validates parameters from the raw args map
+func parseDetailedReportRequest(args map[string]interface{}) (*DetailedReportRequest, error) {
+	req := &DetailedReportRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Sandbox, _ = extractOptionalString(args, "sandbox")
+	buildID := extractInt(args, "build_id", 0)
+	if buildID < 0 {
+		return nil, fmt.Errorf("build_id must be a positive number")
+	}
+	req.BuildID = int64(buildID)
+	req.Refresh = extractFlag(args, "refresh")
+
+	req.ScanType, err = extractOptionalEnum(args, "scan_type", []string{"STATIC", "DYNAMIC"})
+	if err != nil {
+		return nil, err
+	}
+	req.SeverityGte, _, err = extractOptionalInt32Ptr(args, "severity_gte")
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSeverity(req.SeverityGte, "severity_gte"); err != nil {
+		return nil, err
+	}
+	req.CWEIDs = extractCWEIDs(args)
+	req.File, _ = extractOptionalString(args, "file")
+
+	// Default violates_policy to true, like the platform findings tools
+	violatesPolicy, provided := extractOptionalBool(args, "violates_policy")
+	req.ViolatesPolicy = !provided || *violatesPolicy
+
+	req.Size = extractInt(args, "page_size", 50)
+	req.Page = extractInt(args, "page", 0)
+	if err := validatePaginationParams(req.Size, req.Page); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// detailedReportPath returns where the detailed report of a build is cached
+func detailedReportPath(applicationPath string, buildID int64) string {
+	return filepath.Join(veracodeWorkDir(applicationPath, "reports"), fmt.Sprintf("detailedreport-%d.xml", buildID))
+}
+
+// handleDetailedReport downloads (or reads from the cache) a build's detailed report and queries it locally
+func handleDetailedReport(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseDetailedReportRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+
+		// The workspace's default sandbox only applies to the workspace's own application
+		if req.Sandbox == "" {
+			req.Sandbox = wsConfig.Sandbox
+		}
+	}
+
+	// Step 2: A cached report of the requested build needs no API calls
+	if req.BuildID != 0 && !req.Refresh {
+		reportFile := detailedReportPath(req.ApplicationPath, req.BuildID)
+		if report, err := readCachedDetailedReport(reportFile); err == nil {
+			response := buildDetailedReportResponse(report, req)
+			response.AppProfile = appProfile
+			response.ReportFile = reportFile
+			response.FromCache = true
+			return formatDetailedReportResponse(response), nil
+		}
+	}
+
+	// Step 3: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return detailedReportError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 4: Look up the numeric application and sandbox IDs the XML API uses
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return detailedReportError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+	}
+
+	sandbox, sandboxID, err := resolvePlatformSandbox(ctx, client, application.GetGuid(), req.Sandbox)
+	if err != nil {
+		return detailedReportError(appProfile, fmt.Sprintf("Error: Failed to find sandbox %q\n\n%v", req.Sandbox, err)), nil
+	}
+
+	// Step 5: Pick the build
+	buildID := req.BuildID
+	if buildID == 0 {
+		buildID, err = latestPublishedBuild(ctx, client, int64(application.GetId()), sandboxID)
+		if err != nil {
+			return detailedReportError(appProfile, fmt.Sprintf("Error: %v", err)), nil
+		}
+	}
+
+	// Step 6: Read the report from the cache, downloading it if needed
+	reportFile := detailedReportPath(req.ApplicationPath, buildID)
+	report, fromCache, err := loadDetailedReport(ctx, client, reportFile, buildID, req.Refresh)
+	if err != nil {
+		return detailedReportError(appProfile, fmt.Sprintf("Error: Failed to get the detailed report of build %d\n\n%v", buildID, err)), nil
+	}
+	if !fromCache {
+		if err := touchWorkspaceManifest(req.ApplicationPath); err != nil {
+			log.Printf("Warning: Failed to update workspace manifest: %v", err)
+		}
+	}
+
+	response := buildDetailedReportResponse(report, req)
+	response.AppProfile = appProfile
+	response.Sandbox = sandbox
+	response.ReportFile = reportFile
+	response.FromCache = fromCache
+
+	return formatDetailedReportResponse(response), nil
+}
+
+// latestPublishedBuild returns the most recent build whose results are ready
+func latestPublishedBuild(ctx context.Context, client api.Client, appID, sandboxID int64) (int64, error) {
+	builds, err := client.GetBuildList(ctx, appID, sandboxID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list builds: %w", err)
+	}
+
+	sort.Slice(builds, func(i, j int) bool { return builds[i].BuildID > builds[j].BuildID })
+	for _, build := range builds[:min(len(builds), latestReportLookback)] {
+		info, err := client.GetBuildInfo(ctx, appID, sandboxID, build.BuildID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get build %d: %w", build.BuildID, err)
+		}
+		if info.ResultsReady {
+			return build.BuildID, nil
+		}
+	}
+	return 0, fmt.Errorf("none of the %d most recent builds has published results - pass build_id to choose an older build", latestReportLookback)
+}
+
+// loadDetailedReport reads a cached report, or downloads and caches it
+func loadDetailedReport(ctx context.Context, client api.Client, reportFile string, buildID int64, refresh bool) (*api.DetailedReport, bool, error) {
+	if !refresh {
+		if report, err := readCachedDetailedReport(reportFile); err == nil {
+			return report, true, nil
+		}
+	}
+
+	report, data, err := client.DownloadDetailedReport(ctx, buildID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(reportFile), 0750); err != nil {
+		return nil, false, fmt.Errorf("failed to create reports directory: %w", err)
+	}
+	if err := os.WriteFile(reportFile, data, 0600); err != nil {
+		return nil, false, fmt.Errorf("failed to cache report: %w", err)
+	}
+	return report, false, nil
+}
+
+// readCachedDetailedReport reads a report cached by loadDetailedReport
+func readCachedDetailedReport(reportFile string) (*api.DetailedReport, error) {
+	// #nosec G304 -- reportFile is constructed from the Veracode work directory and the build ID
+	data, err := os.ReadFile(reportFile)
+	if err != nil {
+		return nil, err
+	}
+	return api.ParseDetailedReport(data)
+}
+
+// buildDetailedReportResponse summarizes the report and applies the request's filters and paging to its flaws
+func buildDetailedReportResponse(report *api.DetailedReport, req *DetailedReportRequest) *MCPDetailedReport {
+	response := &MCPDetailedReport{
+		BuildID:                report.BuildID,
+		ScanName:               report.Version,
+		GenerationDate:         report.GenerationDate,
+		PolicyName:             report.PolicyName,
+		PolicyComplianceStatus: report.PolicyComplianceStatus,
+		Summary:                summarizeDetailedReport(report),
+	}
+
+	var matched []MCPReportFlaw
+	if req.ScanType != "DYNAMIC" {
+		for _, flaw := range report.StaticFlaws() {
+			if reportFlawMatches(flaw, req) {
+				matched = append(matched, transformReportFlaw(flaw, "STATIC"))
+			}
+		}
+	}
+	if req.ScanType != "STATIC" {
+		for _, flaw := range report.DynamicFlaws() {
+			if reportFlawMatches(flaw, req) {
+				matched = append(matched, transformReportFlaw(flaw, "DYNAMIC"))
+			}
+		}
+	}
+
+	start := min(req.Page*req.Size, len(matched))
+	end := min(start+req.Size, len(matched))
+	response.Flaws = matched[start:end]
+	response.Pagination = &MCPPagination{
+		CurrentPage:   req.Page,
+		PageSize:      req.Size,
+		TotalElements: len(matched),
+		TotalPages:    (len(matched) + req.Size - 1) / req.Size,
+		HasNext:       end < len(matched),
+		HasPrevious:   req.Page > 0,
+	}
+
+	if report.SCA != nil {
+		for _, component := range report.SCA.Components {
+			if req.ViolatesPolicy && !component.AffectsPolicyCompliance {
+				continue
+			}
+			response.Components = append(response.Components, transformReportComponent(component))
+		}
+	}
+
+	return response
+}
+
+// summarizeDetailedReport counts the report's flaws by type, severity and remediation status
+func summarizeDetailedReport(report *api.DetailedReport) MCPReportSummary {
+	summary := MCPReportSummary{
+		TotalFlaws:   report.TotalFlaws,
+		NotMitigated: report.FlawsNotMitigated,
+		BySeverity:   make(map[string]int),
+	}
+	if report.FlawStatus != nil {
+		summary.New = report.FlawStatus.New
+		summary.Reopened = report.FlawStatus.Reopen
+		summary.Fixed = report.FlawStatus.Fixed
+	}
+	if report.StaticAnalysis != nil {
+		for _, module := range report.StaticAnalysis.Modules {
+			summary.Modules = append(summary.Modules, module.Name)
+		}
+	}
+	if report.SCA != nil {
+		summary.ThirdPartyComponents = report.SCA.ThirdPartyComponents
+		summary.VulnerableComponents = len(report.SCA.Components)
+	}
+
+	count := func(flaws []api.ReportFlaw) {
+		for _, flaw := range flaws {
+			if flaw.AffectsPolicyCompliance {
+				summary.ViolatingPolicy++
+			}
+			if !strings.EqualFold(flaw.RemediationStatus, "Fixed") {
+				severity := int32(flaw.Severity)
+				summary.BySeverity[string(TransformSeverity(&severity))]++
+			}
+		}
+	}
+	static, dynamic := report.StaticFlaws(), report.DynamicFlaws()
+	summary.StaticFlaws = len(static)
+	summary.DynamicFlaws = len(dynamic)
+	count(static)
+	count(dynamic)
+
+	return summary
+}
+
+// reportFlawMatches reports whether a flaw passes the request's filters
+func reportFlawMatches(flaw api.ReportFlaw, req *DetailedReportRequest) bool {
+	if req.ViolatesPolicy && !flaw.AffectsPolicyCompliance {
+		return false
+	}
+	if req.SeverityGte != nil && int32(flaw.Severity) < *req.SeverityGte {
+		return false
+	}
+	if len(req.CWEIDs) > 0 {
+		cweID := strconv.Itoa(flaw.CWEID)
+		found := false
+		for _, id := range req.CWEIDs {
+			if strings.TrimPrefix(strings.ToUpper(id), "CWE-") == cweID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if req.File != "" && !strings.Contains(strings.ToLower(flaw.SourceFilePath+flaw.SourceFile), strings.ToLower(req.File)) {
+		return false
+	}
+	return true
+}
+
+// transformReportFlaw converts a detailed report flaw to MCP format
+func transformReportFlaw(flaw api.ReportFlaw, scanType string) MCPReportFlaw {
+	severity := int32(flaw.Severity)
+	result := MCPReportFlaw{
+		FlawID:            flaw.IssueID,
+		ScanType:          scanType,
+		Severity:          TransformSeverity(&severity),
+		CweID:             flaw.CWEID,
+		CweName:           CWEName(int32(flaw.CWEID)),
+		Category:          flaw.CategoryName,
+		Module:            flaw.Module,
+		Line:              flaw.Line,
+		URL:               flaw.URL,
+		Parameter:         flaw.VulnParameter,
+		Exploitability:    exploitabilityLevels[flaw.ExploitLevel],
+		RemediationStatus: flaw.RemediationStatus,
+		MitigationStatus:  strings.ToUpper(flaw.MitigationStatus),
+		ViolatesPolicy:    flaw.AffectsPolicyCompliance,
+		FirstFound:        flaw.DateFirstOccurrence,
+	}
+	if flaw.SourceFile != "" {
+		result.File = flaw.SourceFilePath + flaw.SourceFile
+	}
+	for _, mitigation := range flaw.Mitigations {
+		result.MitigationHistory = append(result.MitigationHistory, MCPMitigation{
+			Action:    mitigation.Action,
+			Comment:   mitigation.Description,
+			Submitter: mitigation.User,
+			Date:      mitigation.Date,
+		})
+	}
+	return result
+}
+
+// transformReportComponent converts a detailed report component to MCP format
+func transformReportComponent(component api.ReportComponent) MCPReportComponent {
+	result := MCPReportComponent{
+		Library:         component.Library,
+		Version:         component.Version,
+		FileName:        component.FileName,
+		MaxCVSSScore:    component.MaxCVSSScore,
+		ViolatesPolicy:  component.AffectsPolicyCompliance,
+		Vulnerabilities: make([]string, 0, len(component.Vulnerabilities)),
+	}
+	for _, vulnerability := range component.Vulnerabilities {
+		result.Vulnerabilities = append(result.Vulnerabilities, vulnerability.CVEID)
+	}
+	return result
+}
+
+// detailedReportError formats an error as an MCP tool response
+func detailedReportError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Detailed Report - Error\n=======================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// formatDetailedReportResponse formats the report summary and flaws into an MCP tool response
+func formatDetailedReportResponse(response *MCPDetailedReport) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format detailed report: %v", err)}
+	}
+
+	source := "downloaded and cached"
+	if response.FromCache {
+		source = "read from the cache (pass refresh to download it again)"
+	}
+	summary := fmt.Sprintf(`Detailed report of build %d (%s) of %s, %s: %d flaw(s), %d not mitigated, %d violating policy.
+Showing %d of %d matching flaw(s). Call detailed-report again with other filters to query the cached report without further API calls.`,
+		response.BuildID, response.ScanName, response.AppProfile, source,
+		response.Summary.TotalFlaws, response.Summary.NotMitigated, response.Summary.ViolatingPolicy,
+		len(response.Flaws), response.Pagination.TotalElements)
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
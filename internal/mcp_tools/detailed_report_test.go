@@ -0,0 +1,203 @@
+package mcp_tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+const testDetailedReport = `<detailedreport xmlns="https://www.veracode.com/schema/reports/export/1.0" app_name="WebGoat" app_id="100" build_id="300" version="release 1.2" policy_compliance_status="Did Not Pass" total_flaws="3" flaws_not_mitigated="2">
+  <static-analysis score="72"><modules><module name="webgoat.war" score="72"/></modules></static-analysis>
+  <severity level="4">
+    <category categoryid="19" categoryname="SQL Injection">
+      <cwe cweid="89" cwename="SQL Injection">
+        <staticflaws>
+          <flaw issueid="12" severity="4" categoryname="SQL Injection" cweid="89" module="webgoat.war" exploitLevel="1" remediation_status="Open" mitigation_status="proposed" affects_policy_compliance="true" sourcefile="UserDao.java" sourcefilepath="org/owasp/webgoat/" line="57">
+            <mitigations><mitigation action="Mitigate by Design" description="Allowlisted enum" user="dev@example.com" date="2026-09-01 10:00:00"/></mitigations>
+          </flaw>
+        </staticflaws>
+        <dynamicflaws>
+          <flaw issueid="40" severity="4" categoryname="SQL Injection" cweid="89" url="https://app.example.com/login" vuln_parameter="username" remediation_status="New" mitigation_status="none" affects_policy_compliance="true"/>
+        </dynamicflaws>
+      </cwe>
+    </category>
+  </severity>
+  <severity level="3">
+    <category categoryid="20" categoryname="Cross-Site Scripting">
+      <cwe cweid="80" cwename="Basic XSS">
+        <staticflaws>
+          <flaw issueid="13" severity="3" categoryname="Cross-Site Scripting" cweid="80" remediation_status="Fixed" mitigation_status="none" affects_policy_compliance="false" sourcefile="view.jsp" line="9"/>
+        </staticflaws>
+      </cwe>
+    </category>
+  </severity>
+  <flaw-status new="1" reopen="0" open="1" fixed="1" total="3" not_mitigated="2"/>
+  <software_composition_analysis third_party_components="20" violate_policy="true" components_violated_policy="1">
+    <vulnerable_components>
+      <component component_id="abc" file_name="log4j-core-2.14.1.jar" library="log4j-core" version="2.14.1" max_cvss_score="10.0" component_affects_policy_compliance="true">
+        <vulnerabilities><vulnerability cve_id="CVE-2021-44228" cvss_score="10.0" severity="5"/></vulnerabilities>
+      </component>
+    </vulnerable_components>
+  </software_composition_analysis>
+</detailedreport>`
+
+// reportClient answers the build and detailed report calls; every other method is unimplemented
+type reportClient struct {
+	api.Client
+	builds    []api.BuildSummary
+	ready     map[int64]bool
+	downloads int
+}
+
+func (c *reportClient) GetBuildList(_ context.Context, _, _ int64) ([]api.BuildSummary, error) {
+	return c.builds, nil
+}
+
+func (c *reportClient) GetBuildInfo(_ context.Context, _, _, buildID int64) (*api.BuildInfo, error) {
+	return &api.BuildInfo{BuildID: buildID, ResultsReady: c.ready[buildID]}, nil
+}
+
+func (c *reportClient) DownloadDetailedReport(_ context.Context, _ int64) (*api.DetailedReport, []byte, error) {
+	c.downloads++
+	report, err := api.ParseDetailedReport([]byte(testDetailedReport))
+	return report, []byte(testDetailedReport), err
+}
+
+func TestParseDetailedReportRequest(t *testing.T) {
+	req, err := parseDetailedReportRequest(map[string]interface{}{
+		"application_path": "/work/app",
+		"build_id":         float64(300),
+		"scan_type":        "static",
+		"severity_gte":     float64(4),
+		"cwe_ids":          []interface{}{float64(89)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.BuildID != 300 || req.ScanType != "STATIC" || *req.SeverityGte != 4 || !req.ViolatesPolicy || req.Size != 50 {
+		t.Errorf("unexpected request: %+v", req)
+	}
+
+	if _, err := parseDetailedReportRequest(map[string]interface{}{"application_path": "/work/app", "scan_type": "SCA"}); err == nil {
+		t.Error("expected error for unsupported scan_type")
+	}
+	if _, err := parseDetailedReportRequest(map[string]interface{}{"application_path": "/work/app", "severity_gte": float64(6)}); err == nil {
+		t.Error("expected error for out-of-range severity_gte")
+	}
+}
+
+func TestLatestPublishedBuild(t *testing.T) {
+	client := &reportClient{
+		builds: []api.BuildSummary{{BuildID: 298}, {BuildID: 299}, {BuildID: 300}},
+		ready:  map[int64]bool{298: true, 299: true},
+	}
+
+	buildID, err := latestPublishedBuild(context.Background(), client, 100, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buildID != 299 {
+		t.Errorf("latestPublishedBuild() = %d, want 299 (300 is still scanning)", buildID)
+	}
+
+	client.ready = nil
+	if _, err := latestPublishedBuild(context.Background(), client, 100, 0); err == nil {
+		t.Error("expected error when no build has results")
+	}
+}
+
+func TestLoadDetailedReport_Cache(t *testing.T) {
+	reportFile := filepath.Join(t.TempDir(), "reports", "detailedreport-300.xml")
+	client := &reportClient{}
+	ctx := context.Background()
+
+	report, fromCache, err := loadDetailedReport(ctx, client, reportFile, 300, false)
+	if err != nil || fromCache || report.BuildID != 300 {
+		t.Fatalf("first load: report %v, fromCache %v, err %v", report, fromCache, err)
+	}
+	if _, err := os.Stat(reportFile); err != nil {
+		t.Fatalf("report was not cached: %v", err)
+	}
+
+	if _, fromCache, err = loadDetailedReport(ctx, client, reportFile, 300, false); err != nil || !fromCache {
+		t.Errorf("second load should come from the cache: fromCache %v, err %v", fromCache, err)
+	}
+	if _, fromCache, err = loadDetailedReport(ctx, client, reportFile, 300, true); err != nil || fromCache {
+		t.Errorf("refresh should download again: fromCache %v, err %v", fromCache, err)
+	}
+	if client.downloads != 2 {
+		t.Errorf("downloads = %d, want 2", client.downloads)
+	}
+}
+
+func TestDetailedReportTool_CachedBuildNeedsNoAPI(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	t.Setenv("VERACODE_API_ID", "")
+	t.Setenv("VERACODE_API_KEY", "")
+	t.Setenv("HOME", t.TempDir()) // No credentials file either, so any API call would fail
+	appPath := t.TempDir()
+
+	reportFile := detailedReportPath(appPath, 300)
+	if err := os.MkdirAll(filepath.Dir(reportFile), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(reportFile, []byte(testDetailedReport), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := handleDetailedReport(context.Background(), map[string]interface{}{
+		"application_path": appPath,
+		"app_profile":      "WebGoat",
+		"build_id":         float64(300),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	text := jobToolText(t, result)
+	if !strings.Contains(text, `"from_cache": true`) {
+		t.Errorf("expected the cached report without any API call, got:\n%s", text)
+	}
+}
+
+func TestBuildDetailedReportResponse(t *testing.T) {
+	report, err := api.ParseDetailedReport([]byte(testDetailedReport))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := buildDetailedReportResponse(report, &DetailedReportRequest{ViolatesPolicy: true, Size: 50})
+	if response.Summary.StaticFlaws != 2 || response.Summary.DynamicFlaws != 1 || response.Summary.ViolatingPolicy != 2 {
+		t.Errorf("unexpected summary: %+v", response.Summary)
+	}
+	if response.Summary.BySeverity["HIGH"] != 2 || response.Summary.BySeverity["MEDIUM"] != 0 {
+		t.Errorf("open_by_severity = %v, want 2 HIGH and the fixed MEDIUM excluded", response.Summary.BySeverity)
+	}
+	if len(response.Flaws) != 2 || len(response.Components) != 1 {
+		t.Fatalf("expected 2 policy-violating flaws and 1 component, got %d and %d", len(response.Flaws), len(response.Components))
+	}
+
+	flaw := response.Flaws[0]
+	if flaw.FlawID != 12 || flaw.File != "org/owasp/webgoat/UserDao.java" || flaw.Exploitability != "Likely" || flaw.MitigationStatus != "PROPOSED" {
+		t.Errorf("unexpected static flaw: %+v", flaw)
+	}
+	if len(flaw.MitigationHistory) != 1 || flaw.MitigationHistory[0].Comment != "Allowlisted enum" {
+		t.Errorf("unexpected mitigation history: %+v", flaw.MitigationHistory)
+	}
+	if response.Flaws[1].ScanType != "DYNAMIC" || response.Flaws[1].Parameter != "username" {
+		t.Errorf("unexpected dynamic flaw: %+v", response.Flaws[1])
+	}
+
+	response = buildDetailedReportResponse(report, &DetailedReportRequest{ScanType: "STATIC", File: "view.jsp", Size: 50})
+	if len(response.Flaws) != 1 || response.Flaws[0].FlawID != 13 {
+		t.Errorf("file filter: got %+v", response.Flaws)
+	}
+
+	response = buildDetailedReportResponse(report, &DetailedReportRequest{CWEIDs: []string{"CWE-89"}, Size: 1, Page: 1})
+	if len(response.Flaws) != 1 || response.Flaws[0].FlawID != 40 || response.Pagination.TotalElements != 2 || response.Pagination.HasNext {
+		t.Errorf("cwe filter and paging: got %+v, %+v", response.Flaws, response.Pagination)
+	}
+}
@@ -2,11 +2,9 @@ package mcp_tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"log"
-	"sort"
 	"strings"
+	"time"
 
 	"github.com/dipsylala/veracode-mcp/api"
 	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
@@ -27,10 +25,18 @@ type DynamicFindingsRequest struct {
 	Sandbox         string   `json:"sandbox,omitempty"`
 	Size            int      `json:"size,omitempty"`
 	Page            int      `json:"page,omitempty"`
+	AllPages        bool     `json:"all_pages,omitempty"`
+	MaxResults      int      `json:"max_results,omitempty"`
 	Severity        *int32   `json:"severity,omitempty"`
 	SeverityGte     *int32   `json:"severity_gte,omitempty"`
 	CWEIDs          []string `json:"cwe_ids,omitempty"`
 	ViolatesPolicy  *bool    `json:"violates_policy,omitempty"`
+
+	FindingCategory    []int32    `json:"finding_category,omitempty"`
+	MitigatedAfter     *time.Time `json:"mitigated_after,omitempty"`
+	New                bool       `json:"new,omitempty"`
+	IncludeAnnotations bool       `json:"include_annotations,omitempty"`
+	IncludeExpiryDate  bool       `json:"include_expiry_date,omitempty"`
 }
 
 // parseDynamicFindingsRequest extracts and validates parameters from the raw args map
@@ -50,6 +56,12 @@ func parseDynamicFindingsRequest(args map[string]interface{}) (*DynamicFindingsR
 	req.Size = extractInt(args, "page_size", 10)
 	req.Page = extractInt(args, "page", 0)
 
+	// Extract all-pages options
+	req.AllPages, req.MaxResults, err = extractAllPagesParams(args)
+	if err != nil {
+		return nil, err
+	}
+
 	// Extract optional int32 pointers with validation
 	req.Severity, _, err = extractOptionalInt32Ptr(args, "severity")
 	if err != nil {
@@ -69,6 +81,19 @@ func parseDynamicFindingsRequest(args map[string]interface{}) (*DynamicFindingsR
 	}
 	req.ViolatesPolicy = violatesPolicy
 
+	// Extract the remaining Findings API filters
+	req.FindingCategory, err = extractInt32List(args, "finding_category")
+	if err != nil {
+		return nil, err
+	}
+	req.MitigatedAfter, err = extractOptionalDate(args, "mitigated_after")
+	if err != nil {
+		return nil, err
+	}
+	req.New = extractFlag(args, "new")
+	req.IncludeAnnotations = extractFlag(args, "include_annotations")
+	req.IncludeExpiryDate = extractFlag(args, "include_expiry_date")
+
 	// Extract CWE IDs
 	req.CWEIDs = extractCWEIDs(args)
 
@@ -102,16 +127,23 @@ func handleGetDynamicFindings(ctx context.Context, args map[string]interface{})
 	hasAppProfile := appProfile != ""
 	if !hasAppProfile {
 		// Fall back to workspace config if app_profile not provided
-		appProfile, err = workspace.FindWorkspaceConfig(req.ApplicationPath)
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
 		if err != nil {
 			return map[string]interface{}{
 				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
 			}, nil
 		}
+		appProfile = wsConfig.Application()
+
+		// The workspace's default sandbox only applies to the workspace's own application
+		if req.Sandbox == "" {
+			req.Sandbox = wsConfig.Sandbox
+		}
 	}
 
 	// Step 2: Create API client
-	client, err := api.NewClient()
+	client, err := newWorkspaceClient(req.ApplicationPath)
 	if err != nil {
 		responseText := fmt.Sprintf(`Dynamic Findings Analysis - Error
 ========================
@@ -139,8 +171,7 @@ Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set
 	// Step 3: Get application GUID using the profile name (or use directly if it's a GUID)
 	var applicationGUID string
 
-	// Check if appProfile is already a GUID (format: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
-	if len(appProfile) == 36 && appProfile[8] == '-' && appProfile[13] == '-' && appProfile[18] == '-' && appProfile[23] == '-' {
+	if isApplicationGUID(appProfile) {
 		// It's a GUID, use it directly
 		applicationGUID = appProfile
 	} else {
@@ -188,20 +219,56 @@ Please verify:
 		}
 	}
 
-	// Step 4: Build the findings request
+	// Step 4: Resolve the sandbox name to its GUID (no sandbox means the policy scan)
+	sandbox, err := resolveSandbox(ctx, client, applicationGUID, req.Sandbox)
+	if err != nil {
+		responseText := fmt.Sprintf(`Dynamic Findings Analysis - Error
+========================
+
+Application Path: %s
+App Profile: %s
+Application GUID: %s
+Sandbox: %s
+Error: Failed to find sandbox
+
+%v
+
+Please verify the sandbox name matches a sandbox of this application.`,
+			req.ApplicationPath,
+			appProfile,
+			applicationGUID,
+			req.Sandbox,
+			err,
+		)
+
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": responseText,
+			}},
+		}, nil
+	}
+
+	// Step 5: Build the findings request
 	findingsReq := api.FindingsRequest{
 		AppProfile:     applicationGUID,
-		Sandbox:        req.Sandbox,
+		Sandbox:        sandboxGUID(sandbox),
 		Size:           req.Size,
 		Page:           req.Page,
 		Severity:       req.Severity,
 		SeverityGte:    req.SeverityGte,
 		CWEIDs:         req.CWEIDs,
 		ViolatesPolicy: req.ViolatesPolicy,
+
+		FindingCategory: req.FindingCategory,
+		MitigatedAfter:  req.MitigatedAfter,
+		New:             req.New,
+		IncludeAnnot:    req.IncludeAnnotations,
+		IncludeExpDate:  req.IncludeExpiryDate,
 	}
 
-	// Step 5: Call the API to get dynamic findings
-	findingsResp, err := client.GetDynamicFindings(ctx, findingsReq)
+	// Step 6: Call the API to get dynamic findings
+	findings, err := collectFindings(ctx, client, findingsReq, req.AllPages, req.MaxResults, dynamicFindingsFormat)
 	if err != nil {
 		responseText := fmt.Sprintf(`Dynamic Findings Analysis - Error
 ========================
@@ -231,118 +298,34 @@ Please verify:
 		}, nil
 	}
 
-	// Step 6: Format and return the response
+	// Step 7: Format and return the response
 	policyFilter := req.ViolatesPolicy != nil && *req.ViolatesPolicy
-	return formatDynamicFindingsResponse(ctx, appProfile, applicationGUID, req.Sandbox, findingsResp, policyFilter), nil
+	return formatDynamicFindingsResponse(ctx, appProfile, applicationGUID, sandbox, findings, policyFilter), nil
 }
 
-// formatDynamicFindingsResponse formats the findings API response into an MCP tool response
-func formatDynamicFindingsResponse(ctx context.Context, appProfile, applicationGUID, sandbox string, findings *api.FindingsResponse, policyFilter bool) map[string]interface{} {
-	// Build MCP response structure
-	response := MCPFindingsResponse{
-		Application: MCPApplication{
-			Name: appProfile,
-			ID:   applicationGUID,
-		},
-		PolicyFilter: policyFilter,
-		Summary: MCPFindingsSummary{
-			BySeverity: map[string]int{
-				"very high": 0,
-				"high":      0,
-				"medium":    0,
-				"low":       0,
-				"very low":  0,
-				"info":      0,
-			},
-			ByStatus: map[string]int{
-				"open":   0,
-				"closed": 0,
-			},
-			ByMitigation: map[string]int{
-				"none":     0,
-				"proposed": 0,
-				"approved": 0,
-				"rejected": 0,
-			},
-		},
-		Findings: []MCPFinding{},
-	}
-
-	// Add sandbox if specified
-	if sandbox != "" {
-		response.Sandbox = &MCPSandbox{
-			Name: sandbox,
-			ID:   sandbox,
-		}
-	}
-
-	// Add pagination info
-	if findings != nil {
-		response.Pagination = &MCPPagination{
-			CurrentPage:   findings.Page,
-			PageSize:      findings.Size,
-			TotalElements: findings.TotalCount,
-			TotalPages:    (findings.TotalCount + findings.Size - 1) / findings.Size,
-			HasNext:       (findings.Page+1)*findings.Size < findings.TotalCount,
-			HasPrevious:   findings.Page > 0,
-		}
-		// Set total_findings to the total across all pages
-		response.Summary.TotalFindings = findings.TotalCount
-	}
-
-	// Process each finding
-	if findings != nil && len(findings.Findings) > 0 {
-		for _, finding := range findings.Findings {
-			mcpFinding := processDynamicFinding(finding)
-			response.Findings = append(response.Findings, mcpFinding)
-			updateSummaryCounters(&response.Summary, mcpFinding)
-		}
-	}
-
-	// Sort findings by severity (Very High first)
-	sort.Slice(response.Findings, func(i, j int) bool {
-		return response.Findings[i].SeverityScore > response.Findings[j].SeverityScore
-	})
-
-	// Marshal response to JSON for non-UI clients
-	responseJSON, err := json.MarshalIndent(response, "", "  ")
-	if err != nil {
-		log.Printf("Warning: Failed to marshal response to JSON: %v", err)
-		responseJSON, _ = json.Marshal(response) // Fall back to compact JSON
-	}
-
-	// Build pagination summary for LLM
-	var paginationSummary string
-	if response.Pagination != nil {
-		paginationSummary = fmt.Sprintf("Showing %d findings on page %d of %d (Total: %d findings across all pages)\n\n",
-			len(response.Findings),
-			response.Pagination.CurrentPage+1, // Display as 1-based
-			response.Pagination.TotalPages,
-			response.Pagination.TotalElements,
-		)
-	} else {
-		paginationSummary = fmt.Sprintf("Showing %d findings\n\n", len(response.Findings))
-	}
+// dynamicFindingsFormat converts and counts dynamic findings, listing the most severe first
+var dynamicFindingsFormat = findingsFormat{
+	Label:             "Dynamic findings",
+	ScanType:          "DYNAMIC",
+	Convert:           processDynamicFinding,
+	Count:             updateSummaryCounters,
+	SortBySeverity:    true,
+	StructuredContent: true,
+}
 
-	// Build result with content
-	result := map[string]interface{}{
-		"content": []map[string]interface{}{
-			{
-				"type": "text",
-				"text": paginationSummary + string(responseJSON),
-			},
-		},
+// formatDynamicFindingsResponse formats the collected findings into an MCP tool response
+func formatDynamicFindingsResponse(ctx context.Context, appProfile, applicationGUID string, sandbox *MCPSandbox, findings *collectedFindings, policyFilter bool) map[string]interface{} {
+	response := newFindingsResponse(findings, dynamicFindingsFormat)
+	response.Application = MCPApplication{
+		Name: appProfile,
+		ID:   applicationGUID,
 	}
+	response.PolicyFilter = policyFilter
 
-	// Only include structuredContent if client supports MCP Apps UI
-	if ClientSupportsUIFromContext(ctx) {
-		log.Printf("Dynamic findings: Returning %d findings (content: JSON, structuredContent: full data for UI)", len(response.Findings))
-		result["structuredContent"] = response
-	} else {
-		log.Printf("Dynamic findings: Returning %d findings (content: JSON only, no structuredContent - client doesn't support UI)", len(response.Findings))
-	}
+	// Add sandbox if the findings are from one rather than the policy scan
+	response.Sandbox = sandbox
 
-	return result
+	return formatFindingsResponse(ctx, response, findings.Response != nil && findings.Response.AllPages, dynamicFindingsFormat)
 }
 
 // processDynamicFinding transforms a single API finding into an MCP finding
@@ -392,19 +375,23 @@ func processDynamicFinding(finding api.Finding) MCPFinding {
 	}
 
 	return MCPFinding{
-		FlawID:           finding.ID,
-		BuildID:          finding.BuildID,
-		ScanType:         "DAST",
-		Status:           string(transformedStatus),
-		MitigationStatus: mitigationStatus,
-		ViolatesPolicy:   violatesPolicy,
-		Severity:         string(transformedSeverity),
-		SeverityScore:    severityNum,
-		CweId:            cweID,
-		Description:      cleanedDesc,
-		References:       references,
-		URL:              finding.URL,
-		AttackVector:     finding.AttackVector,
+		FlawID:             finding.ID,
+		BuildID:            finding.BuildID,
+		ScanType:           "DAST",
+		Status:             string(transformedStatus),
+		MitigationStatus:   mitigationStatus,
+		ViolatesPolicy:     violatesPolicy,
+		Severity:           string(transformedSeverity),
+		SeverityScore:      severityNum,
+		CweId:              cweID,
+		CweName:            CWEName(cweID),
+		Description:        cleanedDesc,
+		References:         references,
+		URL:                finding.URL,
+		AttackVector:       finding.AttackVector,
+		Mitigations:        TransformAnnotations(finding.Annotations),
+		GracePeriodExpires: finding.GracePeriodExpires,
+		DetailsError:       finding.DetailsError,
 	}
 }
 
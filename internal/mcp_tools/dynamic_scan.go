@@ -0,0 +1,384 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	dynamic_analysis "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_analysis"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const DynamicScanToolName = "dynamic-scan"
+
+// dynamicScanOccurrenceLimit is how many recent runs are shown for each analysis
+const dynamicScanOccurrenceLimit = 3
+
+// maxLinkedAnalysisLookups bounds how many analyses have their scans listed to find the application's
+const maxLinkedAnalysisLookups = 50
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(DynamicScanToolName, handleDynamicScan)
+}
+
+// DynamicScanRequest represents the parsed parameters for dynamic-scan
+type DynamicScanRequest struct {
+	ApplicationPath     string
+	AppProfile          string
+	Analysis            string // Analysis name or ID; empty lists every analysis linked to the application
+	IncludeVerification bool
+	Start               bool
+	Confirm             bool
+}
+
+// MCPDynamicScanResponse lists the Dynamic Analyses that scan an application
+type MCPDynamicScanResponse struct {
+	AppProfile string               `json:"app_profile"`
+	Analyses   []MCPDynamicAnalysis `json:"analyses"`
+	Start      *MCPDynamicScanStart `json:"start,omitempty"`
+	Warnings   []string             `json:"warnings,omitempty"`
+}
+
+// MCPDynamicAnalysis is a configured Dynamic Analysis and its recent runs
+type MCPDynamicAnalysis struct {
+	AnalysisID     string                  `json:"analysis_id"`
+	Name           string                  `json:"name"`
+	TargetURLs     []string                `json:"target_urls"`
+	ScheduleStatus string                  `json:"schedule_status,omitempty"`
+	LatestStatus   string                  `json:"latest_status,omitempty"`
+	Occurrences    []MCPDynamicAnalysisRun `json:"recent_runs,omitempty"`
+	Error          string                  `json:"error,omitempty"`
+}
+
+// MCPDynamicAnalysisRun is one run (occurrence) of a Dynamic Analysis
+type MCPDynamicAnalysisRun struct {
+	OccurrenceID string              `json:"occurrence_id"`
+	Status       string              `json:"status"`
+	StartDate    string              `json:"start_date,omitempty"`
+	EndDate      string              `json:"end_date,omitempty"`
+	Scans        []MCPDynamicScanRun `json:"scans,omitempty"` // Only for the most recent run
+}
+
+// MCPDynamicScanRun is the run of one target URL within an analysis run
+type MCPDynamicScanRun struct {
+	ScanOccurrenceID string                  `json:"scan_occurrence_id"`
+	TargetURL        string                  `json:"target_url"`
+	Status           string                  `json:"status"`
+	FlawCount        int32                   `json:"flaw_count"`
+	Verification     *MCPDynamicVerification `json:"verification,omitempty"`
+}
+
+// MCPDynamicVerification is the pre-scan check that the target URL is reachable and the login works
+type MCPDynamicVerification struct {
+	Status   string   `json:"status"`
+	Summary  string   `json:"summary,omitempty"`
+	Messages []string `json:"messages,omitempty"`
+}
+
+// MCPDynamicScanStart reports a request to start an analysis
+type MCPDynamicScanStart struct {
+	AnalysisID string `json:"analysis_id"`
+	Name       string `json:"name"`
+	Started    bool   `json:"started"` // False until the start is confirmed
+	Error      string `json:"error,omitempty"`
+}
+
+// parseDynamicScanRequest extracts and validates parameters from the raw args map
+func parseDynamicScanRequest(args map[string]interface{}) (*DynamicScanRequest, error) {
+	req := &DynamicScanRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Analysis, _ = extractOptionalString(args, "analysis")
+	req.Analysis = strings.TrimSpace(req.Analysis)
+	req.IncludeVerification = extractFlag(args, "include_verification")
+	req.Start = extractFlag(args, "start")
+	req.Confirm = extractFlag(args, "confirm")
+
+	// Starting a scan sends traffic to a live site, so it must name exactly one analysis
+	if req.Start && req.Analysis == "" {
+		return nil, fmt.Errorf("analysis is required when start is true")
+	}
+	if req.Confirm && !req.Start {
+		return nil, fmt.Errorf("confirm can only be used with start")
+	}
+
+	return req, nil
+}
+
+func handleDynamicScan(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseDynamicScanRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return dynamicScanError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 3: Look up the application, whose GUID links it to Dynamic Analysis scans
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return dynamicScanError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+	}
+
+	// Step 4: Find the analyses that scan this application
+	analyses, warnings, err := findLinkedAnalyses(ctx, client, application.GetGuid(), req.Analysis)
+	if err != nil {
+		return dynamicScanError(appProfile, fmt.Sprintf("Error: Failed to list Dynamic Analyses\n\n%v", err)), nil
+	}
+	if req.Start && len(analyses) != 1 {
+		return dynamicScanError(appProfile, fmt.Sprintf("Error: analysis %q does not match exactly one Dynamic Analysis linked to this application (found %d)", req.Analysis, len(analyses))), nil
+	}
+
+	// Step 5: Start the analysis, once the user has confirmed it
+	response := &MCPDynamicScanResponse{AppProfile: appProfile, Analyses: analyses, Warnings: warnings}
+	if req.Start {
+		response.Start = &MCPDynamicScanStart{AnalysisID: analyses[0].AnalysisID, Name: analyses[0].Name}
+		if req.Confirm {
+			if err := client.StartDynamicAnalysis(ctx, analyses[0].AnalysisID); err != nil {
+				response.Start.Error = err.Error()
+			} else {
+				response.Start.Started = true
+			}
+		}
+	}
+
+	// Step 6: Show each analysis's recent runs
+	for i := range response.Analyses {
+		loadAnalysisRuns(ctx, client, &response.Analyses[i], req.IncludeVerification)
+	}
+
+	return formatDynamicScanResponse(response), nil
+}
+
+// dynamicScanError formats an error as an MCP tool response
+func dynamicScanError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Dynamic Scan - Error\n====================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// findLinkedAnalyses returns the analyses with a scan linked to the application, optionally
+// only the one whose name or ID matches filter. Listing an analysis's scans takes a call per analysis,
+// so at most maxLinkedAnalysisLookups analyses are checked, and an analysis whose scans can't be
+// listed is skipped; both are reported in the returned warnings.
+func findLinkedAnalyses(ctx context.Context, client api.Client, appGUID, filter string) ([]MCPDynamicAnalysis, []string, error) {
+	candidates, err := candidateAnalyses(ctx, client, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []string
+	if len(candidates) > maxLinkedAnalysisLookups {
+		warnings = append(warnings, fmt.Sprintf("The account has %d Dynamic Analyses; only the first %d were checked for scans of this application. Pass analysis to look one up by name or ID.", len(candidates), maxLinkedAnalysisLookups))
+		candidates = candidates[:maxLinkedAnalysisLookups]
+	}
+
+	var linked []MCPDynamicAnalysis
+	for _, analysis := range candidates {
+		scans, err := client.ListDynamicAnalysisScans(ctx, analysis.GetAnalysisId())
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Skipped analysis %q: failed to list its scans: %v", analysis.GetName(), err))
+			continue
+		}
+
+		var targetURLs []string
+		for _, scan := range scans {
+			if strings.EqualFold(scan.GetLinkedPlatformAppUuid(), appGUID) {
+				targetURLs = append(targetURLs, scan.GetTargetUrl())
+			}
+		}
+		if len(targetURLs) == 0 {
+			continue
+		}
+
+		linked = append(linked, MCPDynamicAnalysis{
+			AnalysisID:     analysis.GetAnalysisId(),
+			Name:           analysis.GetName(),
+			TargetURLs:     targetURLs,
+			ScheduleStatus: analysis.Schedule.GetScheduleStatus(),
+			LatestStatus:   analysis.LatestOccurrenceStatus.GetStatusType(),
+		})
+	}
+
+	sort.Slice(linked, func(i, j int) bool { return linked[i].Name < linked[j].Name })
+	return linked, warnings, nil
+}
+
+// candidateAnalyses returns the analyses named filter, filtered on the server, or the analysis with ID filter.
+// Without a filter it returns every analysis in the account.
+func candidateAnalyses(ctx context.Context, client api.Client, filter string) ([]dynamic_analysis.Analysis, error) {
+	analyses, err := client.ListDynamicAnalyses(ctx, filter)
+	if err != nil || filter == "" {
+		return analyses, err
+	}
+
+	// The name filter may match partially, so keep exact matches only
+	var named []dynamic_analysis.Analysis
+	for _, analysis := range analyses {
+		if strings.EqualFold(analysis.GetName(), filter) {
+			named = append(named, analysis)
+		}
+	}
+	if len(named) > 0 {
+		return named, nil
+	}
+
+	// Not a name, so try it as an analysis ID; an unknown ID matches nothing
+	analysis, err := client.GetDynamicAnalysis(ctx, filter)
+	if err != nil {
+		return nil, nil
+	}
+	return []dynamic_analysis.Analysis{*analysis}, nil
+}
+
+// loadAnalysisRuns adds an analysis's recent runs, with per-URL detail for the most recent one.
+// Failures are recorded on the analysis so the other analyses are still reported.
+func loadAnalysisRuns(ctx context.Context, client api.Client, analysis *MCPDynamicAnalysis, includeVerification bool) {
+	occurrences, err := client.ListAnalysisOccurrences(ctx, analysis.AnalysisID, dynamicScanOccurrenceLimit)
+	if err != nil {
+		analysis.Error = fmt.Sprintf("failed to list recent runs: %v", err)
+		return
+	}
+
+	// The client returns the most recent run first
+
+	for i, occurrence := range occurrences {
+		run := MCPDynamicAnalysisRun{
+			OccurrenceID: occurrence.GetAnalysisOccurrenceId(),
+			Status:       occurrence.Status.GetStatusType(),
+			StartDate:    firstNonEmpty(occurrence.GetActualStartDate(), occurrence.GetStartDate()),
+			EndDate:      firstNonEmpty(occurrence.GetActualEndDate(), occurrence.GetEndDate()),
+		}
+		if i == 0 {
+			run.Scans, err = loadScanRuns(ctx, client, run.OccurrenceID, includeVerification)
+			if err != nil {
+				analysis.Error = fmt.Sprintf("failed to list the scans of the latest run: %v", err)
+			}
+		}
+		analysis.Occurrences = append(analysis.Occurrences, run)
+	}
+}
+
+// loadScanRuns lists the per-URL runs of an analysis run, with their verification reports if requested
+func loadScanRuns(ctx context.Context, client api.Client, occurrenceID string, includeVerification bool) ([]MCPDynamicScanRun, error) {
+	occurrences, err := client.ListScanOccurrences(ctx, occurrenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]MCPDynamicScanRun, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		run := MCPDynamicScanRun{
+			ScanOccurrenceID: occurrence.GetScanOccurrenceId(),
+			TargetURL:        occurrence.GetTargetUrl(),
+			Status:           occurrence.Status.GetStatusType(),
+			FlawCount:        occurrence.GetCountOfFlaws(),
+		}
+		if includeVerification {
+			report, reportErr := client.GetVerificationReport(ctx, run.ScanOccurrenceID)
+			if reportErr != nil {
+				run.Verification = &MCPDynamicVerification{Status: "UNAVAILABLE", Summary: reportErr.Error()}
+			} else {
+				run.Verification = &MCPDynamicVerification{Status: report.GetStatus(), Summary: report.GetSummary(), Messages: report.Messages}
+			}
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+// firstNonEmpty returns the first of values that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// dynamicScanSummary describes the response in a sentence or two
+func dynamicScanSummary(response *MCPDynamicScanResponse) string {
+	var warnings string
+	for _, warning := range response.Warnings {
+		warnings += "\n⚠ " + warning
+	}
+	if len(response.Analyses) == 0 {
+		return fmt.Sprintf("No Dynamic Analysis has a scan linked to %s. Link a target URL to the application in the Veracode platform to scan it.", response.AppProfile) + warnings
+	}
+
+	summary := fmt.Sprintf("%d Dynamic Analysis configuration(s) scan %s.", len(response.Analyses), response.AppProfile) + warnings
+	if start := response.Start; start != nil {
+		switch {
+		case start.Error != "":
+			summary += fmt.Sprintf("\nFailed to start %q: %s", start.Name, start.Error)
+		case start.Started:
+			summary += fmt.Sprintf("\nStarted %q. Check its progress with dynamic-scan, and review the results with dynamic-findings once the run finishes.", start.Name)
+		default:
+			summary += fmt.Sprintf("\nNot started yet: starting %q scans the live target URLs listed below. Ask the user to confirm, then call dynamic-scan again with confirm set to true.", start.Name)
+		}
+	}
+	return summary
+}
+
+// formatDynamicScanResponse formats the analyses into an MCP tool response
+func formatDynamicScanResponse(response *MCPDynamicScanResponse) map[string]interface{} {
+	if response.Analyses == nil {
+		response.Analyses = []MCPDynamicAnalysis{}
+	}
+
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format dynamic scan response: %v", err)}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": dynamicScanSummary(response) + "\n\n" + string(responseJSON),
+		}},
+	}
+}
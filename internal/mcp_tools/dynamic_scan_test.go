@@ -0,0 +1,233 @@
+package mcp_tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	dynamic_analysis "github.com/dipsylala/veracode-mcp/api/rest/generated/dynamic_analysis"
+)
+
+// dynamicAnalysisClient answers the Dynamic Analysis calls; every other method is unimplemented
+type dynamicAnalysisClient struct {
+	api.Client
+	analyses []dynamic_analysis.Analysis
+	scans    map[string][]dynamic_analysis.Scan
+	runs     map[string][]dynamic_analysis.AnalysisOccurrence
+	started  []string
+
+	scanLookups int
+}
+
+func (c *dynamicAnalysisClient) ListDynamicAnalyses(_ context.Context, name string) ([]dynamic_analysis.Analysis, error) {
+	var analyses []dynamic_analysis.Analysis
+	for _, analysis := range c.analyses {
+		if strings.Contains(strings.ToLower(analysis.GetName()), strings.ToLower(name)) {
+			analyses = append(analyses, analysis)
+		}
+	}
+	return analyses, nil
+}
+
+func (c *dynamicAnalysisClient) GetDynamicAnalysis(_ context.Context, analysisID string) (*dynamic_analysis.Analysis, error) {
+	for _, analysis := range c.analyses {
+		if analysis.GetAnalysisId() == analysisID {
+			return &analysis, nil
+		}
+	}
+	return nil, errors.New("API returned status 404")
+}
+
+func (c *dynamicAnalysisClient) ListDynamicAnalysisScans(_ context.Context, analysisID string) ([]dynamic_analysis.Scan, error) {
+	c.scanLookups++
+	if scans, ok := c.scans[analysisID]; ok {
+		return scans, nil
+	}
+	return nil, errors.New("API returned status 500")
+}
+
+func (c *dynamicAnalysisClient) ListAnalysisOccurrences(_ context.Context, analysisID string, _ int32) ([]dynamic_analysis.AnalysisOccurrence, error) {
+	return c.runs[analysisID], nil
+}
+
+func (c *dynamicAnalysisClient) ListScanOccurrences(_ context.Context, occurrenceID string) ([]dynamic_analysis.ScanOccurrence, error) {
+	occurrence := dynamic_analysis.ScanOccurrence{}
+	occurrence.SetScanOccurrenceId(occurrenceID + "-scan")
+	occurrence.SetTargetUrl("https://app.example.com")
+	occurrence.SetCountOfFlaws(7)
+	return []dynamic_analysis.ScanOccurrence{occurrence}, nil
+}
+
+func (c *dynamicAnalysisClient) GetVerificationReport(_ context.Context, _ string) (*dynamic_analysis.VerificationReport, error) {
+	return nil, errors.New("API returned status 404")
+}
+
+func (c *dynamicAnalysisClient) StartDynamicAnalysis(_ context.Context, analysisID string) error {
+	c.started = append(c.started, analysisID)
+	return nil
+}
+
+func newTestAnalysis(id, name string) dynamic_analysis.Analysis {
+	analysis := dynamic_analysis.Analysis{}
+	analysis.SetAnalysisId(id)
+	analysis.SetName(name)
+	return analysis
+}
+
+func newTestDynamicScan(targetURL, appGUID string) dynamic_analysis.Scan {
+	scan := dynamic_analysis.Scan{}
+	scan.SetTargetUrl(targetURL)
+	scan.SetLinkedPlatformAppUuid(appGUID)
+	return scan
+}
+
+func newTestAnalysisRun(id, startDate string) dynamic_analysis.AnalysisOccurrence {
+	run := dynamic_analysis.AnalysisOccurrence{}
+	run.SetAnalysisOccurrenceId(id)
+	run.SetStartDate(startDate)
+	return run
+}
+
+func newTestDynamicAnalysisClient() *dynamicAnalysisClient {
+	return &dynamicAnalysisClient{
+		analyses: []dynamic_analysis.Analysis{newTestAnalysis("a1", "Nightly"), newTestAnalysis("a2", "Other app"), newTestAnalysis("a3", "Release")},
+		scans: map[string][]dynamic_analysis.Scan{
+			"a1": {newTestDynamicScan("https://app.example.com", "app-guid"), newTestDynamicScan("https://other.example.com", "other-guid")},
+			"a2": {newTestDynamicScan("https://other.example.com", "other-guid")},
+			"a3": {newTestDynamicScan("https://staging.example.com", "APP-GUID")},
+		},
+		runs: map[string][]dynamic_analysis.AnalysisOccurrence{
+			// Most recent first, as the client returns them
+			"a1": {newTestAnalysisRun("o2", "2026-10-01T00:00:00Z"), newTestAnalysisRun("o1", "2026-09-01T00:00:00Z")},
+		},
+	}
+}
+
+func TestParseDynamicScanRequest(t *testing.T) {
+	req, err := parseDynamicScanRequest(map[string]interface{}{
+		"application_path":     "/work/app",
+		"analysis":             " Nightly ",
+		"include_verification": true,
+		"start":                true,
+		"confirm":              true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Analysis != "Nightly" || !req.IncludeVerification || !req.Start || !req.Confirm {
+		t.Errorf("unexpected request: %+v", req)
+	}
+
+	if _, err := parseDynamicScanRequest(map[string]interface{}{"application_path": "/work/app", "start": true}); err == nil {
+		t.Error("expected error for start without an analysis")
+	}
+	if _, err := parseDynamicScanRequest(map[string]interface{}{"application_path": "/work/app", "confirm": true}); err == nil {
+		t.Error("expected error for confirm without start")
+	}
+}
+
+func TestFindLinkedAnalyses(t *testing.T) {
+	client := newTestDynamicAnalysisClient()
+	ctx := context.Background()
+
+	analyses, warnings, err := findLinkedAnalyses(ctx, client, "app-guid", "")
+	if err != nil || len(warnings) != 0 {
+		t.Fatalf("unexpected error: %v, %v", err, warnings)
+	}
+	if len(analyses) != 2 || analyses[0].Name != "Nightly" || analyses[1].Name != "Release" {
+		t.Fatalf("expected Nightly and Release, got %+v", analyses)
+	}
+	if len(analyses[0].TargetURLs) != 1 || analyses[0].TargetURLs[0] != "https://app.example.com" {
+		t.Errorf("only this application's target URLs should be listed, got %v", analyses[0].TargetURLs)
+	}
+
+	for _, filter := range []string{"nightly", "a1"} {
+		client.scanLookups = 0
+		analyses, _, err = findLinkedAnalyses(ctx, client, "app-guid", filter)
+		if err != nil || len(analyses) != 1 || analyses[0].AnalysisID != "a1" {
+			t.Errorf("filter %q: got %+v, %v", filter, analyses, err)
+		}
+		if client.scanLookups != 1 {
+			t.Errorf("filter %q: expected the scans of one analysis to be listed, got %d lookups", filter, client.scanLookups)
+		}
+	}
+	if analyses, _, _ = findLinkedAnalyses(ctx, client, "app-guid", "Other app"); len(analyses) != 0 {
+		t.Errorf("an analysis of another application should not match, got %+v", analyses)
+	}
+	if analyses, _, _ = findLinkedAnalyses(ctx, client, "app-guid", "missing"); len(analyses) != 0 {
+		t.Errorf("an unknown analysis should match nothing, got %+v", analyses)
+	}
+}
+
+func TestFindLinkedAnalyses_SkipsFailuresAndBoundsLookups(t *testing.T) {
+	client := newTestDynamicAnalysisClient()
+	ctx := context.Background()
+
+	// An analysis whose scans can't be listed is skipped, not fatal
+	client.analyses = append(client.analyses, newTestAnalysis("broken", "Broken"))
+	analyses, warnings, err := findLinkedAnalyses(ctx, client, "app-guid", "")
+	if err != nil || len(analyses) != 2 {
+		t.Fatalf("expected the other analyses despite the failure, got %+v, %v", analyses, err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `Skipped analysis "Broken"`) {
+		t.Errorf("expected a warning for the skipped analysis, got %v", warnings)
+	}
+
+	for i := 0; i < maxLinkedAnalysisLookups; i++ {
+		client.analyses = append(client.analyses, newTestAnalysis(fmt.Sprintf("x%d", i), fmt.Sprintf("Extra %d", i)))
+	}
+	client.scanLookups = 0
+	_, warnings, _ = findLinkedAnalyses(ctx, client, "app-guid", "")
+	if client.scanLookups != maxLinkedAnalysisLookups {
+		t.Errorf("expected %d scan lookups, got %d", maxLinkedAnalysisLookups, client.scanLookups)
+	}
+	if len(warnings) == 0 || !strings.Contains(warnings[0], "only the first 50 were checked") {
+		t.Errorf("expected a warning that the lookup was bounded, got %v", warnings)
+	}
+}
+
+func TestLoadAnalysisRuns(t *testing.T) {
+	client := newTestDynamicAnalysisClient()
+	analysis := &MCPDynamicAnalysis{AnalysisID: "a1"}
+
+	loadAnalysisRuns(context.Background(), client, analysis, true)
+
+	if len(analysis.Occurrences) != 2 || analysis.Occurrences[0].OccurrenceID != "o2" {
+		t.Fatalf("expected the October run first, got %+v", analysis.Occurrences)
+	}
+	latest := analysis.Occurrences[0]
+	if len(latest.Scans) != 1 || latest.Scans[0].FlawCount != 7 {
+		t.Errorf("unexpected scans of the latest run: %+v", latest.Scans)
+	}
+	if latest.Scans[0].Verification == nil || latest.Scans[0].Verification.Status != "UNAVAILABLE" {
+		t.Errorf("a missing verification report should be reported as unavailable, got %+v", latest.Scans[0].Verification)
+	}
+	if analysis.Occurrences[1].Scans != nil {
+		t.Error("only the latest run should list its scans")
+	}
+}
+
+func TestDynamicScanSummary(t *testing.T) {
+	analyses := []MCPDynamicAnalysis{{AnalysisID: "a1", Name: "Nightly"}}
+	tests := []struct {
+		name     string
+		response MCPDynamicScanResponse
+		want     string
+	}{
+		{"no analyses", MCPDynamicScanResponse{AppProfile: "App"}, "No Dynamic Analysis"},
+		{"list", MCPDynamicScanResponse{AppProfile: "App", Analyses: analyses}, "1 Dynamic Analysis"},
+		{"pending", MCPDynamicScanResponse{AppProfile: "App", Analyses: analyses, Start: &MCPDynamicScanStart{Name: "Nightly"}}, "confirm set to true"},
+		{"started", MCPDynamicScanResponse{AppProfile: "App", Analyses: analyses, Start: &MCPDynamicScanStart{Name: "Nightly", Started: true}}, `Started "Nightly"`},
+		{"failed", MCPDynamicScanResponse{AppProfile: "App", Analyses: analyses, Start: &MCPDynamicScanStart{Name: "Nightly", Error: "API returned status 409"}}, "status 409"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dynamicScanSummary(&tt.response); !strings.Contains(got, tt.want) {
+				t.Errorf("dynamicScanSummary() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
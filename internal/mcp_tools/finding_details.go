@@ -20,7 +20,9 @@ func init() {
 type FindingDetailsRequest struct {
 	ApplicationPath string `json:"application_path"`
 	AppProfile      string `json:"app_profile,omitempty"`
-	FlawID          string `json:"flaw_id"` // String to support both platform "12345" and pipeline "1234-1" formats
+	Sandbox         string `json:"sandbox,omitempty"`
+	ScanType        string `json:"scan_type,omitempty"` // STATIC, DYNAMIC, MANUAL or SCA; narrows the platform lookup
+	FlawID          string `json:"flaw_id"`             // String to support both platform "12345" and pipeline "1234-1" formats
 }
 
 // parseFindingDetailsRequest extracts and validates parameters from the raw args map
@@ -46,20 +48,37 @@ func parseFindingDetailsRequest(args map[string]interface{}) (*FindingDetailsReq
 
 	// Extract optional fields
 	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Sandbox, _ = extractOptionalString(args, "sandbox")
+	req.ScanType, err = extractOptionalEnum(args, "scan_type", []string{"STATIC", "DYNAMIC", "MANUAL", "SCA"})
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// getAppProfileName retrieves the application profile name from request or workspace config
+// getAppProfileName retrieves the application profile name from request or workspace config.
+// When the workspace config is used, its default sandbox is applied if no sandbox was requested.
 func getAppProfileName(req *FindingDetailsRequest) (string, error) {
 	if req.AppProfile != "" {
 		return req.AppProfile, nil
 	}
-	return workspace.FindWorkspaceConfig(req.ApplicationPath)
+
+	config, err := workspace.LoadWorkspaceConfig(req.ApplicationPath)
+	if err != nil {
+		return "", err
+	}
+	if req.Sandbox == "" {
+		req.Sandbox = config.Sandbox
+	}
+	return config.Application(), nil
 }
 
-// lookupApplicationGUID looks up the application GUID by profile name
+// lookupApplicationGUID looks up the application GUID by profile name, or returns the GUID it's given
 func lookupApplicationGUID(ctx context.Context, client api.Client, appProfile string) (string, error) {
+	if isApplicationGUID(appProfile) {
+		return appProfile, nil
+	}
 	authCtx := client.GetAuthContext(ctx)
 	app, err := client.GetApplicationByName(authCtx, appProfile)
 	if err != nil {
@@ -83,6 +102,6 @@ func handleGetFindingDetails(ctx context.Context, args map[string]interface{}) (
 		return handlePipelineFindingDetails(ctx, req)
 	}
 
-	// Platform scan (static/dynamic) - route to platform logic
+	// Platform scan (static/dynamic/manual/SCA) - route to platform logic
 	return handlePlatformFindingDetails(ctx, req)
 }
@@ -15,7 +15,7 @@ import (
 	staticfindingdatapath "github.com/dipsylala/veracode-mcp/api/rest/generated/static_finding_data_path"
 )
 
-// handlePlatformFindingDetails handles platform scan flaws (static/dynamic)
+// handlePlatformFindingDetails handles platform scan flaws (static/dynamic/manual/SCA)
 func handlePlatformFindingDetails(ctx context.Context, req *FindingDetailsRequest) (interface{}, error) {
 	// Convert string flaw_id to int for platform API calls
 	flawIDInt, err := strconv.Atoi(req.FlawID)
@@ -43,7 +43,7 @@ func handlePlatformFindingDetails(ctx context.Context, req *FindingDetailsReques
 	}
 
 	// Create Veracode API client
-	client, err := api.NewClient()
+	client, err := newWorkspaceClient(req.ApplicationPath)
 	if err != nil {
 		return map[string]interface{}{"error": fmt.Sprintf("Failed to create Veracode API client: %v", err)}, nil
 	}
@@ -59,49 +59,77 @@ func handlePlatformFindingDetails(ctx context.Context, req *FindingDetailsReques
 		}, nil
 	}
 
-	// Try to get static flaw details first
-	staticFlaw, buildID, staticErr := tryGetStaticFlaw(ctx, client, appGUID, flawIDInt)
-	if staticErr == nil {
-		// Get mitigation info if we have a build_id
-		var mitigationInfo *api.MitigationIssue
-		if buildID != nil {
-			log.Printf("Fetching mitigation info for build_id=%d, flaw_id=%d", *buildID, flawIDInt)
-			//nolint:gosec // G115: Safe conversion from int to int64
-			mitigationInfo, _ = client.GetMitigationInfoForSingleFlaw(ctx, *buildID, int64(flawIDInt))
-			if mitigationInfo != nil {
-				log.Printf("Retrieved %d mitigation actions", len(mitigationInfo.MitigationActions))
+	// Resolve the sandbox, if any; otherwise the policy scan is searched
+	sandbox, err := resolveSandbox(ctx, client, appGUID, req.Sandbox)
+	if err != nil {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf("Finding Details Lookup\n======================\n\nApplication Path: %s\nApplication Profile: %s\nSandbox: %s\n\n❌ Error: Failed to find sandbox\n\n%v\n", req.ApplicationPath, appProfile, req.Sandbox, err),
+			}},
+		}, nil
+	}
+
+	// Look up the finding so we know which detail API to call; without a scan_type every scan type is searched
+	finding, err := client.GetFindingByID(ctx, appGUID, sandboxGUID(sandbox), req.FlawID, req.ScanType)
+	if err != nil {
+		return formatErrorResponse(req.ApplicationPath, appProfile, appGUID, flawIDInt, err), nil
+	}
+	log.Printf("Found flaw ID %d as a %s finding in build %d", flawIDInt, finding.ScanType, finding.BuildID)
+
+	// Fetch scan-type specific details (data paths, HTTP request/response or the tester's write-up) where available
+	var flaw interface{}
+	buildID := finding.BuildID
+	switch finding.ScanType {
+	case "STATIC":
+		staticFlaw, staticBuildID, staticErr := tryGetStaticFlaw(ctx, client, appGUID, sandboxGUID(sandbox), flawIDInt)
+		if staticErr == nil {
+			flaw = staticFlaw
+			if buildID == 0 && staticBuildID != nil {
+				buildID = *staticBuildID
 			}
 		}
-		return formatStaticFlawDetailsResponse(req.ApplicationPath, appProfile, flawIDInt, staticFlaw, mitigationInfo), nil
-	}
-
-	// Try dynamic flaw
-	dynamicFlaw, buildID, dynamicErr := tryGetDynamicFlaw(ctx, client, appGUID, flawIDInt)
-	if dynamicErr == nil {
-		// Get mitigation info if we have a build_id
-		var mitigationInfo *api.MitigationIssue
-		if buildID != nil {
-			log.Printf("Fetching mitigation info for build_id=%d, flaw_id=%d", *buildID, flawIDInt)
-			//nolint:gosec // G115: Safe conversion from int to int64
-			mitigationInfo, _ = client.GetMitigationInfoForSingleFlaw(ctx, *buildID, int64(flawIDInt))
-			if mitigationInfo != nil {
-				log.Printf("Retrieved %d mitigation actions", len(mitigationInfo.MitigationActions))
+	case "DYNAMIC":
+		dynamicFlaw, dynamicBuildID, dynamicErr := tryGetDynamicFlaw(ctx, client, appGUID, flawIDInt)
+		if dynamicErr == nil {
+			flaw = dynamicFlaw
+			if buildID == 0 && dynamicBuildID != nil {
+				buildID = *dynamicBuildID
 			}
 		}
-		return formatDynamicFlawDetailsResponse(req.ApplicationPath, appProfile, flawIDInt, dynamicFlaw, mitigationInfo), nil
+	case "MANUAL":
+		detail, manualErr := client.GetManualFindingDetail(ctx, appGUID, req.FlawID)
+		if manualErr != nil {
+			log.Printf("Manual scan details lookup failed for flaw ID %d: %v", flawIDInt, manualErr)
+		} else {
+			flaw = detail
+		}
+	}
+
+	// Get mitigation info if we have a build_id (SCA mitigations are carried in the finding's annotations)
+	var mitigationInfo *api.MitigationIssue
+	if buildID != 0 && finding.ScanType != "SCA" {
+		log.Printf("Fetching mitigation info for build_id=%d, flaw_id=%d", buildID, flawIDInt)
+		//nolint:gosec // G115: Safe conversion from int to int64
+		mitigationInfo, _ = client.GetMitigationInfoForSingleFlaw(ctx, buildID, int64(flawIDInt))
+		if mitigationInfo != nil {
+			log.Printf("Retrieved %d mitigation actions", len(mitigationInfo.MitigationActions))
+		}
 	}
 
-	// Neither found - return error
-	return formatErrorResponse(req.ApplicationPath, appProfile, appGUID, flawIDInt, staticErr, dynamicErr), nil
+	return formatFlawDetailsResponse(req.ApplicationPath, appProfile, sandbox, flawIDInt, finding, flaw, mitigationInfo), nil
 }
 
-// tryGetStaticFlaw attempts to retrieve static flaw details
-func tryGetStaticFlaw(ctx context.Context, client api.Client, appGUID string, flawID int) (interface{}, *int64, error) {
+// tryGetStaticFlaw attempts to retrieve static flaw details, from the sandbox if sandboxGUID is set
+func tryGetStaticFlaw(ctx context.Context, client api.Client, appGUID, sandboxGUID string, flawID int) (interface{}, *int64, error) {
 	authCtx := client.GetAuthContext(ctx)
 	issueIDStr := strconv.Itoa(flawID)
 
 	staticFlawReq := client.StaticFindingDataPathClient().StaticFlawDataPathsInformationAPI.
 		AppsecV2ApplicationsAppGuidFindingsIssueIdStaticFlawInfoGet(authCtx, appGUID, issueIDStr)
+	if sandboxGUID != "" {
+		staticFlawReq = staticFlawReq.Context(sandboxGUID)
+	}
 
 	staticFlaw, staticResp, staticErr := staticFlawReq.Execute()
 
@@ -162,17 +190,7 @@ func tryGetDynamicFlaw(ctx context.Context, client api.Client, appGUID string, f
 }
 
 // formatErrorResponse creates a formatted error response
-func formatErrorResponse(appPath, appProfile, appGUID string, flawID int, staticErr, dynamicErr error) map[string]interface{} {
-	errorMsg := "Not found in either static or dynamic scan results"
-	if staticErr != nil {
-		errorMsg = fmt.Sprintf("Static API error: %v", staticErr)
-		if dynamicErr != nil {
-			errorMsg = fmt.Sprintf("Static API error: %v; Dynamic API error: %v", staticErr, dynamicErr)
-		}
-	} else if dynamicErr != nil {
-		errorMsg = fmt.Sprintf("Dynamic API error: %v", dynamicErr)
-	}
-
+func formatErrorResponse(appPath, appProfile, appGUID string, flawID int, lookupErr error) map[string]interface{} {
 	return map[string]interface{}{
 		"content": []map[string]string{{
 			"type": "text",
@@ -186,23 +204,27 @@ Flaw ID: %d
 
 ❌ Finding not found
 
-The specified flaw ID was not found in either static or dynamic scan results for this application.
+The specified flaw ID was not found in the static, dynamic, manual or SCA findings for this application.
 
-Error details: %s
+Error details: %v
 
 Please verify that:
 1. The flaw ID is correct
 2. The flaw belongs to this application
 3. You have access to view findings for this application
-`, appPath, appProfile, appGUID, flawID, errorMsg),
+`, appPath, appProfile, appGUID, flawID, lookupErr),
 		}},
 	}
 }
 
-// formatStaticFlawDetailsResponse formats static flaw details into an MCP response
-func formatStaticFlawDetailsResponse(appPath, appProfile string, flawID int, flaw interface{}, mitigationInfo *api.MitigationIssue) map[string]interface{} {
+// formatFlawDetailsResponse formats a platform finding and its scan-specific details into an MCP response
+func formatFlawDetailsResponse(appPath, appProfile string, sandbox *MCPSandbox, flawID int, finding *api.Finding, flaw interface{}, mitigationInfo *api.MitigationIssue) map[string]interface{} {
 	// Build the LLM-optimized JSON structure
-	result := buildLLMOptimizedResponse(appPath, appProfile, flawID, "STATIC", flaw, mitigationInfo)
+	result := buildLLMOptimizedResponse(appPath, appProfile, flawID, finding.ScanType, flaw, mitigationInfo)
+	addFindingSummary(result, finding)
+	if sandbox != nil {
+		result["sandbox"] = sandbox
+	}
 
 	// Marshal to JSON
 	jsonBytes, err := json.MarshalIndent(result, "", "  ")
@@ -227,31 +249,65 @@ func formatStaticFlawDetailsResponse(appPath, appProfile string, flawID int, fla
 	}
 }
 
-// formatDynamicFlawDetailsResponse formats dynamic flaw details into an MCP response
-func formatDynamicFlawDetailsResponse(appPath, appProfile string, flawID int, flaw interface{}, mitigationInfo *api.MitigationIssue) map[string]interface{} {
-	// Build the LLM-optimized JSON structure
-	result := buildLLMOptimizedResponse(appPath, appProfile, flawID, "DYNAMIC", flaw, mitigationInfo)
+// addFindingSummary adds the vulnerability metadata from the findings API
+// (which the data path and dynamic flaw APIs don't return) to the response
+func addFindingSummary(response map[string]interface{}, finding *api.Finding) {
+	if finding == nil {
+		return
+	}
 
-	// Marshal to JSON
-	jsonBytes, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return map[string]interface{}{
-			"content": []map[string]interface{}{
-				{
-					"type": "text",
-					"text": fmt.Sprintf("Error formatting flaw details: %v", err),
-				},
-			},
+	severityScore := finding.SeverityScore
+	summary := map[string]interface{}{
+		"build_id":          finding.BuildID,
+		"status":            finding.Status,
+		"resolution_status": finding.ResolutionStatus,
+		"violates_policy":   finding.ViolatesPolicy,
+		"severity":          string(TransformSeverity(&severityScore)),
+		"severity_score":    severityScore,
+	}
+	if finding.CWE != "" {
+		summary["cwe"] = finding.CWE
+	}
+	if finding.Description != "" {
+		description, references := TransformDescription(finding.Description, finding.ScanType)
+		summary["description"] = description
+		if len(references) > 0 {
+			summary["references"] = references
 		}
 	}
+	response["finding"] = summary
 
-	return map[string]interface{}{
-		"content": []map[string]interface{}{
-			{
-				"type": "text",
-				"text": string(jsonBytes),
-			},
-		},
+	// Static/dynamic locations come from the detail APIs; fill in from the finding if they weren't available
+	if _, hasLocation := response["location"]; !hasLocation {
+		switch {
+		case finding.FilePath != "":
+			response["location"] = map[string]interface{}{
+				"file_path":   finding.FilePath,
+				"line_number": finding.LineNumber,
+				"module":      finding.Module,
+				"function":    finding.Procedure,
+			}
+		case finding.URL != "":
+			response["location"] = map[string]interface{}{"url": finding.URL}
+		}
+	}
+
+	if finding.ScanType == "SCA" {
+		component := map[string]interface{}{
+			"filename": finding.ComponentFilename,
+			"version":  finding.ComponentVersion,
+		}
+		if finding.CVE != "" {
+			component["cve"] = finding.CVE
+		}
+		if len(finding.Licenses) > 0 {
+			component["licenses"] = finding.Licenses
+		}
+		response["component"] = component
+	}
+
+	if len(finding.Annotations) > 0 {
+		response["annotations"] = finding.Annotations
 	}
 }
 
@@ -275,6 +331,10 @@ func buildLLMOptimizedResponse(appPath, appProfile string, flawID int, scanType
 		if dynamicFlaw, ok := flaw.(*dynamicflaw.DynamicFlaw); ok {
 			extractDynamicFlawInfo(response, dynamicFlaw)
 		}
+	} else if scanType == "MANUAL" {
+		if manualDetail, ok := flaw.(*api.ManualFindingDetail); ok {
+			extractManualFlawInfo(response, manualDetail)
+		}
 	}
 
 	// Add mitigation information if available
@@ -377,6 +437,55 @@ func extractDynamicFlawInfo(response map[string]interface{}, dynamicFlaw *dynami
 	}
 }
 
+// extractManualFlawInfo extracts the manual penetration test write-up into the response
+func extractManualFlawInfo(response map[string]interface{}, manualDetail *api.ManualFindingDetail) {
+	if manualDetail.Location != "" || manualDetail.Module != "" {
+		location := map[string]interface{}{}
+		if manualDetail.Location != "" {
+			location["url"] = manualDetail.Location
+		}
+		if manualDetail.Module != "" {
+			location["module"] = manualDetail.Module
+		}
+		response["location"] = location
+	}
+
+	manualTest := map[string]interface{}{
+		"scan_id": manualDetail.ScanID,
+	}
+	if manualDetail.ScanName != "" {
+		manualTest["scan_name"] = manualDetail.ScanName
+	}
+	if manualDetail.CapecID != 0 {
+		manualTest["capec_id"] = manualDetail.CapecID
+	}
+	if manualDetail.Exploitability != nil {
+		manualTest["exploitability"] = *manualDetail.Exploitability
+	}
+	if manualDetail.ExploitDifficulty != 0 {
+		manualTest["exploit_difficulty"] = manualDetail.ExploitDifficulty
+	}
+	if manualDetail.RemediationEffort != 0 {
+		manualTest["remediation_effort"] = manualDetail.RemediationEffort
+	}
+	if manualDetail.InputVector != "" {
+		manualTest["input_vector"] = manualDetail.InputVector
+	}
+	if manualDetail.ExploitDescription != "" {
+		manualTest["exploit_description"] = manualDetail.ExploitDescription
+	}
+	if manualDetail.Remediation != "" {
+		manualTest["remediation"] = manualDetail.Remediation
+	}
+	if manualDetail.SeverityRationale != "" {
+		manualTest["severity_rationale"] = manualDetail.SeverityRationale
+	}
+	if manualDetail.CVSS != 0 {
+		manualTest["cvss"] = manualDetail.CVSS
+	}
+	response["manual_test"] = manualTest
+}
+
 // buildMitigationInfo creates mitigation information structure
 func buildMitigationInfo(mitigationInfo *api.MitigationIssue) map[string]interface{} {
 	if mitigationInfo == nil {
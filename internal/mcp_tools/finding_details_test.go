@@ -2,7 +2,11 @@ package mcp_tools
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
 )
 
 // ============================================================================
@@ -48,6 +52,49 @@ func TestParseFindingDetailsRequest_WithAppProfile(t *testing.T) {
 	}
 }
 
+func TestParseFindingDetailsRequest_WithSandbox(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path": "/path/to/app",
+		"flaw_id":          "123",
+		"sandbox":          "feature-login",
+	}
+
+	req, err := parseFindingDetailsRequest(args)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.Sandbox != "feature-login" {
+		t.Errorf("Expected sandbox 'feature-login', got '%s'", req.Sandbox)
+	}
+}
+
+func TestGetAppProfileName_WorkspaceDefaultSandbox(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `{"name": "WorkspaceApp", "sandbox": "feature-login"}`
+	if err := os.WriteFile(filepath.Join(tempDir, ".veracode-workspace.json"), []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write workspace file: %v", err)
+	}
+
+	req := &FindingDetailsRequest{ApplicationPath: tempDir, FlawID: "123"}
+	appProfile, err := getAppProfileName(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if appProfile != "WorkspaceApp" || req.Sandbox != "feature-login" {
+		t.Errorf("Expected WorkspaceApp with sandbox feature-login, got %s/%s", appProfile, req.Sandbox)
+	}
+
+	// An explicit sandbox wins over the workspace default
+	req = &FindingDetailsRequest{ApplicationPath: tempDir, FlawID: "123", Sandbox: "other"}
+	if _, err := getAppProfileName(req); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if req.Sandbox != "other" {
+		t.Errorf("Expected explicit sandbox to be kept, got %s", req.Sandbox)
+	}
+}
+
 func TestParseFindingDetailsRequest_MissingFlawID(t *testing.T) {
 	args := map[string]interface{}{
 		"application_path": "/path/to/app",
@@ -167,3 +214,97 @@ func TestFindingDetailsTool_HandleInvalidFlawID(t *testing.T) {
 		t.Error("Expected error for empty flaw_id")
 	}
 }
+
+// ============================================================================
+// formatFlawDetailsResponse tests
+// ============================================================================
+
+func TestAddFindingSummary_SCAFinding(t *testing.T) {
+	response := buildLLMOptimizedResponse("/path/to/app", "MyApp", 1234, "SCA", nil, nil)
+	addFindingSummary(response, &api.Finding{
+		ID:                "1234",
+		ScanType:          "SCA",
+		SeverityScore:     4,
+		CWE:               "CWE-79",
+		Status:            "OPEN",
+		ViolatesPolicy:    true,
+		ComponentFilename: "lodash-4.17.15.js",
+		ComponentVersion:  "4.17.15",
+		CVE:               "CVE-2020-8203",
+		Annotations: []api.Annotation{
+			{Action: "COMMENT", Comment: "Upgrade scheduled", UserName: "jdoe"},
+		},
+	})
+
+	summary, ok := response["finding"].(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected finding summary in response")
+	}
+	if summary["cwe"] != "CWE-79" || summary["violates_policy"] != true {
+		t.Errorf("Unexpected finding summary: %+v", summary)
+	}
+
+	component, ok := response["component"].(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected component block for SCA finding")
+	}
+	if component["cve"] != "CVE-2020-8203" || component["version"] != "4.17.15" {
+		t.Errorf("Unexpected component block: %+v", component)
+	}
+
+	if annotations, ok := response["annotations"].([]api.Annotation); !ok || len(annotations) != 1 {
+		t.Errorf("Expected 1 annotation, got %+v", response["annotations"])
+	}
+}
+
+func TestAddFindingSummary_ManualFindingLocation(t *testing.T) {
+	response := buildLLMOptimizedResponse("/path/to/app", "MyApp", 55, "MANUAL", nil, nil)
+	addFindingSummary(response, &api.Finding{
+		ID:       "55",
+		ScanType: "MANUAL",
+		URL:      "https://example.com/login",
+	})
+
+	location, ok := response["location"].(map[string]interface{})
+	if !ok || location["url"] != "https://example.com/login" {
+		t.Errorf("Expected location from the finding's URL, got %+v", response["location"])
+	}
+	if _, ok := response["component"]; ok {
+		t.Error("Did not expect a component block for a manual finding")
+	}
+}
+
+func TestBuildLLMOptimizedResponse_ManualFinding(t *testing.T) {
+	exploitability := int32(2)
+	detail := &api.ManualFindingDetail{
+		ScanID:             3,
+		ScanName:           "Annual pen test",
+		CapecID:            87,
+		RemediationEffort:  3,
+		Exploitability:     &exploitability,
+		ExploitDescription: "Changed the id parameter",
+		Location:           "https://example.com/account",
+		Remediation:        "Check the account belongs to the session user",
+	}
+
+	response := buildLLMOptimizedResponse("/path", "MyApp", 42, "MANUAL", detail, nil)
+
+	manualTest, ok := response["manual_test"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected a manual_test block, got %v", response)
+	}
+	if manualTest["capec_id"] != int32(87) || manualTest["exploitability"] != int32(2) || manualTest["scan_name"] != "Annual pen test" {
+		t.Errorf("Unexpected manual_test: %v", manualTest)
+	}
+	if manualTest["remediation_effort"] != int32(3) {
+		t.Errorf("Expected remediation effort 3, got %v", manualTest["remediation_effort"])
+	}
+	if manualTest["remediation"] != "Check the account belongs to the session user" {
+		t.Errorf("Expected the tester's remediation advice, got %v", manualTest["remediation"])
+	}
+
+	location, ok := response["location"].(map[string]interface{})
+	if !ok || location["url"] != "https://example.com/account" {
+		t.Errorf("Expected the tested URL as the location, got %v", response["location"])
+	}
+}
@@ -0,0 +1,197 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+// findingsFormat describes how a platform findings tool converts, counts and presents its findings
+type findingsFormat struct {
+	Label             string // Names the tool in log messages, e.g. "Static findings"
+	ScanType          string // STATIC, DYNAMIC, MANUAL or SCA
+	Convert           func(api.Finding) MCPFinding
+	Count             func(*MCPFindingsSummary, MCPFinding)
+	SortBySeverity    bool // List the most severe findings first
+	StructuredContent bool // Add the response as structuredContent for clients that support MCP Apps UI
+}
+
+// collectedFindings are the findings a platform findings tool lists: one page, or with all_pages
+// the first max_results findings of every page
+type collectedFindings struct {
+	Response *api.FindingsResponse
+	Summary  *MCPFindingsSummary // With all_pages, the counts of every finding; otherwise nil and the listed findings are counted
+}
+
+// collectFindings fetches the page of findings req asks for. With allPages it walks every page instead,
+// counting each finding as it streams past so the summary covers the whole application, and keeps only
+// the first maxResults findings to list.
+func collectFindings(ctx context.Context, client api.Client, req api.FindingsRequest, allPages bool, maxResults int, format findingsFormat) (*collectedFindings, error) {
+	if !allPages {
+		var resp *api.FindingsResponse
+		var err error
+		switch format.ScanType {
+		case "STATIC":
+			resp, err = client.GetStaticFindings(ctx, req)
+		case "DYNAMIC":
+			resp, err = client.GetDynamicFindings(ctx, req)
+		case "MANUAL":
+			resp, err = client.GetManualFindings(ctx, req)
+		case "SCA":
+			resp, err = client.GetScaFindings(ctx, req)
+		default:
+			return nil, fmt.Errorf("unsupported scan type %q", format.ScanType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &collectedFindings{Response: resp}, nil
+	}
+
+	// Walk every page at the maximum page size rather than the caller's page_size
+	req.Size = 0
+	summary := newFindingsSummary()
+	resp := &api.FindingsResponse{Findings: []api.Finding{}, AllPages: true}
+	for finding, err := range client.IterateFindings(ctx, req, format.ScanType) {
+		if err != nil {
+			return nil, err
+		}
+		resp.TotalCount++
+		format.Count(&summary, format.Convert(finding))
+		if len(resp.Findings) < maxResults {
+			resp.Findings = append(resp.Findings, finding)
+		} else {
+			resp.Truncated = true
+		}
+	}
+	return &collectedFindings{Response: resp, Summary: &summary}, nil
+}
+
+// newFindingsSummary returns an empty summary with every severity, status and mitigation status present
+func newFindingsSummary() MCPFindingsSummary {
+	return MCPFindingsSummary{
+		BySeverity: map[string]int{
+			"very high": 0,
+			"high":      0,
+			"medium":    0,
+			"low":       0,
+			"very low":  0,
+			"info":      0,
+		},
+		ByStatus: map[string]int{
+			"open":   0,
+			"closed": 0,
+		},
+		ByMitigation: map[string]int{
+			"none":     0,
+			"proposed": 0,
+			"approved": 0,
+			"rejected": 0,
+		},
+	}
+}
+
+// newFindingsResponse converts the collected findings into an MCP response with its summary and pagination.
+// The caller fills in the application, sandbox and policy filter.
+func newFindingsResponse(findings *collectedFindings, format findingsFormat) *MCPFindingsResponse {
+	response := &MCPFindingsResponse{Summary: newFindingsSummary(), Findings: []MCPFinding{}}
+	if findings.Summary != nil {
+		response.Summary = *findings.Summary
+	}
+
+	resp := findings.Response
+	if resp != nil {
+		// Set total_findings to the total across all pages
+		response.Summary.TotalFindings = resp.TotalCount
+		if resp.AllPages {
+			response.Truncated = resp.Truncated
+		} else if resp.Size > 0 {
+			response.Pagination = &MCPPagination{
+				CurrentPage:   resp.Page,
+				PageSize:      resp.Size,
+				TotalElements: resp.TotalCount,
+				TotalPages:    (resp.TotalCount + resp.Size - 1) / resp.Size,
+				HasNext:       (resp.Page+1)*resp.Size < resp.TotalCount,
+				HasPrevious:   resp.Page > 0,
+			}
+		}
+
+		for _, finding := range resp.Findings {
+			mcpFinding := format.Convert(finding)
+			response.Findings = append(response.Findings, mcpFinding)
+			if findings.Summary == nil {
+				format.Count(&response.Summary, mcpFinding)
+			}
+		}
+	}
+
+	if format.SortBySeverity {
+		// Sort findings by severity (Very High first)
+		sort.SliceStable(response.Findings, func(i, j int) bool {
+			return response.Findings[i].SeverityScore > response.Findings[j].SeverityScore
+		})
+	}
+	return response
+}
+
+// formatFindingsResponse formats a findings response into an MCP tool response: a sentence on what is shown,
+// then the response as JSON
+func formatFindingsResponse(ctx context.Context, response *MCPFindingsResponse, allPages bool, format findingsFormat) map[string]interface{} {
+	// Marshal response to JSON for non-UI clients
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		log.Printf("Warning: Failed to marshal response to JSON: %v", err)
+		responseJSON, _ = json.Marshal(response) // Fall back to compact JSON
+	}
+
+	// Build pagination summary for LLM
+	var paginationSummary string
+	switch {
+	case allPages && response.Truncated:
+		paginationSummary = fmt.Sprintf("Showing the first %d of %d findings (max_results reached). The summary counts cover all %d findings; raise max_results to list more.\n\n",
+			len(response.Findings),
+			response.Summary.TotalFindings,
+			response.Summary.TotalFindings,
+		)
+	case allPages:
+		paginationSummary = fmt.Sprintf("Showing %d findings from all pages (Total: %d findings)\n\n",
+			len(response.Findings),
+			response.Summary.TotalFindings,
+		)
+	case response.Pagination != nil:
+		paginationSummary = fmt.Sprintf("Showing %d findings on page %d of %d (Total: %d findings across all pages)\n\n",
+			len(response.Findings),
+			response.Pagination.CurrentPage+1, // Display as 1-based
+			response.Pagination.TotalPages,
+			response.Pagination.TotalElements,
+		)
+	default:
+		paginationSummary = fmt.Sprintf("Showing %d findings\n\n", len(response.Findings))
+	}
+
+	// Build result with content
+	result := map[string]interface{}{
+		"content": []map[string]interface{}{
+			{
+				"type": "text",
+				"text": paginationSummary + string(responseJSON),
+			},
+		},
+	}
+	if !format.StructuredContent {
+		return result
+	}
+
+	// Only include structuredContent if client supports MCP Apps UI
+	if ClientSupportsUIFromContext(ctx) {
+		log.Printf("%s: Returning %d findings (content: JSON, structuredContent: full data for UI)", format.Label, len(response.Findings))
+		result["structuredContent"] = response
+	} else {
+		log.Printf("%s: Returning %d findings (content: JSON only, no structuredContent - client doesn't support UI)", format.Label, len(response.Findings))
+	}
+	return result
+}
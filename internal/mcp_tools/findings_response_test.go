@@ -0,0 +1,68 @@
+package mcp_tools
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+// streamingFindingsClient streams its findings as every page of any scan type, then fails if err is set
+type streamingFindingsClient struct {
+	api.Client
+	findings []api.Finding
+	err      error
+	req      api.FindingsRequest
+}
+
+func (c *streamingFindingsClient) IterateFindings(ctx context.Context, req api.FindingsRequest, scanType string) iter.Seq2[api.Finding, error] {
+	c.req = req
+	return func(yield func(api.Finding, error) bool) {
+		for _, finding := range c.findings {
+			if !yield(finding, nil) {
+				return
+			}
+		}
+		if c.err != nil {
+			yield(api.Finding{}, c.err)
+		}
+	}
+}
+
+func TestCollectFindings_AllPagesCountsEveryFinding(t *testing.T) {
+	client := &streamingFindingsClient{findings: []api.Finding{
+		{ID: "1", SeverityScore: 5, Status: "OPEN", ViolatesPolicy: true},
+		{ID: "2", SeverityScore: 4, Status: "CLOSED"},
+		{ID: "3", SeverityScore: 3, Status: "OPEN", ViolatesPolicy: true},
+	}}
+
+	findings, err := collectFindings(context.Background(), client, api.FindingsRequest{Size: 10}, true, 2, dynamicFindingsFormat)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if client.req.Size != 0 {
+		t.Errorf("Expected every page to be walked at the maximum page size, got size %d", client.req.Size)
+	}
+	if len(findings.Response.Findings) != 2 || !findings.Response.Truncated || findings.Response.TotalCount != 3 {
+		t.Errorf("Expected the first 2 of 3 findings, truncated, got %d of %d (truncated %v)",
+			len(findings.Response.Findings), findings.Response.TotalCount, findings.Response.Truncated)
+	}
+
+	response := newFindingsResponse(findings, dynamicFindingsFormat)
+	if response.Summary.TotalFindings != 3 || response.Summary.OpenFindings != 2 || response.Summary.PolicyViolations != 2 {
+		t.Errorf("Expected the summary to count all 3 findings, got %+v", response.Summary)
+	}
+	if len(response.Findings) != 2 || !response.Truncated {
+		t.Errorf("Expected only the first 2 findings listed, got %d", len(response.Findings))
+	}
+}
+
+func TestCollectFindings_AllPagesError(t *testing.T) {
+	client := &streamingFindingsClient{findings: []api.Finding{{ID: "1"}}, err: errors.New("service unavailable")}
+
+	if _, err := collectFindings(context.Background(), client, api.FindingsRequest{}, true, 10, scaFindingsFormat); err == nil {
+		t.Fatal("Expected the page error to be returned")
+	}
+}
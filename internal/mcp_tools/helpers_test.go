@@ -0,0 +1,13 @@
+package mcp_tools
+
+import "time"
+
+// Pointer helpers for building API models in tests
+
+func stringPtr(s string) *string { return &s }
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func boolPtr(b bool) *bool { return &b }
+
+func timePtr(t time.Time) *time.Time { return &t }
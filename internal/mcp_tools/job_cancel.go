@@ -0,0 +1,31 @@
+package mcp_tools
+
+import (
+	"context"
+)
+
+const JobCancelToolName = "job-cancel"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(JobCancelToolName, handleJobCancel)
+}
+
+// handleJobCancel stops a queued or running background job
+func handleJobCancel(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseJobRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	job, err := backgroundJobs.cancel(req.ApplicationPath, req.JobID)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+	return formatJobToolResponse(describeJob(job), job), nil
+}
@@ -0,0 +1,106 @@
+package mcp_tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const JobListToolName = "job-list"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(JobListToolName, handleJobList)
+}
+
+// JobListRequest represents the parsed parameters for job-list
+type JobListRequest struct {
+	ApplicationPath string
+	State           string // Lower case job state; empty lists every state
+	Kind            string // Tool that started the jobs; empty lists every tool
+	Limit           int
+}
+
+// MCPJobList lists the jobs of a workspace, newest first
+type MCPJobList struct {
+	ApplicationPath string `json:"application_path"`
+	Total           int    `json:"total"`
+	Jobs            []Job  `json:"jobs"`
+}
+
+// parseJobListRequest extracts and validates parameters from the raw args map
+func parseJobListRequest(args map[string]interface{}) (*JobListRequest, error) {
+	req := &JobListRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := extractOptionalEnum(args, "state", []string{"QUEUED", "RUNNING", "SUCCEEDED", "FAILED", "CANCELLED"})
+	if err != nil {
+		return nil, err
+	}
+	req.State = strings.ToLower(state)
+	req.Kind, _ = extractOptionalString(args, "kind")
+
+	req.Limit = extractInt(args, "limit", 20)
+	if err := validateIntRange(req.Limit, 1, maxJobRecords, "limit"); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// handleJobList lists the background jobs of a workspace
+func handleJobList(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseJobListRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	jobs, err := backgroundJobs.list(req.ApplicationPath)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	response := &MCPJobList{ApplicationPath: req.ApplicationPath, Jobs: []Job{}}
+	counts := map[string]int{}
+	for _, job := range jobs {
+		if (req.State != "" && job.State != req.State) || (req.Kind != "" && job.Kind != req.Kind) {
+			continue
+		}
+		response.Total++
+		counts[job.State]++
+		if len(response.Jobs) < req.Limit {
+			response.Jobs = append(response.Jobs, job)
+		}
+	}
+
+	return formatJobToolResponse(jobListSummary(response, counts), response), nil
+}
+
+// jobListSummary describes the listed jobs in a sentence
+func jobListSummary(response *MCPJobList, counts map[string]int) string {
+	if response.Total == 0 {
+		return fmt.Sprintf("No jobs found for %s.", response.ApplicationPath)
+	}
+
+	var parts []string
+	for _, state := range []string{JobQueued, JobRunning, JobSucceeded, JobFailed, JobCancelled} {
+		if counts[state] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[state], state))
+		}
+	}
+	summary := fmt.Sprintf("%d job(s) for %s: %s.", response.Total, response.ApplicationPath, strings.Join(parts, ", "))
+	if len(response.Jobs) < response.Total {
+		summary += fmt.Sprintf(" Showing the newest %d.", len(response.Jobs))
+	}
+	return summary
+}
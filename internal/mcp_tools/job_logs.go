@@ -0,0 +1,76 @@
+package mcp_tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const JobLogsToolName = "job-logs"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(JobLogsToolName, handleJobLogs)
+}
+
+// JobLogsRequest represents the parsed parameters for job-logs
+type JobLogsRequest struct {
+	JobRequest
+	TailLines int
+}
+
+// parseJobLogsRequest extracts and validates parameters from the raw args map
+func parseJobLogsRequest(args map[string]interface{}) (*JobLogsRequest, error) {
+	jobReq, err := parseJobRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &JobLogsRequest{JobRequest: *jobReq}
+	req.TailLines = extractInt(args, "tail_lines", 100)
+	if err := validateIntRange(req.TailLines, 1, 5000, "tail_lines"); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// handleJobLogs shows the end of a background job's log file
+func handleJobLogs(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseJobLogsRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	job, err := backgroundJobs.get(req.ApplicationPath, req.JobID)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	lines, total, err := tailFileLines(job.LogFile, req.TailLines)
+	var text string
+	switch {
+	case os.IsNotExist(err) && job.State == JobQueued:
+		text = fmt.Sprintf("Job %s is queued and has no log yet.", job.ID)
+	case err != nil:
+		return map[string]interface{}{
+			"error": fmt.Sprintf("Failed to read log file of job %s: %v", job.ID, err),
+		}, nil
+	default:
+		text = fmt.Sprintf("Job %s (%s)\nLog File: %s\nShowing the last %d of %d lines\n\n%s",
+			job.ID, job.State, job.LogFile, len(lines), total, strings.Join(lines, "\n"))
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": text,
+		}},
+	}, nil
+}
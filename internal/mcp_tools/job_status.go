@@ -0,0 +1,137 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const JobStatusToolName = "job-status"
+
+// jobStatusLogLines is how many log lines job-status shows for a failed job
+const jobStatusLogLines = 20
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(JobStatusToolName, handleJobStatus)
+}
+
+// JobRequest represents the parsed parameters of the tools that act on one job
+type JobRequest struct {
+	ApplicationPath string
+	JobID           string
+}
+
+// parseJobRequest extracts and validates the application path and job ID from the raw args map
+func parseJobRequest(args map[string]interface{}) (*JobRequest, error) {
+	req := &JobRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+	req.JobID, err = extractRequiredString(args, "job_id")
+	if err != nil {
+		return nil, err
+	}
+	req.JobID = strings.TrimSpace(req.JobID)
+	if err := validateJobID(req.JobID); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// handleJobStatus reports the state of a background job and, once it has finished, what its exit code means
+func handleJobStatus(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseJobRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	job, err := backgroundJobs.get(req.ApplicationPath, req.JobID)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	summary := describeJob(job)
+	if job.State == JobFailed {
+		if lines, total, err := tailFileLines(job.LogFile, jobStatusLogLines); err == nil && total > 0 {
+			summary += fmt.Sprintf("\n\nLast %d lines of %s:\n%s", len(lines), job.LogFile, strings.Join(lines, "\n"))
+		}
+	}
+	return formatJobToolResponse(summary, job), nil
+}
+
+// describeJob describes the state of a job in a sentence or two
+func describeJob(job *Job) string {
+	name := fmt.Sprintf("Job %s (%s)", job.ID, job.Kind)
+	switch job.State {
+	case JobQueued:
+		if job.After != "" {
+			return fmt.Sprintf("%s is queued until job %s finishes.", name, job.After)
+		}
+		return fmt.Sprintf("%s is queued until other jobs finish.", name)
+	case JobRunning:
+		return fmt.Sprintf("%s has been running since %s (PID %d). Check again later; job-logs shows its progress.", name, job.StartedAt, job.PID)
+	case JobSucceeded:
+		summary := fmt.Sprintf("%s succeeded. %s", name, job.Result)
+		if job.NextSteps != "" {
+			summary += "\n\n" + job.NextSteps
+		}
+		return summary
+	case JobFailed:
+		summary := fmt.Sprintf("%s failed.", name)
+		if job.Result != "" {
+			summary += " " + job.Result
+		}
+		if job.Error != "" {
+			summary += " " + job.Error
+		}
+		if job.NextSteps != "" {
+			summary += "\n\n" + job.NextSteps
+		}
+		return summary
+	case JobCancelled:
+		return fmt.Sprintf("%s was cancelled. %s", name, job.Error)
+	default:
+		return fmt.Sprintf("%s is %s.", name, job.State)
+	}
+}
+
+// tailFileLines returns the last n lines of a file and its total number of lines
+func tailFileLines(path string, n int) ([]string, int, error) {
+	// #nosec G304 -- log files are in the Veracode work directory, named by the job record
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return nil, 0, nil
+	}
+	return lines[max(0, len(lines)-n):], len(lines), nil
+}
+
+// formatJobToolResponse formats a summary and the job data into an MCP tool response
+func formatJobToolResponse(summary string, data interface{}) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format job response: %v", err)}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
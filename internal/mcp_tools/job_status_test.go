@@ -0,0 +1,95 @@
+package mcp_tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// savedJobsWorkspace returns an application with finished jobs recorded in its work directory
+func savedJobsWorkspace(t *testing.T) (string, *Job) {
+	t.Helper()
+	t.Setenv(workDirEnvVar, t.TempDir())
+	appPath := t.TempDir()
+
+	logFile := filepath.Join(appPath, "scan.log")
+	if err := os.WriteFile(logFile, []byte("Command: veracode static scan app.jar\n\nline 1\nline 2\nScan failed\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	exitCode := 1
+	failed := &Job{ID: "pipeline-scan-20261019-101500-a1b2", Kind: PipelineScanToolName, ApplicationPath: appPath, State: JobFailed,
+		CreatedAt: "2026-10-19T10:15:00Z", ExitCode: &exitCode, Result: "❌ Generic error occurred", LogFile: logFile}
+	succeeded := &Job{ID: "package-workspace-20261019-101000-c3d4", Kind: PackageWorkspaceToolName, ApplicationPath: appPath, State: JobSucceeded,
+		CreatedAt: "2026-10-19T10:10:00Z"}
+	for _, job := range []*Job{failed, succeeded} {
+		if err := saveJobRecord(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return appPath, failed
+}
+
+func jobToolText(t *testing.T, result interface{}) string {
+	t.Helper()
+	resultMap := result.(map[string]interface{})
+	if resultMap["error"] != nil {
+		t.Fatalf("unexpected error: %v", resultMap["error"])
+	}
+	return resultMap["content"].([]map[string]interface{})[0]["text"].(string)
+}
+
+func TestParseJobRequest(t *testing.T) {
+	if _, err := parseJobRequest(map[string]interface{}{"application_path": "/work/app"}); err == nil {
+		t.Error("expected error for missing job_id")
+	}
+	if _, err := parseJobRequest(map[string]interface{}{"application_path": "/work/app", "job_id": "../jobs"}); err == nil {
+		t.Error("expected error for invalid job_id")
+	}
+}
+
+func TestJobStatusTool_FailedJobShowsLog(t *testing.T) {
+	appPath, failed := savedJobsWorkspace(t)
+
+	result, _ := handleJobStatus(context.Background(), map[string]interface{}{"application_path": appPath, "job_id": failed.ID})
+	text := jobToolText(t, result)
+	if !strings.Contains(text, "failed. ❌ Generic error occurred") || !strings.Contains(text, "Scan failed") {
+		t.Errorf("unexpected status:\n%s", text)
+	}
+}
+
+func TestJobListTool(t *testing.T) {
+	appPath, _ := savedJobsWorkspace(t)
+
+	result, _ := handleJobList(context.Background(), map[string]interface{}{"application_path": appPath})
+	text := jobToolText(t, result)
+	if !strings.Contains(text, "2 job(s)") || strings.Index(text, "pipeline-scan-2026") > strings.Index(text, "package-workspace-2026") {
+		t.Errorf("expected both jobs, newest first:\n%s", text)
+	}
+
+	result, _ = handleJobList(context.Background(), map[string]interface{}{"application_path": appPath, "state": "succeeded"})
+	text = jobToolText(t, result)
+	if !strings.Contains(text, "1 job(s)") || strings.Contains(text, "pipeline-scan-2026") {
+		t.Errorf("expected only the succeeded job:\n%s", text)
+	}
+}
+
+func TestJobLogsTool(t *testing.T) {
+	appPath, failed := savedJobsWorkspace(t)
+
+	result, _ := handleJobLogs(context.Background(), map[string]interface{}{"application_path": appPath, "job_id": failed.ID, "tail_lines": float64(2)})
+	text := jobToolText(t, result)
+	if !strings.Contains(text, "last 2 of 5 lines") || !strings.HasSuffix(text, "line 2\nScan failed") {
+		t.Errorf("unexpected logs:\n%s", text)
+	}
+}
+
+func TestJobCancelTool_FinishedJob(t *testing.T) {
+	appPath, failed := savedJobsWorkspace(t)
+
+	result, _ := handleJobCancel(context.Background(), map[string]interface{}{"application_path": appPath, "job_id": failed.ID})
+	if result.(map[string]interface{})["error"] == nil {
+		t.Error("expected an error cancelling a finished job")
+	}
+}
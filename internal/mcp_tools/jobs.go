@@ -0,0 +1,642 @@
+package mcp_tools
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Job states
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// maxRunningJobs is how many Veracode CLI processes run at once; further jobs wait in the queue
+const maxRunningJobs = 4
+
+// maxJobRecords is how many job records are kept per workspace; the oldest finished ones are deleted
+const maxJobRecords = 50
+
+// jobCancelTimeout is how long job-cancel waits for a killed process to exit
+const jobCancelTimeout = 5 * time.Second
+
+// jobIDPattern matches the IDs created by newJobID, e.g. pipeline-scan-20261019-101500-a1b2
+var jobIDPattern = regexp.MustCompile(`^[a-z-]+-\d{8}-\d{6}-[0-9a-f]{4}$`)
+
+// Job is the persisted record of a long-running Veracode CLI command
+type Job struct {
+	ID              string `json:"id"`
+	Kind            string `json:"kind"` // Tool that started the job
+	ApplicationPath string `json:"application_path"`
+	State           string `json:"state"`
+	After           string `json:"after,omitempty"` // Job that must succeed before this one starts
+	Command         string `json:"command,omitempty"`
+	PID             int    `json:"pid,omitempty"`
+	Executable      string `json:"executable,omitempty"` // Program the process runs, to recognise it after a restart
+	CreatedAt       string `json:"created_at"`
+	StartedAt       string `json:"started_at,omitempty"`
+	EndedAt         string `json:"ended_at,omitempty"`
+	ExitCode        *int   `json:"exit_code,omitempty"`
+	Result          string `json:"result,omitempty"` // What the exit code means
+	NextSteps       string `json:"next_steps,omitempty"`
+	Error           string `json:"error,omitempty"`
+	LogFile         string `json:"log_file"`
+	OutputDir       string `json:"output_dir,omitempty"`
+	ResultFile      string `json:"result_file,omitempty"` // File the command writes when it succeeds
+}
+
+// isActive reports whether the job is still queued or running
+func (j *Job) isActive() bool {
+	return j.State == JobQueued || j.State == JobRunning
+}
+
+// jobSpec describes a job to submit
+type jobSpec struct {
+	Kind            string
+	ApplicationPath string
+	LogFile         string
+	OutputDir       string
+	ResultFile      string
+	After           string // ID of a job in the same workspace that must succeed first
+	Exclusive       bool   // Wait for other jobs of the same kind in the workspace, which share output files
+
+	// Prepare runs when the job leaves the queue and returns the veracode CLI arguments
+	Prepare func() ([]string, error)
+	// NextSteps describes what to do after the command exits; defaults to InterpretVeracodeExitCode's
+	NextSteps func(exitCode int) string
+	// Cleanup runs when the job fails or is cancelled before its process starts, to remove what was set up for it
+	Cleanup func()
+}
+
+// managedJob is a job submitted to this server
+type managedJob struct {
+	record    Job
+	spec      jobSpec
+	process   *os.Process
+	starting  bool // Taken off the queue and being started outside the lock
+	cancelled bool
+	done      chan struct{} // Closed once the job has finished
+}
+
+// jobManager runs jobs in the background, persisting their state in each workspace's jobs directory.
+// Jobs submitted before the server restarted are read back from disk.
+type jobManager struct {
+	mu      sync.Mutex
+	command string
+	jobs    map[string]*managedJob
+	queue   []*managedJob // Jobs waiting to start, oldest first
+}
+
+func newJobManager(command string) *jobManager {
+	return &jobManager{command: command, jobs: make(map[string]*managedJob)}
+}
+
+// backgroundJobs runs the jobs of the package-workspace, pipeline-scan and local-sca-scan tools
+var backgroundJobs = newJobManager("veracode")
+
+// newJobID returns a unique, sortable job ID for a tool
+func newJobID(kind string) string {
+	suffix := make([]byte, 2)
+	_, _ = rand.Read(suffix) // Distinguishes jobs started in the same second; never fails on supported platforms
+	return fmt.Sprintf("%s-%s-%s", kind, time.Now().Format(pipelineRunTimestamp), hex.EncodeToString(suffix))
+}
+
+// validateJobID rejects IDs that aren't job IDs, which also keeps them from escaping the jobs directory
+func validateJobID(id string) error {
+	if !jobIDPattern.MatchString(id) {
+		return fmt.Errorf("invalid job_id %q; use an ID returned by job-list or the tool that started the job", id)
+	}
+	return nil
+}
+
+// jobsDir returns the directory holding a workspace's job records
+func jobsDir(applicationPath string) string {
+	return veracodeWorkDir(applicationPath, "jobs")
+}
+
+// jobRecordPath returns the location of a job record
+func jobRecordPath(applicationPath, id string) string {
+	return filepath.Join(jobsDir(applicationPath), id+".json")
+}
+
+// saveJobRecord writes a job record to its workspace
+func saveJobRecord(job *Job) error {
+	if _, err := createVeracodeWorkDir(job.ApplicationPath, "jobs"); err != nil {
+		return fmt.Errorf("failed to create jobs directory: %w", err)
+	}
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(jobRecordPath(job.ApplicationPath, job.ID), data, 0600)
+}
+
+// loadJobRecord reads a job record from its workspace
+func loadJobRecord(applicationPath, id string) (*Job, error) {
+	// #nosec G304 -- the path is built from the work directory and a validated job ID
+	data, err := os.ReadFile(jobRecordPath(applicationPath, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("job %s not found for %s", id, applicationPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job %s: %w", id, err)
+	}
+
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("failed to parse job %s: %w", id, err)
+	}
+	return &job, nil
+}
+
+// submit queues a job and starts it if it can run now. The returned copy shows
+// whether it started, is queued, or failed to start.
+func (m *jobManager) submit(spec jobSpec) (*Job, error) {
+	job := &managedJob{
+		record: Job{
+			ID:              newJobID(spec.Kind),
+			Kind:            spec.Kind,
+			ApplicationPath: spec.ApplicationPath,
+			State:           JobQueued,
+			After:           spec.After,
+			CreatedAt:       time.Now().UTC().Format(time.RFC3339),
+			LogFile:         spec.LogFile,
+			OutputDir:       spec.OutputDir,
+			ResultFile:      spec.ResultFile,
+		},
+		spec: spec,
+		done: make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	if err := saveJobRecord(&job.record); err != nil {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("failed to save job: %w", err)
+	}
+	m.jobs[job.record.ID] = job
+	m.queue = append(m.queue, job)
+	ready := m.scheduleLocked()
+	m.mu.Unlock()
+
+	m.start(ready)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	record := job.record
+	return &record, nil
+}
+
+// scheduleLocked takes the queued jobs that can run off the queue, in the order they were submitted,
+// and returns them to be started with start once the lock is released
+func (m *jobManager) scheduleLocked() []*managedJob {
+	var ready []*managedJob
+	waiting := m.queue[:0]
+	for _, job := range m.queue {
+		if job.record.State != JobQueued {
+			continue // Cancelled while queued
+		}
+		canStart, failure := m.canStartLocked(job)
+		switch {
+		case failure != "":
+			m.finishLocked(job, JobFailed, nil, failure)
+		case canStart:
+			job.starting = true
+			ready = append(ready, job)
+		default:
+			waiting = append(waiting, job)
+		}
+	}
+	m.queue = waiting
+	return ready
+}
+
+// canStartLocked reports whether a queued job can start, or why it never will
+func (m *jobManager) canStartLocked(job *managedJob) (bool, string) {
+	running := 0
+	for _, other := range m.jobs {
+		if other.record.State != JobRunning && !other.starting {
+			continue
+		}
+		running++
+		if job.spec.Exclusive && other.record.Kind == job.record.Kind && other.record.ApplicationPath == job.record.ApplicationPath {
+			return false, ""
+		}
+	}
+	if running >= maxRunningJobs {
+		return false, ""
+	}
+
+	if job.record.After != "" {
+		after, err := m.getLocked(job.record.ApplicationPath, job.record.After)
+		switch {
+		case err != nil:
+			log.Printf("Warning: %v; starting job %s without waiting for it", err, job.record.ID)
+		case after.isActive():
+			return false, ""
+		case after.State != JobSucceeded:
+			return false, fmt.Sprintf("job %s, which this job waits for, %s", after.ID, after.State)
+		}
+	}
+	return true, ""
+}
+
+// start prepares the jobs taken off the queue and starts their processes. Preparing a job can take a
+// while, so it runs without the lock, which is only taken to record the outcome.
+func (m *jobManager) start(jobs []*managedJob) {
+	for _, job := range jobs {
+		cmd, logFile, err := m.startProcess(job)
+
+		m.mu.Lock()
+		job.starting = false
+		if err != nil {
+			if job.cancelled {
+				m.finishLocked(job, JobCancelled, nil, "cancelled before it started")
+			} else {
+				m.finishLocked(job, JobFailed, nil, err.Error())
+			}
+			ready := m.scheduleLocked()
+			m.mu.Unlock()
+			m.start(ready)
+			continue
+		}
+
+		job.process = cmd.Process
+		job.record.State = JobRunning
+		job.record.Command = fmt.Sprintf("veracode %s", strings.Join(cmd.Args[1:], " "))
+		job.record.PID = cmd.Process.Pid
+		job.record.Executable = filepath.Base(cmd.Path)
+		job.record.StartedAt = time.Now().UTC().Format(time.RFC3339)
+		if err := saveJobRecord(&job.record); err != nil {
+			log.Printf("Warning: Failed to save job %s: %v", job.record.ID, err)
+		}
+		if job.cancelled {
+			// Cancelled while it was being started; wait records it as cancelled
+			_ = job.process.Kill()
+		}
+		m.mu.Unlock()
+
+		go m.wait(job, cmd, logFile)
+	}
+}
+
+// startProcess prepares a job and starts its process, writing its output to the job's log file
+func (m *jobManager) startProcess(job *managedJob) (*exec.Cmd, *os.File, error) {
+	args, err := job.spec.Prepare()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// #nosec G304 -- the log file is in the tool's work directory
+	logFile, err := os.Create(job.spec.LogFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create log file: %w", err)
+	}
+	fmt.Fprintf(logFile, "Command: veracode %s\n\n", strings.Join(args, " "))
+
+	// The process outlives the request that started it, so it isn't tied to the request's context
+	// #nosec G204 -- the command is fixed, only arguments are user-controlled and validated
+	cmd := exec.Command(m.command, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		_ = logFile.Close()
+		return nil, nil, fmt.Errorf("failed to start veracode: %w", err)
+	}
+	return cmd, logFile, nil
+}
+
+// wait records the exit of a job's process and starts the jobs waiting for it
+func (m *jobManager) wait(job *managedJob, cmd *exec.Cmd, logFile *os.File) {
+	err := cmd.Wait()
+	_ = logFile.Close()
+
+	exitCode := 0
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		exitCode = -1
+	}
+
+	m.mu.Lock()
+	switch {
+	case job.cancelled:
+		// The exit code of a killed process says nothing about the command
+		m.finishLocked(job, JobCancelled, nil, "")
+	case exitCode != 0 && !InterpretVeracodeExitCode(exitCode).IsWarning:
+		m.finishLocked(job, JobFailed, &exitCode, "")
+	default:
+		m.finishLocked(job, JobSucceeded, &exitCode, "")
+	}
+	ready := m.scheduleLocked()
+	m.mu.Unlock()
+
+	m.start(ready)
+}
+
+// finishLocked records the end of a job
+func (m *jobManager) finishLocked(job *managedJob, state string, exitCode *int, failure string) {
+	if job.process == nil && state != JobSucceeded && job.spec.Cleanup != nil {
+		job.spec.Cleanup()
+	}
+
+	job.record.State = state
+	job.record.EndedAt = time.Now().UTC().Format(time.RFC3339)
+	job.record.ExitCode = exitCode
+	job.record.Error = failure
+	if exitCode != nil {
+		info := InterpretVeracodeExitCode(*exitCode)
+		job.record.Result = fmt.Sprintf("%s %s", info.Icon, info.Message)
+		job.record.NextSteps = info.NextSteps
+		if job.spec.NextSteps != nil {
+			job.record.NextSteps = job.spec.NextSteps(*exitCode)
+		}
+	}
+
+	// Finished jobs are read back from their record, so only keep the ones whose record couldn't be saved
+	if err := saveJobRecord(&job.record); err != nil {
+		log.Printf("Warning: Failed to save job %s: %v", job.record.ID, err)
+	} else {
+		delete(m.jobs, job.record.ID)
+	}
+	pruneJobRecords(job.record.ApplicationPath)
+	close(job.done)
+}
+
+// get returns a job of a workspace
+func (m *jobManager) get(applicationPath, id string) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.getLocked(applicationPath, id)
+}
+
+func (m *jobManager) getLocked(applicationPath, id string) (*Job, error) {
+	if job, ok := m.jobs[id]; ok {
+		record := job.record
+		return &record, nil
+	}
+	job, err := loadJobRecord(applicationPath, id)
+	if err != nil {
+		return nil, err
+	}
+	reconcileJobRecord(job)
+	return job, nil
+}
+
+// list returns the jobs of a workspace, newest first
+func (m *jobManager) list(applicationPath string) ([]Job, error) {
+	entries, err := os.ReadDir(jobsDir(applicationPath))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jobs directory: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var jobs []Job
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || validateJobID(id) != nil {
+			continue
+		}
+		job, err := m.getLocked(applicationPath, id)
+		if err != nil {
+			log.Printf("Warning: %v", err)
+			continue
+		}
+		jobs = append(jobs, *job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].CreatedAt != jobs[j].CreatedAt {
+			return jobs[i].CreatedAt > jobs[j].CreatedAt
+		}
+		return jobs[i].ID > jobs[j].ID
+	})
+	return jobs, nil
+}
+
+// latest returns the newest job of a kind in a workspace, or nil if there is none
+func (m *jobManager) latest(applicationPath, kind string) *Job {
+	jobs, err := m.list(applicationPath)
+	if err != nil {
+		log.Printf("Warning: %v", err)
+	}
+	for i := range jobs {
+		if jobs[i].Kind == kind {
+			return &jobs[i]
+		}
+	}
+	return nil
+}
+
+// cancel stops a queued or running job
+func (m *jobManager) cancel(applicationPath, id string) (*Job, error) {
+	m.mu.Lock()
+	job, ok := m.jobs[id]
+	if !ok {
+		defer m.mu.Unlock()
+		return cancelJobRecord(applicationPath, id)
+	}
+
+	var ready []*managedJob
+	switch {
+	case job.starting:
+		// start kills the process once it has started
+		job.cancelled = true
+	case job.record.State == JobQueued:
+		m.finishLocked(job, JobCancelled, nil, "cancelled before it started")
+		ready = m.scheduleLocked()
+	case job.record.State == JobRunning:
+		job.cancelled = true
+		if err := job.process.Kill(); err != nil {
+			m.mu.Unlock()
+			return nil, fmt.Errorf("failed to stop job %s: %w", id, err)
+		}
+	default:
+		m.mu.Unlock()
+		return nil, fmt.Errorf("job %s has already %s", id, job.record.State)
+	}
+	m.mu.Unlock()
+	m.start(ready)
+
+	select {
+	case <-job.done:
+	case <-time.After(jobCancelTimeout):
+		log.Printf("Warning: Job %s did not exit within %v of being killed", id, jobCancelTimeout)
+	}
+	return m.get(applicationPath, id)
+}
+
+// cancelJobRecord cancels a job submitted before the server restarted, killing its process if it still runs.
+// reconcileJobRecord has checked the PID still belongs to the job, so an unrelated process is never killed.
+func cancelJobRecord(applicationPath, id string) (*Job, error) {
+	job, err := loadJobRecord(applicationPath, id)
+	if err != nil {
+		return nil, err
+	}
+	reconcileJobRecord(job)
+	if !job.isActive() {
+		return nil, fmt.Errorf("job %s has already %s", id, job.State)
+	}
+
+	if job.State == JobRunning {
+		process, err := os.FindProcess(job.PID)
+		if err == nil {
+			err = process.Kill()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to stop job %s (PID %d): %w", id, job.PID, err)
+		}
+	}
+	job.State = JobCancelled
+	job.EndedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := saveJobRecord(job); err != nil {
+		return nil, fmt.Errorf("failed to save job %s: %w", id, err)
+	}
+	return job, nil
+}
+
+// reconcileJobRecord settles a job submitted before the server restarted: queued jobs were lost with
+// the server, and running jobs whose process has gone finished without their exit code being seen
+func reconcileJobRecord(job *Job) {
+	switch job.State {
+	case JobQueued:
+		job.State = JobCancelled
+		job.Error = "the server stopped before the job started"
+	case JobRunning:
+		if jobProcessRunning(job) {
+			return
+		}
+		job.State = JobFailed
+		job.Error = "the server stopped while the job ran, so its exit code is unknown; check the log file"
+		if job.ResultFile != "" {
+			if _, err := os.Stat(job.ResultFile); err == nil {
+				job.State = JobSucceeded
+				job.Error = ""
+				job.Result = "The server stopped while the job ran, so its exit code is unknown, but it wrote its results"
+			}
+		}
+	default:
+		return
+	}
+
+	job.EndedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := saveJobRecord(job); err != nil {
+		log.Printf("Warning: Failed to save job %s: %v", job.ID, err)
+	}
+}
+
+// jobProcessRunning reports whether a job's process still runs. A PID reused by another program
+// after the job's process exited doesn't count, nor does one whose program can't be checked.
+func jobProcessRunning(job *Job) bool {
+	if running, _ := checkProcessStatus(job.PID); !running {
+		return false
+	}
+
+	executable := job.Executable
+	if executable == "" {
+		executable = "veracode" // Every job runs the Veracode CLI
+	}
+	name, err := processExecutable(job.PID)
+	if err != nil {
+		log.Printf("Warning: Cannot check which program PID %d of job %s runs: %v", job.PID, job.ID, err)
+		return false
+	}
+	return sameExecutable(name, executable)
+}
+
+// processExecutable returns the program a process runs
+func processExecutable(pid int) (string, error) {
+	if runtime.GOOS == "windows" {
+		// #nosec G204 -- the arguments are a fixed filter and a PID
+		out, err := exec.Command("tasklist", "/FI", fmt.Sprintf("PID eq %d", pid), "/FO", "CSV", "/NH").Output()
+		if err != nil {
+			return "", err
+		}
+		// "veracode.exe","1234","Console","1","12,345 K"
+		name, _, found := strings.Cut(strings.TrimSpace(string(out)), ",")
+		if !found {
+			return "", fmt.Errorf("no process with PID %d", pid)
+		}
+		return strings.Trim(name, `"`), nil
+	}
+
+	// #nosec G204 -- the only argument is a PID
+	out, err := exec.Command("ps", "-o", "comm=", "-p", strconv.Itoa(pid)).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// sameExecutable compares a process's program name with the program a job started. Linux reports at
+// most 15 characters of the name and macOS the full path, and Windows adds .exe.
+func sameExecutable(processName, executable string) bool {
+	normalize := func(name string) string {
+		return strings.TrimSuffix(strings.ToLower(filepath.Base(name)), ".exe")
+	}
+	processName, executable = normalize(processName), normalize(executable)
+	if processName == "" {
+		return false
+	}
+	return processName == executable || (len(processName) == 15 && strings.HasPrefix(executable, processName))
+}
+
+// pruneJobRecords deletes the oldest finished job records beyond maxJobRecords
+func pruneJobRecords(applicationPath string) {
+	entries, err := os.ReadDir(jobsDir(applicationPath))
+	if err != nil || len(entries) <= maxJobRecords {
+		return
+	}
+
+	// Job IDs start with the tool name, so order the records by the timestamp that follows it
+	sort.Slice(entries, func(i, j int) bool {
+		return jobIDTime(entries[i].Name()) < jobIDTime(entries[j].Name())
+	})
+	excess := len(entries) - maxJobRecords
+	for _, entry := range entries {
+		if excess == 0 {
+			break
+		}
+		id, _ := strings.CutSuffix(entry.Name(), ".json")
+		job, err := loadJobRecord(applicationPath, id)
+		if err == nil && job.isActive() {
+			continue
+		}
+		if err := os.Remove(filepath.Join(jobsDir(applicationPath), entry.Name())); err != nil {
+			log.Printf("Warning: Failed to remove old job record %s: %v", entry.Name(), err)
+			continue
+		}
+		excess--
+	}
+}
+
+// jobIDTime returns the timestamp and suffix of a job ID or record file name
+func jobIDTime(name string) string {
+	name, _ = strings.CutSuffix(name, ".json")
+	if len(name) < len("20060102-150405-0000") {
+		return name
+	}
+	return name[len(name)-len("20060102-150405-0000"):]
+}
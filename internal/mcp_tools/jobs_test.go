@@ -0,0 +1,308 @@
+package mcp_tools
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestJobHelperProcess stands in for the Veracode CLI: it sleeps for the given milliseconds and exits with the given code
+func TestJobHelperProcess(t *testing.T) {
+	if os.Getenv("VERACODE_MCP_JOB_HELPER") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	exitCode, _ := strconv.Atoi(args[1])
+	sleep, _ := strconv.Atoi(args[2])
+	time.Sleep(time.Duration(sleep) * time.Millisecond)
+	os.Exit(exitCode)
+}
+
+// newTestJobManager returns a job manager that runs TestJobHelperProcess instead of the Veracode CLI
+func newTestJobManager(t *testing.T) *jobManager {
+	t.Helper()
+	t.Setenv("VERACODE_MCP_JOB_HELPER", "1")
+	t.Setenv(workDirEnvVar, t.TempDir())
+	return newJobManager(os.Args[0])
+}
+
+// testJobSpec describes a job of the helper process exiting with exitCode after sleepMs
+func testJobSpec(appPath, kind string, exitCode, sleepMs int) jobSpec {
+	return jobSpec{
+		Kind:            kind,
+		ApplicationPath: appPath,
+		LogFile:         filepath.Join(appPath, kind+"-"+strconv.Itoa(exitCode)+"-"+strconv.Itoa(sleepMs)+".log"),
+		Prepare: func() ([]string, error) {
+			return []string{"-test.run=TestJobHelperProcess", "--", strconv.Itoa(exitCode), strconv.Itoa(sleepMs)}, nil
+		},
+	}
+}
+
+// waitForJob waits for a job submitted to m to finish and returns its record
+func waitForJob(t *testing.T, m *jobManager, appPath, id string) *Job {
+	t.Helper()
+	m.mu.Lock()
+	job, ok := m.jobs[id]
+	m.mu.Unlock()
+	if ok {
+		select {
+		case <-job.done:
+		case <-time.After(30 * time.Second):
+			t.Fatalf("job %s did not finish", id)
+		}
+	}
+	record, err := m.get(appPath, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+func TestJobManager_InterpretsExitCode(t *testing.T) {
+	m := newTestJobManager(t)
+	appPath := t.TempDir()
+
+	spec := testJobSpec(appPath, "local-sca-scan", 3, 0)
+	spec.NextSteps = func(exitCode int) string { return "Review the policy results" }
+	warning, err := m.submit(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	failure, err := m.submit(testJobSpec(appPath, "package-workspace", 2, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Exit code 3 (policy failure) is a warning, so the job succeeded
+	job := waitForJob(t, m, appPath, warning.ID)
+	if job.State != JobSucceeded || *job.ExitCode != 3 || !strings.Contains(job.Result, "did not pass policy") || job.NextSteps != "Review the policy results" {
+		t.Errorf("unexpected job: %+v", job)
+	}
+	if job.StartedAt == "" || job.EndedAt == "" || job.PID == 0 {
+		t.Errorf("start and end should be recorded: %+v", job)
+	}
+
+	job = waitForJob(t, m, appPath, failure.ID)
+	if job.State != JobFailed || *job.ExitCode != 2 || !strings.Contains(job.Result, "Parser error") {
+		t.Errorf("unexpected job: %+v", job)
+	}
+
+	// The records are persisted in the workspace
+	saved, err := loadJobRecord(appPath, warning.ID)
+	if err != nil || saved.State != JobSucceeded {
+		t.Errorf("loadJobRecord() = %+v, %v", saved, err)
+	}
+}
+
+func TestJobManager_AfterWaitsForJob(t *testing.T) {
+	m := newTestJobManager(t)
+	appPath := t.TempDir()
+
+	packaging, _ := m.submit(testJobSpec(appPath, "package-workspace", 0, 300))
+	spec := testJobSpec(appPath, "pipeline-scan", 0, 0)
+	spec.After = packaging.ID
+	scan, _ := m.submit(spec)
+	if scan.State != JobQueued {
+		t.Fatalf("the scan should wait for packaging, got %s", scan.State)
+	}
+	if job := waitForJob(t, m, appPath, scan.ID); job.State != JobSucceeded {
+		t.Errorf("the scan should run after packaging, got %+v", job)
+	}
+
+	failedPackaging, _ := m.submit(testJobSpec(appPath, "package-workspace", 1, 300))
+	spec.After = failedPackaging.ID
+	scan, _ = m.submit(spec)
+	if job := waitForJob(t, m, appPath, scan.ID); job.State != JobFailed || !strings.Contains(job.Error, failedPackaging.ID) {
+		t.Errorf("the scan should fail with its packaging job, got %+v", job)
+	}
+
+	// Finished jobs are read back from their records rather than kept in memory
+	m.mu.Lock()
+	remaining := len(m.jobs)
+	m.mu.Unlock()
+	if remaining != 0 {
+		t.Errorf("expected finished jobs to be dropped from memory, %d left", remaining)
+	}
+}
+
+func TestJobManager_PreparesWithoutTheLock(t *testing.T) {
+	m := newTestJobManager(t)
+	appPath := t.TempDir()
+
+	spec := testJobSpec(appPath, "pipeline-scan", 0, 0)
+	prepare := spec.Prepare
+	held := false
+	spec.Prepare = func() ([]string, error) {
+		if m.mu.TryLock() {
+			m.mu.Unlock()
+		} else {
+			held = true
+		}
+		return prepare()
+	}
+	job, err := m.submit(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.State != JobRunning || job.PID == 0 {
+		t.Errorf("expected the job to be running with its PID recorded, got %+v", job)
+	}
+	if held {
+		t.Error("expected Prepare to run without the job manager's lock")
+	}
+	waitForJob(t, m, appPath, job.ID)
+}
+
+func TestJobManager_CleanupWhenJobNeverStarts(t *testing.T) {
+	m := newTestJobManager(t)
+	appPath := t.TempDir()
+	cleaned := make(map[string]bool)
+	withCleanup := func(spec jobSpec, name string) jobSpec {
+		spec.Cleanup = func() { cleaned[name] = true }
+		return spec
+	}
+
+	unprepared := withCleanup(testJobSpec(appPath, "pipeline-scan", 0, 0), "prepare")
+	unprepared.Prepare = func() ([]string, error) { return nil, errors.New("no packaged files") }
+	if job, _ := m.submit(unprepared); job.State != JobFailed {
+		t.Fatalf("expected the job to fail to start, got %s", job.State)
+	}
+
+	failedPackaging, _ := m.submit(testJobSpec(appPath, "package-workspace", 1, 100))
+	dependent := withCleanup(testJobSpec(appPath, "pipeline-scan", 0, 0), "after")
+	dependent.After = failedPackaging.ID
+	scan, _ := m.submit(dependent)
+	waitForJob(t, m, appPath, scan.ID)
+
+	succeeded, _ := m.submit(withCleanup(testJobSpec(appPath, "local-sca-scan", 0, 0), "succeeded"))
+	waitForJob(t, m, appPath, succeeded.ID)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !cleaned["prepare"] || !cleaned["after"] {
+		t.Errorf("expected jobs that failed before starting to be cleaned up, got %v", cleaned)
+	}
+	if cleaned["succeeded"] {
+		t.Error("a job whose process ran should not be cleaned up")
+	}
+}
+
+func TestJobManager_ExclusiveJobsRunOneAtATime(t *testing.T) {
+	m := newTestJobManager(t)
+	appPath := t.TempDir()
+
+	spec := testJobSpec(appPath, "local-sca-scan", 0, 300)
+	spec.Exclusive = true
+	first, _ := m.submit(spec)
+	second, _ := m.submit(spec)
+	if first.State != JobRunning || second.State != JobQueued {
+		t.Fatalf("expected the second scan to wait, got %s and %s", first.State, second.State)
+	}
+	if job := waitForJob(t, m, appPath, second.ID); job.State != JobSucceeded {
+		t.Errorf("unexpected job: %+v", job)
+	}
+}
+
+func TestJobManager_Cancel(t *testing.T) {
+	m := newTestJobManager(t)
+	appPath := t.TempDir()
+
+	running, _ := m.submit(testJobSpec(appPath, "pipeline-scan", 0, 20000))
+	job, err := m.cancel(appPath, running.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.State != JobCancelled || job.ExitCode != nil {
+		t.Errorf("unexpected job: %+v", job)
+	}
+
+	if _, err := m.cancel(appPath, running.ID); err == nil {
+		t.Error("expected an error cancelling a finished job")
+	}
+}
+
+func TestReconcileJobRecord(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	appPath := t.TempDir()
+	resultFile := filepath.Join(appPath, "results.json")
+	if err := os.WriteFile(resultFile, []byte(`{}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Jobs left behind by a server that stopped
+	running := &Job{ID: "pipeline-scan-20261019-101500-a1b2", ApplicationPath: appPath, State: JobRunning, PID: 999999, ResultFile: resultFile}
+	queued := &Job{ID: "pipeline-scan-20261019-101501-a1b2", ApplicationPath: appPath, State: JobQueued}
+	for _, job := range []*Job{running, queued} {
+		if err := saveJobRecord(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	jobs, err := newJobManager("veracode").list(appPath)
+	if err != nil || len(jobs) != 2 {
+		t.Fatalf("list() = %v, %v", jobs, err)
+	}
+	if jobs[0].State != JobCancelled || jobs[1].State != JobSucceeded {
+		t.Errorf("expected the queued job cancelled and the finished one succeeded from its results, got %s and %s", jobs[0].State, jobs[1].State)
+	}
+}
+
+func TestReconcileJobRecord_ChecksProcessIdentity(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	appPath := t.TempDir()
+
+	// This test's own process stands in for a live process
+	ours := &Job{ID: "pipeline-scan-20261019-101500-a1b2", ApplicationPath: appPath, State: JobRunning, PID: os.Getpid(), Executable: filepath.Base(os.Args[0])}
+	reconcileJobRecord(ours)
+	if ours.State != JobRunning {
+		t.Errorf("a job whose process still runs should stay running, got %s", ours.State)
+	}
+
+	// The PID now belongs to another program, so the job's own process has gone
+	reused := &Job{ID: "pipeline-scan-20261019-101501-a1b2", ApplicationPath: appPath, State: JobRunning, PID: os.Getpid(), Executable: "veracode"}
+	reconcileJobRecord(reused)
+	if reused.State != JobFailed {
+		t.Errorf("a job whose PID was reused should be failed, got %s", reused.State)
+	}
+	if _, err := cancelJobRecord(appPath, reused.ID); err == nil {
+		t.Error("expected cancelling the orphaned job to fail rather than kill another process")
+	}
+}
+
+func TestSameExecutable(t *testing.T) {
+	tests := []struct {
+		processName, executable string
+		want                    bool
+	}{
+		{"veracode", "veracode", true},
+		{"/usr/local/bin/veracode", "veracode", true},
+		{"veracode.exe", "veracode", true},
+		{"mcp_tools.test", "mcp_tools.test", true},
+		{"a-long-program-", "a-long-program-name", true},
+		{"bash", "veracode", false},
+		{"", "veracode", false},
+	}
+	for _, tt := range tests {
+		if got := sameExecutable(tt.processName, tt.executable); got != tt.want {
+			t.Errorf("sameExecutable(%q, %q) = %v, want %v", tt.processName, tt.executable, got, tt.want)
+		}
+	}
+}
+
+func TestValidateJobID(t *testing.T) {
+	if err := validateJobID(newJobID(PipelineScanToolName)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, id := range []string{"", "../../etc/passwd", "pipeline-scan-20261019-101500"} {
+		if err := validateJobID(id); err == nil {
+			t.Errorf("%q: expected error", id)
+		}
+	}
+}
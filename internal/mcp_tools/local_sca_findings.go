@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
 )
 
 const GetLocalSCAFindingsToolName = "local-sca-findings"
@@ -25,6 +27,8 @@ type GetLocalSCAFindingsRequest struct {
 	SeverityGTE     *int   `json:"severity_gte,omitempty"`
 	Size            int    `json:"size,omitempty"`
 	Page            int    `json:"page,omitempty"`
+
+	IgnoreCVEs []string // Accepted vulnerabilities from the local_sca section of .veracode-workspace.json
 }
 
 // parseGetLocalSCAFindingsRequest extracts and validates parameters from the raw args map
@@ -50,6 +54,15 @@ func parseGetLocalSCAFindingsRequest(args map[string]interface{}) (*GetLocalSCAF
 		req.SeverityGTE = &sevInt
 	}
 
+	// Apply the workspace's defaults
+	if config, err := workspace.LoadWorkspaceConfig(req.ApplicationPath); err == nil && config.LocalSCA != nil {
+		if req.SeverityGTE == nil && config.LocalSCA.SeverityGTE > 0 {
+			sevInt := config.LocalSCA.SeverityGTE
+			req.SeverityGTE = &sevInt
+		}
+		req.IgnoreCVEs = config.LocalSCA.IgnoreCVEs
+	}
+
 	// Extract optional fields with defaults
 	req.Size = extractInt(args, "page_size", 10)
 	req.Page = extractInt(args, "page", 0)
@@ -189,6 +202,10 @@ func handleGetLocalSCAFindings(ctx context.Context, args map[string]interface{})
 
 	// Check if results file exists
 	if _, statErr := os.Stat(resultsFile); os.IsNotExist(statErr) {
+		hint := "The results file does not exist. Run a local SCA scan using the run-sca-scan tool first."
+		if job := backgroundJobs.latest(req.ApplicationPath, LocalSCAScanToolName); job != nil && job.isActive() {
+			hint = fmt.Sprintf("The SCA scan (job %s) is still %s. Use job-status to check when it has finished.", job.ID, job.State)
+		}
 		return map[string]interface{}{
 			"content": []map[string]string{{
 				"type": "text",
@@ -200,8 +217,8 @@ Results File: %s
 
 ❌ No results found
 
-The results file does not exist. Run a local SCA scan using the run-sca-scan tool first.
-`, req.ApplicationPath, resultsFile),
+%s
+`, req.ApplicationPath, resultsFile, hint),
 			}},
 		}, nil
 	}
@@ -291,6 +308,15 @@ func severityToInt(severity string) int {
 
 // matchesFilters checks if an SCA match passes all active filters
 func matchesFilters(match SCAMatch, req *GetLocalSCAFindingsRequest) bool {
+	// Hide accepted vulnerabilities, unless they are searched for
+	if req.CVE == "" {
+		for _, cve := range req.IgnoreCVEs {
+			if strings.EqualFold(match.Vulnerability.ID, cve) {
+				return false
+			}
+		}
+	}
+
 	// Filter by CVE
 	if req.CVE != "" {
 		found := false
@@ -536,6 +562,9 @@ func formatSCAFindingsResponse(appPath, resultsFile string, results *SCAFindings
 			filters["severity_gte"] = name
 		}
 	}
+	if len(req.IgnoreCVEs) > 0 && req.CVE == "" {
+		filters["ignored_cves"] = req.IgnoreCVEs
+	}
 
 	// Build the response structure
 	responseData := map[string]interface{}{
@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
 )
 
 func TestParseGetLocalSCAFindingsRequest_Success(t *testing.T) {
@@ -43,6 +45,39 @@ func TestParseGetLocalSCAFindingsRequest_EmptyApplicationPath(t *testing.T) {
 	}
 }
 
+func TestParseGetLocalSCAFindingsRequest_WorkspaceDefaults(t *testing.T) {
+	appPath := t.TempDir()
+	content := `{"name": "ScaApp", "local_sca": {"severity_gte": 4, "ignore_cves": ["CVE-2024-1234"]}}`
+	if err := os.WriteFile(filepath.Join(appPath, workspace.WorkspaceFileName), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := parseGetLocalSCAFindingsRequest(map[string]interface{}{"application_path": appPath})
+	if err != nil {
+		t.Fatalf("Failed to parse request: %v", err)
+	}
+	if req.SeverityGTE == nil || *req.SeverityGTE != 4 {
+		t.Errorf("Expected the workspace's minimum severity, got %v", req.SeverityGTE)
+	}
+
+	accepted := SCAMatch{}
+	accepted.Vulnerability.ID = "CVE-2024-1234"
+	accepted.Vulnerability.Severity = "Critical"
+	if matchesFilters(accepted, req) {
+		t.Error("Expected the accepted CVE to be hidden")
+	}
+	req.CVE = "CVE-2024-1234"
+	if !matchesFilters(accepted, req) {
+		t.Error("Expected the accepted CVE to be shown when searched for")
+	}
+
+	// An argument takes precedence over the default
+	req, err = parseGetLocalSCAFindingsRequest(map[string]interface{}{"application_path": appPath, "severity_gte": float64(2)})
+	if err != nil || *req.SeverityGTE != 2 {
+		t.Errorf("Expected severity_gte 2, got %v, %v", req.SeverityGTE, err)
+	}
+}
+
 func TestGetLocalSCAFindingsTool_HandleMissingResultsFile(t *testing.T) {
 	ctx := context.Background()
 
@@ -1,17 +1,23 @@
 package mcp_tools
 
 import (
-	"bytes"
 	"context"
 	"fmt"
+	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"time"
 )
 
 const LocalSCAScanToolName = "local-sca-scan"
 
+// scaSBOMJobKind is the kind of the job that writes the SBOM of a local SCA scan
+const scaSBOMJobKind = "local-sca-sbom"
+
+// scaSBOMFileName is the CycloneDX SBOM written next to the SCA scan results. The scan results only
+// list vulnerable components; the SBOM lists every component, which sca-licenses needs.
+const scaSBOMFileName = "sbom.json"
+
 // Auto-register this tool when the package is imported
 func init() {
 	RegisterMCPTool(LocalSCAScanToolName, handleLocalSCAScan)
@@ -54,16 +60,48 @@ func handleLocalSCAScan(ctx context.Context, args map[string]interface{}) (inter
 		}, nil
 	}
 
-	// Build and execute SCA scan command
-	exitCode, duration, stdout, stderr, err := executeSCAScanCommand(ctx, req, outputFile)
+	// Start the scan in the background; scans of the same workspace share the output file, so they run one at a time
+	timestamp := time.Now().Format(pipelineRunTimestamp)
+	logFile := filepath.Join(outputDir, fmt.Sprintf("veracode-sca-%s.log", timestamp))
+	job, err := backgroundJobs.submit(jobSpec{
+		Kind:            LocalSCAScanToolName,
+		ApplicationPath: req.ApplicationPath,
+		LogFile:         logFile,
+		OutputDir:       outputDir,
+		ResultFile:      outputFile,
+		Exclusive:       true,
+		Prepare: func() ([]string, error) {
+			return scaScanArgs(req, outputFile), nil
+		},
+		NextSteps: func(exitCode int) string {
+			return scaScanNextSteps(outputFile, exitCode)
+		},
+	})
 	if err != nil {
 		return map[string]interface{}{
 			"error": err.Error(),
 		}, nil
 	}
 
+	// Write the SBOM alongside; the scan's results are still usable if it fails
+	sbomFile := filepath.Join(outputDir, scaSBOMFileName)
+	sbomJob, err := backgroundJobs.submit(jobSpec{
+		Kind:            scaSBOMJobKind,
+		ApplicationPath: req.ApplicationPath,
+		LogFile:         filepath.Join(outputDir, fmt.Sprintf("veracode-sbom-%s.log", timestamp)),
+		OutputDir:       outputDir,
+		ResultFile:      sbomFile,
+		Exclusive:       true,
+		Prepare: func() ([]string, error) {
+			return scaSBOMArgs(req, sbomFile), nil
+		},
+	})
+	if err != nil {
+		log.Printf("Warning: Failed to start the SBOM job: %v", err)
+	}
+
 	// Build and return response
-	return buildSCAScanResponse(req, outputDir, outputFile, exitCode, duration, stdout, stderr), nil
+	return buildSCAScanResponse(req, outputDir, outputFile, job, sbomJob), nil
 }
 
 // validateAndPrepareSCADirectories validates the application path and creates the output directory
@@ -77,10 +115,9 @@ func validateAndPrepareSCADirectories(applicationPath string) (string, string, e
 		return "", "", fmt.Errorf("Failed to access application path: %v", err)
 	}
 
-	// Create output directory (temp/.veracode/{app}/sca)
-	// MkdirAll creates the directory if it doesn't exist, or does nothing if it already exists
-	outputDir := veracodeWorkDir(applicationPath, "sca")
-	err = os.MkdirAll(outputDir, 0750)
+	// Create output directory ({workspace_dir}/sca)
+	// The directory is created if it doesn't exist, or reused if it already exists
+	outputDir, err := createVeracodeWorkDir(applicationPath, "sca")
 	if err != nil {
 		return "", "", fmt.Errorf("Failed to create output directory: %v", err)
 	}
@@ -91,96 +128,73 @@ func validateAndPrepareSCADirectories(applicationPath string) (string, string, e
 	return outputDir, outputFile, nil
 }
 
-// executeSCAScanCommand builds and executes the Veracode SCA scan command
-func executeSCAScanCommand(ctx context.Context, req *LocalSCAScanRequest, outputFile string) (int, time.Duration, bytes.Buffer, bytes.Buffer, error) {
-	// Build command arguments
-	cmdArgs := []string{
+// scaScanArgs builds the Veracode SCA scan command arguments
+func scaScanArgs(req *LocalSCAScanRequest, outputFile string) []string {
+	return []string{
 		"scan",
 		"--format", "json",
 		"-s", req.ApplicationPath,
 		"-o", outputFile,
 		"--type", "directory",
 	}
+}
 
-	// #nosec G204 -- veracode command is hardcoded, only arguments are user-controlled and validated
-	cmd := exec.CommandContext(ctx, "veracode", cmdArgs...)
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	// Execute the command
-	startTime := time.Now()
-	err := cmd.Run()
-	duration := time.Since(startTime)
-
-	// Extract exit code
-	exitCode := 0
-	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			exitCode = exitErr.ExitCode()
-		}
+// scaSBOMArgs builds the Veracode CLI arguments that write a CycloneDX SBOM of every component
+func scaSBOMArgs(req *LocalSCAScanRequest, sbomFile string) []string {
+	return []string{
+		"sbom",
+		"--type", "directory",
+		"--source", req.ApplicationPath,
+		"--format", "cyclonedx-json",
+		"--output", sbomFile,
 	}
-
-	return exitCode, duration, stdout, stderr, nil
 }
 
-// buildSCAScanResponse constructs the response based on command execution results
-func buildSCAScanResponse(req *LocalSCAScanRequest, outputDir, outputFile string, exitCode int, duration time.Duration, stdout, stderr bytes.Buffer) map[string]interface{} {
-	// Interpret the exit code
-	cliInfo := InterpretVeracodeExitCode(exitCode)
-
-	// Build command arguments for display
-	cmdArgs := []string{
-		"scan",
-		"--format", "json",
-		"-s", req.ApplicationPath,
-		"-o", outputFile,
-		"--type", "directory",
+// scaScanNextSteps describes what to do once the SCA scan has exited
+func scaScanNextSteps(outputFile string, exitCode int) string {
+	switch exitCode {
+	case 0:
+		return fmt.Sprintf("Next steps:\n- Review SCA scan results in: %s\n- Check for vulnerable dependencies and license issues\n- Review remediation recommendations", outputFile)
+	case 3:
+		return fmt.Sprintf("Next steps:\n- Review SCA scan results in: %s\n- Check policy violations in dependencies\n- Address vulnerable components before deployment", outputFile)
+	default:
+		return InterpretVeracodeExitCode(exitCode).NextSteps
 	}
+}
 
-	// Build base response information
-	baseInfo := fmt.Sprintf(`Veracode SCA Scan
+// buildSCAScanResponse describes the SCA scan job that was started, and the SBOM job if it was submitted
+func buildSCAScanResponse(req *LocalSCAScanRequest, outputDir, outputFile string, job, sbomJob *Job) map[string]interface{} {
+	responseText := fmt.Sprintf(`Veracode SCA Scan
 =================
 
 Application Path: %s
 Output Directory: %s
 Output File: %s
-Duration: %v
-Exit Code: %d
+Job: %s
+Status: %s
+Log file: %s
 
-Command executed:
-veracode %s
+`, req.ApplicationPath, outputDir, outputFile, job.ID, job.State, job.LogFile)
 
-`, req.ApplicationPath, outputDir, outputFile, duration, exitCode, joinArgs(cmdArgs))
-
-	// Customize next steps for SCA scan context
-	nextSteps := cliInfo.NextSteps
-	switch exitCode {
-	case 0:
-		nextSteps = fmt.Sprintf("Next steps:\n- Review SCA scan results in: %s\n- Check for vulnerable dependencies and license issues\n- Review remediation recommendations", outputFile)
-	case 3:
-		nextSteps = fmt.Sprintf("Next steps:\n- Review SCA scan results in: %s\n- Check policy violations in dependencies\n- Address vulnerable components before deployment", outputFile)
+	// A job that couldn't start (e.g. the Veracode CLI isn't installed) has already failed
+	if job.State == JobFailed {
+		return map[string]interface{}{
+			"error": responseText + fmt.Sprintf("❌ The SCA scan could not start: %s", job.Error),
+		}
 	}
 
-	responseText := baseInfo + fmt.Sprintf("%s %s\n\n", cliInfo.Icon, cliInfo.Message)
-
-	if stdout.Len() > 0 {
-		responseText += fmt.Sprintf("\nOutput:\n%s\n", stdout.String())
+	if job.Command != "" {
+		responseText += fmt.Sprintf("Command executed:\n%s\n\n", job.Command)
 	}
-
-	if stderr.Len() > 0 {
-		responseText += fmt.Sprintf("\nError output:\n%s\n", stderr.String())
+	if sbomJob != nil && sbomJob.State != JobFailed {
+		responseText += fmt.Sprintf("SBOM Job: %s (%s), writing every component and its licenses to %s for sca-licenses\n\n", sbomJob.ID, sbomJob.State, sbomJob.ResultFile)
 	}
+	responseText += fmt.Sprintf(`The SCA scan runs in the background.
 
-	responseText += fmt.Sprintf("\n%s", nextSteps)
-
-	// Return as error for truly failing exit codes, but as content for warnings
-	if exitCode > 0 && !cliInfo.IsWarning {
-		return map[string]interface{}{
-			"error": responseText,
-		}
-	}
+Next steps:
+- Use job-status with job_id %s to check when the scan has finished
+- Then review the results with local-sca-findings and local-iac-findings
+`, job.ID)
 
 	return map[string]interface{}{
 		"content": []map[string]string{{
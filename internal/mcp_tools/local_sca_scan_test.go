@@ -1,10 +1,10 @@
 package mcp_tools
 
 import (
-	"bytes"
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -209,10 +209,10 @@ func TestBuildSCAScanResponse_Success(t *testing.T) {
 	outputDir := "/test/path/.veracode/sca"
 	outputFile := "/test/path/.veracode/sca/veracode.json"
 
-	var stdout, stderr bytes.Buffer
-	response := buildSCAScanResponse(req, outputDir, outputFile, 0, 1000000, stdout, stderr)
+	job := &Job{ID: "local-sca-scan-20261019-101500-a1b2", State: JobRunning, LogFile: "/test/path/.veracode/sca/veracode-sca.log"}
+	response := buildSCAScanResponse(req, outputDir, outputFile, job, nil)
 
-	// Should have content for successful exit code
+	// Should have content for a started scan
 	if response["content"] == nil {
 		t.Error("Expected content in response")
 	}
@@ -226,30 +226,23 @@ func TestBuildSCAScanResponse_Error(t *testing.T) {
 	outputDir := "/test/path/.veracode/sca"
 	outputFile := "/test/path/.veracode/sca/veracode.json"
 
-	var stdout, stderr bytes.Buffer
-	// Exit code 1 is a real error (not a warning)
-	response := buildSCAScanResponse(req, outputDir, outputFile, 1, 1000000, stdout, stderr)
+	// A job that failed to start (e.g. the CLI is missing) is an error
+	job := &Job{ID: "local-sca-scan-20261019-101500-a1b2", State: JobFailed, Error: "failed to start veracode: executable file not found"}
+	response := buildSCAScanResponse(req, outputDir, outputFile, job, nil)
 
-	// Should have error for failing exit code
+	// Should have error for a job that could not start
 	if response["error"] == nil {
-		t.Error("Expected error in response for non-zero exit code")
+		t.Error("Expected error in response for a job that could not start")
 	}
 }
 
-func TestBuildSCAScanResponse_Warning(t *testing.T) {
-	req := &LocalSCAScanRequest{
-		ApplicationPath: "/test/path",
-	}
-
-	outputDir := "/test/path/.veracode/sca"
+func TestSCAScanNextSteps_Warning(t *testing.T) {
 	outputFile := "/test/path/.veracode/sca/veracode.json"
 
-	var stdout, stderr bytes.Buffer
 	// Exit code 3 is a warning (policy failure but scan succeeded)
-	response := buildSCAScanResponse(req, outputDir, outputFile, 3, 1000000, stdout, stderr)
+	nextSteps := scaScanNextSteps(outputFile, 3)
 
-	// Should have content (not error) for warning exit codes
-	if response["content"] == nil {
-		t.Error("Expected content in response for warning exit code")
+	if !strings.Contains(nextSteps, "policy violations") || !strings.Contains(nextSteps, outputFile) {
+		t.Errorf("Expected policy next steps for exit code 3, got: %s", nextSteps)
 	}
 }
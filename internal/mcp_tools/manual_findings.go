@@ -0,0 +1,398 @@
+package mcp_tools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const ManualFindingsToolName = "manual-findings"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(ManualFindingsToolName, handleGetManualFindings)
+}
+
+// ManualFindingsRequest represents the parsed parameters for manual-findings
+type ManualFindingsRequest struct {
+	ApplicationPath    string   `json:"application_path"`
+	AppProfile         string   `json:"app_profile,omitempty"`
+	Size               int      `json:"size,omitempty"`
+	Page               int      `json:"page,omitempty"`
+	AllPages           bool     `json:"all_pages,omitempty"`
+	MaxResults         int      `json:"max_results,omitempty"`
+	Severity           *int32   `json:"severity,omitempty"`
+	SeverityGte        *int32   `json:"severity_gte,omitempty"`
+	CWEIDs             []string `json:"cwe_ids,omitempty"`
+	ViolatesPolicy     *bool    `json:"violates_policy,omitempty"`
+	IncludeAnnotations bool     `json:"include_annotations,omitempty"`
+}
+
+// parseManualFindingsRequest extracts and validates parameters from the raw args map
+func parseManualFindingsRequest(args map[string]interface{}) (*ManualFindingsRequest, error) {
+	req := &ManualFindingsRequest{}
+
+	// Extract required fields
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract optional fields
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Size = extractInt(args, "page_size", 10)
+	req.Page = extractInt(args, "page", 0)
+
+	// Extract all-pages options
+	req.AllPages, req.MaxResults, err = extractAllPagesParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract optional int32 pointers with validation
+	req.Severity, _, err = extractOptionalInt32Ptr(args, "severity")
+	if err != nil {
+		return nil, err
+	}
+	req.SeverityGte, _, err = extractOptionalInt32Ptr(args, "severity_gte")
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract optional booleans — default violates_policy to true if not provided
+	violatesPolicy, provided := extractOptionalBool(args, "violates_policy")
+	if !provided {
+		defaultTrue := true
+		violatesPolicy = &defaultTrue
+	}
+	req.ViolatesPolicy = violatesPolicy
+	req.IncludeAnnotations = extractFlag(args, "include_annotations")
+
+	// Extract CWE IDs
+	req.CWEIDs = extractCWEIDs(args)
+
+	// Validate pagination bounds
+	if err := validatePaginationParams(req.Size, req.Page); err != nil {
+		return nil, err
+	}
+
+	// Validate severity bounds
+	if err := validateSeverity(req.Severity, "severity"); err != nil {
+		return nil, err
+	}
+	if err := validateSeverity(req.SeverityGte, "severity_gte"); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func handleGetManualFindings(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseManualFindingsRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	hasAppProfile := appProfile != ""
+	if !hasAppProfile {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		responseText := fmt.Sprintf(`Manual Findings Analysis - Error
+========================
+
+Application Path: %s
+App Profile: %s
+Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`,
+			req.ApplicationPath,
+			appProfile,
+			err,
+		)
+
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": responseText,
+			}},
+		}, nil
+	}
+
+	// Step 3: Get application GUID using the profile name (or use directly if it's a GUID)
+	var applicationGUID string
+
+	if isApplicationGUID(appProfile) {
+		// It's a GUID, use it directly
+		applicationGUID = appProfile
+	} else {
+		// It's an app name, look it up
+		var application *applications.Application
+		application, err = client.GetApplicationByName(ctx, appProfile)
+		if err != nil {
+			appProfileSource := "from workspace config"
+			if hasAppProfile {
+				appProfileSource = "from parameter (overriding workspace)"
+			}
+
+			responseText := fmt.Sprintf(`Manual Findings Analysis - Error
+========================
+
+Application Path: %s
+App Profile: %s (%s)
+Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions
+- The application name matches exactly (case-insensitive)`,
+				req.ApplicationPath,
+				appProfile,
+				appProfileSource,
+				err,
+			)
+
+			return map[string]interface{}{
+				"content": []map[string]string{{
+					"type": "text",
+					"text": responseText,
+				}},
+			}, nil
+		}
+
+		// Extract GUID from application
+		if application.Guid != nil {
+			applicationGUID = *application.Guid
+		} else {
+			applicationGUID = "unknown"
+		}
+	}
+
+	// Step 4: Build the findings request (manual penetration tests are run against the policy profile, not sandboxes)
+	findingsReq := api.FindingsRequest{
+		AppProfile:     applicationGUID,
+		Size:           req.Size,
+		Page:           req.Page,
+		Severity:       req.Severity,
+		SeverityGte:    req.SeverityGte,
+		CWEIDs:         req.CWEIDs,
+		ViolatesPolicy: req.ViolatesPolicy,
+		IncludeAnnot:   req.IncludeAnnotations,
+	}
+
+	// Step 5: Call the API to get manual findings
+	findings, err := collectFindings(ctx, client, findingsReq, req.AllPages, req.MaxResults, manualFindingsFormat(nil))
+	if err != nil {
+		responseText := fmt.Sprintf(`Manual Findings Analysis - Error
+========================
+
+Application Path: %s
+App Profile: %s
+Application GUID: %s
+Error: Failed to retrieve manual findings
+
+%v
+
+Please verify:
+- The application has had a manual penetration test
+- API credentials have access to view findings`,
+			req.ApplicationPath,
+			appProfile,
+			applicationGUID,
+			err,
+		)
+
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": responseText,
+			}},
+		}, nil
+	}
+
+	// Step 6: Add the tester's write-up from the Manual Scans API (the findings are still useful without it)
+	var details map[string]api.ManualFindingDetail
+	if len(findings.Response.Findings) > 0 {
+		details, err = client.GetManualFindingDetails(ctx, applicationGUID)
+		if err != nil {
+			log.Printf("Manual findings: failed to retrieve manual scan details: %v", err)
+		}
+	}
+
+	// Step 7: Format and return the response
+	policyFilter := req.ViolatesPolicy != nil && *req.ViolatesPolicy
+	return formatManualFindingsResponse(ctx, appProfile, applicationGUID, findings, details, policyFilter), nil
+}
+
+// manualFindingsFormat converts and counts manual findings, listing the most severe first. The
+// tester's write-up in details is added to the findings it belongs to; it isn't needed for counting.
+func manualFindingsFormat(details map[string]api.ManualFindingDetail) findingsFormat {
+	return findingsFormat{
+		Label:    "Manual findings",
+		ScanType: "MANUAL",
+		Convert: func(finding api.Finding) MCPFinding {
+			var detail *api.ManualFindingDetail
+			if d, ok := details[finding.ID]; ok {
+				detail = &d
+			}
+			return processManualFinding(finding, detail)
+		},
+		Count:             updateSummaryCounters,
+		SortBySeverity:    true,
+		StructuredContent: true,
+	}
+}
+
+// formatManualFindingsResponse formats the collected findings into an MCP tool response
+func formatManualFindingsResponse(ctx context.Context, appProfile, applicationGUID string, findings *collectedFindings, details map[string]api.ManualFindingDetail, policyFilter bool) map[string]interface{} {
+	format := manualFindingsFormat(details)
+	response := newFindingsResponse(findings, format)
+	response.Application = MCPApplication{
+		Name: appProfile,
+		ID:   applicationGUID,
+	}
+	response.PolicyFilter = policyFilter
+
+	return formatFindingsResponse(ctx, response, findings.Response != nil && findings.Response.AllPages, format)
+}
+
+// processManualFinding transforms a single API finding, and its Manual Scans API
+// write-up if available, into an MCP finding
+func processManualFinding(finding api.Finding, detail *api.ManualFindingDetail) MCPFinding {
+	// Use severity score from API extraction
+	severityNum := finding.SeverityScore
+	transformedSeverity := TransformSeverity(&severityNum)
+
+	// Transform status (OPEN/CLOSED/UNKNOWN)
+	status := finding.Status
+	if status == "" {
+		status = "OPEN" // Default
+	}
+
+	// Normalize status
+	var transformedStatus FindingStatus
+	switch strings.ToUpper(status) {
+	case "OPEN", "NEW":
+		transformedStatus = StatusOpen
+	case "CLOSED":
+		transformedStatus = StatusClosed
+	default:
+		transformedStatus = StatusUnknown
+	}
+
+	// Map ResolutionStatus from FindingStatus API directly to mitigation status
+	mitigationStatus := finding.ResolutionStatus
+	if mitigationStatus == "" {
+		mitigationStatus = "NONE"
+	}
+
+	// Determine policy violation using business rule
+	violatesPolicyValue := finding.ViolatesPolicy
+	violatesPolicy := DeterminesPolicyViolation(
+		transformedStatus,
+		mitigationStatus,
+		&violatesPolicyValue,
+	)
+
+	// Clean description
+	cleanedDesc, references := TransformDescription(finding.Description, "MANUAL")
+
+	// Extract numeric CWE ID
+	var cweID int32
+	if finding.CWE != "" {
+		_, _ = fmt.Sscanf(finding.CWE, "CWE-%d", &cweID)
+	}
+
+	mcpFinding := MCPFinding{
+		FlawID:             finding.ID,
+		BuildID:            finding.BuildID,
+		ScanType:           "MANUAL",
+		Status:             string(transformedStatus),
+		MitigationStatus:   mitigationStatus,
+		ViolatesPolicy:     violatesPolicy,
+		Severity:           string(transformedSeverity),
+		SeverityScore:      severityNum,
+		CweId:              cweID,
+		CweName:            CWEName(cweID),
+		Description:        cleanedDesc,
+		References:         references,
+		URL:                finding.URL,
+		Module:             finding.Module,
+		AttackVector:       finding.AttackVector,
+		Mitigations:        TransformAnnotations(finding.Annotations),
+		GracePeriodExpires: finding.GracePeriodExpires,
+		DetailsError:       finding.DetailsError,
+		ManualTest: &MCPManualTest{
+			CapecID:     finding.CapecID,
+			InputVector: finding.AttackVector,
+		},
+	}
+
+	if detail != nil {
+		applyManualFindingDetail(&mcpFinding, detail)
+	}
+
+	return mcpFinding
+}
+
+// applyManualFindingDetail fills in an MCP finding from the Manual Scans API write-up.
+// The Findings API values are kept where both APIs report the same field.
+func applyManualFindingDetail(mcpFinding *MCPFinding, detail *api.ManualFindingDetail) {
+	if mcpFinding.ManualTest == nil {
+		mcpFinding.ManualTest = &MCPManualTest{}
+	}
+	manualTest := mcpFinding.ManualTest
+
+	manualTest.ScanName = detail.ScanName
+	manualTest.ExploitDifficulty = detail.ExploitDifficulty
+	manualTest.RemediationEffort = detail.RemediationEffort
+	manualTest.ExploitDescription = detail.ExploitDescription
+	manualTest.Remediation = detail.Remediation
+	manualTest.SeverityRationale = detail.SeverityRationale
+	manualTest.CVSS = detail.CVSS
+
+	manualTest.Exploitability = detail.Exploitability
+
+	if manualTest.CapecID == "" && detail.CapecID != 0 {
+		manualTest.CapecID = strconv.Itoa(int(detail.CapecID))
+	}
+	if manualTest.InputVector == "" {
+		manualTest.InputVector = detail.InputVector
+	}
+	if mcpFinding.AttackVector == "" {
+		mcpFinding.AttackVector = detail.InputVector
+	}
+	if mcpFinding.URL == "" {
+		mcpFinding.URL = detail.Location
+	}
+	if mcpFinding.Module == "" {
+		mcpFinding.Module = detail.Module
+	}
+}
@@ -0,0 +1,169 @@
+package mcp_tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+// ============================================================================
+// parseManualFindingsRequest tests
+// ============================================================================
+
+func TestParseManualFindingsRequest_Success(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path": "/path/to/app",
+	}
+
+	req, err := parseManualFindingsRequest(args)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if req.Size != 10 || req.Page != 0 {
+		t.Errorf("Expected default pagination 10/0, got %d/%d", req.Size, req.Page)
+	}
+	if req.ViolatesPolicy == nil || !*req.ViolatesPolicy {
+		t.Error("Expected violates_policy to default to true")
+	}
+}
+
+func TestParseManualFindingsRequest_AllParameters(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path":    "/path/to/app",
+		"app_profile":         "MyApp",
+		"severity_gte":        float64(3),
+		"cwe_ids":             []interface{}{"639"},
+		"violates_policy":     false,
+		"include_annotations": true,
+		"all_pages":           true,
+		"max_results":         float64(200),
+	}
+
+	req, err := parseManualFindingsRequest(args)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if req.AppProfile != "MyApp" || req.SeverityGte == nil || *req.SeverityGte != 3 {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+	if len(req.CWEIDs) != 1 || req.CWEIDs[0] != "639" {
+		t.Errorf("Expected cwe_ids [639], got %v", req.CWEIDs)
+	}
+	if req.ViolatesPolicy == nil || *req.ViolatesPolicy {
+		t.Error("Expected violates_policy false")
+	}
+	if !req.IncludeAnnotations || !req.AllPages || req.MaxResults != 200 {
+		t.Errorf("Unexpected options: %+v", req)
+	}
+}
+
+func TestParseManualFindingsRequest_Validation(t *testing.T) {
+	if _, err := parseManualFindingsRequest(map[string]interface{}{}); err == nil {
+		t.Error("Expected error for missing application_path")
+	}
+	if _, err := parseManualFindingsRequest(map[string]interface{}{"application_path": "/path", "severity": float64(6)}); err == nil {
+		t.Error("Expected error for severity=6")
+	}
+	if _, err := parseManualFindingsRequest(map[string]interface{}{"application_path": "/path", "page_size": float64(0)}); err == nil {
+		t.Error("Expected error for page_size=0")
+	}
+}
+
+// ============================================================================
+// processManualFinding tests
+// ============================================================================
+
+func TestProcessManualFinding_WithDetail(t *testing.T) {
+	exploitability := int32(1)
+	finding := api.Finding{
+		ID:               "42",
+		SeverityScore:    4,
+		CWE:              "CWE-639",
+		Status:           "OPEN",
+		ResolutionStatus: "NONE",
+		ViolatesPolicy:   true,
+		Description:      "Account IDs can be changed to view other customers",
+		URL:              "https://example.com/account",
+		CapecID:          "87",
+	}
+	detail := &api.ManualFindingDetail{
+		IssueID:            "42",
+		ScanName:           "Annual pen test",
+		CapecID:            99,
+		Exploitability:     &exploitability,
+		ExploitDifficulty:  2,
+		RemediationEffort:  3,
+		ExploitDescription: "Changed the id parameter",
+		InputVector:        "URL parameter",
+		Location:           "/ignored",
+		Remediation:        "Check the account belongs to the session user",
+	}
+
+	mcpFinding := processManualFinding(finding, detail)
+
+	if mcpFinding.ScanType != "MANUAL" || mcpFinding.CweId != 639 || mcpFinding.Severity != string(SeverityHigh) {
+		t.Errorf("Unexpected core fields: %+v", mcpFinding)
+	}
+	if mcpFinding.URL != "https://example.com/account" {
+		t.Errorf("Expected the Findings API location to be kept, got %s", mcpFinding.URL)
+	}
+	if mcpFinding.AttackVector != "URL parameter" {
+		t.Errorf("Expected attack vector from the write-up, got %s", mcpFinding.AttackVector)
+	}
+
+	manualTest := mcpFinding.ManualTest
+	if manualTest == nil {
+		t.Fatal("Expected manual_test to be set")
+	}
+	if manualTest.CapecID != "87" {
+		t.Errorf("Expected the Findings API CAPEC ID to be kept, got %q", manualTest.CapecID)
+	}
+	if manualTest.Exploitability == nil || *manualTest.Exploitability != 1 || manualTest.ExploitDifficulty != 2 || manualTest.RemediationEffort != 3 {
+		t.Errorf("Unexpected exploitability: %+v", manualTest)
+	}
+	if manualTest.ScanName != "Annual pen test" || manualTest.Remediation == "" || manualTest.ExploitDescription == "" {
+		t.Errorf("Expected the tester's write-up, got %+v", manualTest)
+	}
+}
+
+func TestProcessManualFinding_WithoutDetail(t *testing.T) {
+	mcpFinding := processManualFinding(api.Finding{ID: "7", SeverityScore: 2, AttackVector: "Cookie", DetailsError: "failed to decode MANUAL finding details"}, nil)
+
+	if mcpFinding.ManualTest == nil || mcpFinding.ManualTest.InputVector != "Cookie" {
+		t.Errorf("Expected manual_test from the Findings API alone, got %+v", mcpFinding.ManualTest)
+	}
+	if mcpFinding.ManualTest.Remediation != "" {
+		t.Errorf("Expected no write-up without Manual Scans API data, got %q", mcpFinding.ManualTest.Remediation)
+	}
+	if mcpFinding.DetailsError == "" {
+		t.Error("Expected the details decode error to be passed on")
+	}
+}
+
+func TestFormatManualFindingsResponse(t *testing.T) {
+	findings := &api.FindingsResponse{
+		Findings: []api.Finding{
+			{ID: "1", SeverityScore: 2, Status: "OPEN", ViolatesPolicy: true},
+			{ID: "2", SeverityScore: 5, Status: "OPEN", ViolatesPolicy: true},
+		},
+		TotalCount: 2,
+		Page:       0,
+		Size:       10,
+	}
+	details := map[string]api.ManualFindingDetail{"2": {IssueID: "2", Remediation: "Fix it"}}
+
+	result := formatManualFindingsResponse(context.Background(), "MyApp", "app-guid", &collectedFindings{Response: findings}, details, true)
+
+	content, ok := result["content"].([]map[string]interface{})
+	if !ok || len(content) != 1 {
+		t.Fatalf("Expected one content item, got %v", result["content"])
+	}
+	text, _ := content[0]["text"].(string)
+	if !strings.Contains(text, `"scan_type": "MANUAL"`) || !strings.Contains(text, `"remediation": "Fix it"`) {
+		t.Errorf("Expected manual findings with the write-up, got %s", text)
+	}
+}
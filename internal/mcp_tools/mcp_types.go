@@ -14,6 +14,7 @@ type MCPFindingsResponse struct {
 	Findings     []MCPFinding       `json:"findings"`
 	Pagination   *MCPPagination     `json:"pagination,omitempty"`
 	PolicyFilter bool               `json:"policy_filter,omitempty"`
+	Truncated    bool               `json:"truncated,omitempty"` // all_pages stopped at max_results
 }
 
 // MCPApplication represents application information
@@ -34,7 +35,7 @@ type MCPFinding struct {
 	// Core identification
 	FlawID   string `json:"flaw_id"`
 	BuildID  int64  `json:"build_id,omitempty"` // Build/scan ID where this finding was discovered
-	ScanType string `json:"scan_type"`          // STATIC, DAST, SCA
+	ScanType string `json:"scan_type"`          // STATIC, DAST, MANUAL, SCA
 
 	// Status
 	Status           string `json:"status"`            // OPEN, CLOSED, UNKNOWN
@@ -45,6 +46,7 @@ type MCPFinding struct {
 	Severity      string      `json:"severity"`       // CRITICAL, HIGH, MEDIUM, LOW, INFORMATIONAL
 	SeverityScore int32       `json:"severity_score"` // 0-5
 	CweId         int32       `json:"cwe_id"`         // CWE ID number (e.g., 78 for CWE-78)
+	CweName       string      `json:"cwe_name,omitempty"`
 	Description   string      `json:"description"`
 	References    []Reference `json:"references,omitempty"`
 
@@ -59,12 +61,16 @@ type MCPFinding struct {
 	Component     *MCPComponent     `json:"component,omitempty"`
 	Vulnerability *MCPVulnerability `json:"vulnerability,omitempty"`
 
+	// Manual penetration test-specific
+	ManualTest *MCPManualTest `json:"manual_test,omitempty"`
+
 	// Mitigations
 	Mitigations []MCPMitigation `json:"mitigations,omitempty"`
 
 	// Dates
-	FirstFound string `json:"first_found,omitempty"`
-	LastSeen   string `json:"last_seen,omitempty"`
+	FirstFound         string `json:"first_found,omitempty"`
+	LastSeen           string `json:"last_seen,omitempty"`
+	GracePeriodExpires string `json:"grace_period_expires,omitempty"` // Policy grace period expiry (include_expiry_date)
 
 	// Additional context
 	Module       string `json:"module,omitempty"`
@@ -72,6 +78,9 @@ type MCPFinding struct {
 	AttackVector string `json:"attack_vector,omitempty"`
 	ContextType  string `json:"context_type,omitempty"`
 	Count        int    `json:"count,omitempty"`
+
+	// Why the scan-type details could not be decoded; the fields above that come from them are then missing
+	DetailsError string `json:"details_error,omitempty"`
 }
 
 // MCPComponent represents SCA component information
@@ -95,6 +104,20 @@ type MCPVulnerability struct {
 	Exploitable bool    `json:"exploitable"`
 }
 
+// MCPManualTest represents the tester's write-up of a manual penetration test finding
+type MCPManualTest struct {
+	ScanName           string  `json:"scan_name,omitempty"`
+	CapecID            string  `json:"capec_id,omitempty"`
+	Exploitability     *int32  `json:"exploitability,omitempty"` // -2 (very unlikely) to 2 (very likely)
+	ExploitDifficulty  int32   `json:"exploit_difficulty,omitempty"`
+	RemediationEffort  int32   `json:"remediation_effort,omitempty"` // 1 (trivial) to 5 (complex), from the CWE API
+	InputVector        string  `json:"input_vector,omitempty"`
+	ExploitDescription string  `json:"exploit_description,omitempty"`
+	Remediation        string  `json:"remediation,omitempty"`
+	SeverityRationale  string  `json:"severity_rationale,omitempty"`
+	CVSS               float64 `json:"cvss,omitempty"`
+}
+
 // MCPMitigation represents a mitigation/annotation
 type MCPMitigation struct {
 	Action    string `json:"action"`
@@ -0,0 +1,339 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const MitigationQueueToolName = "mitigation-queue"
+
+// maxQueueApplications caps the applications a single call searches
+const maxQueueApplications = 25
+
+// mitigationInfoBatchSize caps the flaw IDs sent in one getmitigationinfo.do call
+const mitigationInfoBatchSize = 100
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(MitigationQueueToolName, handleMitigationQueue)
+}
+
+// MitigationQueueRequest represents the parsed parameters for mitigation-queue
+type MitigationQueueRequest struct {
+	ApplicationPath string   `json:"application_path"`
+	AppProfiles     []string `json:"app_profiles,omitempty"` // Names or GUIDs; defaults to the workspace application
+	ScanType        string   `json:"scan_type,omitempty"`    // STATIC, DYNAMIC, MANUAL or SCA; empty for all
+}
+
+// MCPMitigationQueue is the mitigation proposals awaiting review across one or more applications
+type MCPMitigationQueue struct {
+	TotalProposals int                             `json:"total_proposals"`
+	Applications   []MCPMitigationQueueApplication `json:"applications"`
+}
+
+// MCPMitigationQueueApplication is one application's pending mitigation proposals
+type MCPMitigationQueueApplication struct {
+	AppProfile string                  `json:"app_profile"`
+	GUID       string                  `json:"guid,omitempty"`
+	Proposals  []MCPMitigationProposal `json:"proposals"`
+	Error      string                  `json:"error,omitempty"`
+	Warnings   []string                `json:"warnings,omitempty"`
+}
+
+// MCPMitigationProposal is a finding with a proposed mitigation, and the justification a reviewer needs to triage it
+type MCPMitigationProposal struct {
+	FlawID         string          `json:"flaw_id"`
+	ScanType       string          `json:"scan_type"`
+	BuildID        int64           `json:"build_id,omitempty"`
+	CweID          int32           `json:"cwe_id,omitempty"`
+	CweName        string          `json:"cwe_name,omitempty"`
+	Severity       string          `json:"severity"`
+	Location       string          `json:"location,omitempty"`
+	ViolatesPolicy bool            `json:"violates_policy"`
+	Action         string          `json:"action"` // The proposed mitigation, e.g. Mitigate by Design
+	Submitter      string          `json:"submitter,omitempty"`
+	ProposedDate   string          `json:"proposed_date,omitempty"`
+	Technique      string          `json:"technique,omitempty"`
+	Specifics      string          `json:"specifics,omitempty"`
+	RemainingRisk  string          `json:"remaining_risk,omitempty"`
+	Verification   string          `json:"verification,omitempty"`
+	Comment        string          `json:"comment,omitempty"`
+	History        []MCPMitigation `json:"history,omitempty"` // Every proposal, comment and review decision, oldest first
+
+	severityScore int32
+}
+
+// parseMitigationQueueRequest extracts and validates parameters from the raw args map
+func parseMitigationQueueRequest(args map[string]interface{}) (*MitigationQueueRequest, error) {
+	req := &MitigationQueueRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfiles, err = extractStringList(args, "app_profiles")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.AppProfiles) > maxQueueApplications {
+		return nil, fmt.Errorf("app_profiles can contain at most %d applications, got %d", maxQueueApplications, len(req.AppProfiles))
+	}
+
+	req.ScanType, err = extractOptionalEnum(args, "scan_type", policyScanTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func handleMitigationQueue(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseMitigationQueueRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve the application profile names
+	appProfiles := req.AppProfiles
+	if len(appProfiles) == 0 {
+		// Fall back to workspace config if app_profiles not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfiles = []string{wsConfig.Application()}
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf("Mitigation Queue - Error\n========================\n\nError: Failed to create API client\n\n%v\n\nPlease ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.", err),
+			}},
+		}, nil
+	}
+
+	scanTypes := policyScanTypes
+	if req.ScanType != "" {
+		scanTypes = []string{req.ScanType}
+	}
+
+	// Step 3: Gather each application's proposals; one application failing doesn't stop the others
+	queue := &MCPMitigationQueue{}
+	for _, appProfile := range appProfiles {
+		queueApp := mitigationQueueForApplication(ctx, client, appProfile, scanTypes)
+		queue.TotalProposals += len(queueApp.Proposals)
+		queue.Applications = append(queue.Applications, queueApp)
+	}
+
+	return formatMitigationQueueResponse(queue), nil
+}
+
+// mitigationQueueForApplication finds an application's proposed mitigations and loads their comment threads
+func mitigationQueueForApplication(ctx context.Context, client api.Client, appProfile string, scanTypes []string) MCPMitigationQueueApplication {
+	queueApp := MCPMitigationQueueApplication{AppProfile: appProfile, Proposals: []MCPMitigationProposal{}}
+
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		queueApp.Error = fmt.Sprintf("Failed to lookup application: %v", err)
+		return queueApp
+	}
+	queueApp.GUID = application.GetGuid()
+
+	// The annotations carry each proposal's technique, specifics, remaining risk and verification
+	findingsReq := api.FindingsRequest{AppProfile: application.GetGuid(), IncludeAnnot: true}
+	var proposed []api.Finding
+	for _, scanType := range scanTypes {
+		for finding, err := range client.IterateFindings(ctx, findingsReq, scanType) {
+			if err != nil {
+				log.Printf("Mitigation queue: failed to retrieve %s findings for %s: %v", scanType, appProfile, err)
+				queueApp.Warnings = append(queueApp.Warnings, fmt.Sprintf("Could not retrieve %s findings: %v", scanType, err))
+				break
+			}
+			if strings.EqualFold(finding.ResolutionStatus, string(MitigationProposed)) {
+				proposed = append(proposed, finding)
+			}
+		}
+	}
+
+	threads, warnings := fetchMitigationThreads(ctx, client, proposed)
+	queueApp.Warnings = append(queueApp.Warnings, warnings...)
+
+	for _, finding := range proposed {
+		queueApp.Proposals = append(queueApp.Proposals, buildMitigationProposal(finding, threads[mitigationThreadKey(finding.BuildID, finding.ID)]))
+	}
+	sortMitigationProposals(queueApp.Proposals)
+
+	return queueApp
+}
+
+// mitigationThreadKey identifies a flaw's comment thread, as flaw IDs are only unique within a build
+func mitigationThreadKey(buildID int64, flawID string) string {
+	return fmt.Sprintf("%d/%s", buildID, flawID)
+}
+
+// fetchMitigationThreads batch-fetches the mitigation comment threads of the findings, grouped by build.
+// SCA findings have no thread in the mitigation API, so their history comes from their annotations.
+func fetchMitigationThreads(ctx context.Context, client api.Client, proposed []api.Finding) (map[string][]api.MitigationAction, []string) {
+	flawsByBuild := make(map[int64][]int64)
+	for _, finding := range proposed {
+		if finding.ScanType == "SCA" || finding.BuildID == 0 {
+			continue
+		}
+		flawID, err := strconv.ParseInt(finding.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		flawsByBuild[finding.BuildID] = append(flawsByBuild[finding.BuildID], flawID)
+	}
+
+	threads := make(map[string][]api.MitigationAction)
+	var warnings []string
+	for buildID, flawIDs := range flawsByBuild {
+		for start := 0; start < len(flawIDs); start += mitigationInfoBatchSize {
+			batch := flawIDs[start:min(start+mitigationInfoBatchSize, len(flawIDs))]
+			info, err := client.GetMitigationInfo(ctx, buildID, batch)
+			if err != nil {
+				log.Printf("Mitigation queue: failed to get mitigation info for build %d: %v", buildID, err)
+				warnings = append(warnings, fmt.Sprintf("Could not load the comment threads for build %d, so their history comes from the Findings API: %v", buildID, err))
+				continue
+			}
+			for _, issue := range info.Issues {
+				threads[mitigationThreadKey(buildID, strconv.FormatInt(issue.FlawID, 10))] = issue.MitigationActions
+			}
+		}
+	}
+	sort.Strings(warnings)
+	return threads, warnings
+}
+
+// buildMitigationProposal describes a finding's latest mitigation proposal, with its comment thread if available
+func buildMitigationProposal(finding api.Finding, thread []api.MitigationAction) MCPMitigationProposal {
+	severity := finding.SeverityScore
+	proposal := MCPMitigationProposal{
+		FlawID:         finding.ID,
+		ScanType:       finding.ScanType,
+		BuildID:        finding.BuildID,
+		Severity:       string(TransformSeverity(&severity)),
+		ViolatesPolicy: finding.ViolatesPolicy,
+		severityScore:  finding.SeverityScore,
+	}
+	if finding.CWE != "" {
+		_, _ = fmt.Sscanf(finding.CWE, "CWE-%d", &proposal.CweID)
+		proposal.CweName = CWEName(proposal.CweID)
+	}
+	switch {
+	case finding.FilePath != "":
+		proposal.Location = fmt.Sprintf("%s:%d", finding.FilePath, finding.LineNumber)
+	case finding.URL != "":
+		proposal.Location = finding.URL
+	case finding.ComponentFilename != "":
+		proposal.Location = componentRef(finding.ComponentFilename, finding.ComponentVersion)
+	}
+
+	if annotation, ok := latestProposalAnnotation(finding.Annotations); ok {
+		proposal.Action = TransformMitigationAction(annotation.Action)
+		proposal.Submitter = annotation.UserName
+		proposal.ProposedDate = TransformDate(&annotation.Created)
+		proposal.Technique = annotation.Technique
+		proposal.Specifics = annotation.Specifics
+		proposal.RemainingRisk = annotation.RemainingRisk
+		proposal.Verification = annotation.Verification
+		proposal.Comment = annotation.Comment
+	}
+
+	if len(thread) > 0 {
+		for _, action := range thread {
+			name := action.Desc
+			if name == "" {
+				name = TransformMitigationAction(action.Action)
+			}
+			proposal.History = append(proposal.History, MCPMitigation{
+				Action:    name,
+				Comment:   action.Comment,
+				Submitter: action.Reviewer,
+				Date:      action.Date,
+			})
+		}
+	} else {
+		annotations := append([]api.Annotation(nil), finding.Annotations...)
+		sort.SliceStable(annotations, func(i, j int) bool { return annotations[i].Created < annotations[j].Created })
+		proposal.History = TransformAnnotations(annotations)
+	}
+
+	return proposal
+}
+
+// latestProposalAnnotation returns the most recent annotation that proposes a mitigation, skipping comments and review decisions
+func latestProposalAnnotation(annotations []api.Annotation) (api.Annotation, bool) {
+	var latest api.Annotation
+	found := false
+	for _, annotation := range annotations {
+		switch strings.ToUpper(annotation.Action) {
+		case "COMMENT", "ACCEPTED", "APPROVED", "REJECTED":
+			continue
+		}
+		// RFC3339 timestamps in the same zone sort lexically
+		if !found || annotation.Created >= latest.Created {
+			latest = annotation
+			found = true
+		}
+	}
+	return latest, found
+}
+
+// sortMitigationProposals orders proposals for triage: most severe first, then longest waiting
+func sortMitigationProposals(proposals []MCPMitigationProposal) {
+	sort.SliceStable(proposals, func(i, j int) bool {
+		if proposals[i].severityScore != proposals[j].severityScore {
+			return proposals[i].severityScore > proposals[j].severityScore
+		}
+		return proposals[i].ProposedDate < proposals[j].ProposedDate
+	})
+}
+
+// formatMitigationQueueResponse formats the queue into an MCP tool response
+func formatMitigationQueueResponse(queue *MCPMitigationQueue) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(queue, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format mitigation queue: %v", err)}
+	}
+
+	var summary strings.Builder
+	fmt.Fprintf(&summary, "%d mitigation proposal(s) awaiting review across %d application(s):\n", queue.TotalProposals, len(queue.Applications))
+	for _, queueApp := range queue.Applications {
+		if queueApp.Error != "" {
+			fmt.Fprintf(&summary, "- %s: %s\n", queueApp.AppProfile, queueApp.Error)
+			continue
+		}
+		fmt.Fprintf(&summary, "- %s: %d\n", queueApp.AppProfile, len(queueApp.Proposals))
+	}
+	if queue.TotalProposals > 0 {
+		summary.WriteString("\nTo approve or reject a proposal, the user must explicitly ask; then call propose-mitigation with action accepted or rejected and review set to true.\n")
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary.String() + "\n" + string(responseJSON),
+		}},
+	}
+}
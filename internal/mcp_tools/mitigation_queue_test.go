@@ -0,0 +1,165 @@
+package mcp_tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+// mitigationInfoClient answers GetMitigationInfo from canned threads; every other method is unimplemented
+type mitigationInfoClient struct {
+	api.Client
+	threads map[int64]map[int64][]api.MitigationAction // build ID -> flaw ID -> thread
+	calls   [][]int64
+}
+
+func (c *mitigationInfoClient) GetMitigationInfo(_ context.Context, buildID int64, flawIDs []int64) (*api.MitigationInfo, error) {
+	c.calls = append(c.calls, flawIDs)
+	build, ok := c.threads[buildID]
+	if !ok {
+		return nil, errors.New("API returned status 404")
+	}
+	info := &api.MitigationInfo{BuildID: buildID}
+	for _, flawID := range flawIDs {
+		if thread, ok := build[flawID]; ok {
+			info.Issues = append(info.Issues, api.MitigationIssue{FlawID: flawID, MitigationActions: thread})
+		}
+	}
+	return info, nil
+}
+
+func TestParseMitigationQueueRequest(t *testing.T) {
+	req, err := parseMitigationQueueRequest(map[string]interface{}{
+		"application_path": "/app",
+		"app_profiles":     []interface{}{"App One", "App Two"},
+		"scan_type":        "static",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(req.AppProfiles) != 2 || req.ScanType != "STATIC" {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+
+	req, err = parseMitigationQueueRequest(map[string]interface{}{"application_path": "/app", "app_profiles": "App One"})
+	if err != nil || len(req.AppProfiles) != 1 {
+		t.Errorf("Expected a single application name to be accepted, got %+v, %v", req, err)
+	}
+
+	tooMany := make([]interface{}, maxQueueApplications+1)
+	for i := range tooMany {
+		tooMany[i] = "app"
+	}
+	if _, err := parseMitigationQueueRequest(map[string]interface{}{"application_path": "/app", "app_profiles": tooMany}); err == nil {
+		t.Error("Expected error for too many applications")
+	}
+	if _, err := parseMitigationQueueRequest(map[string]interface{}{"application_path": "/app", "scan_type": "IAC"}); err == nil {
+		t.Error("Expected error for an unknown scan type")
+	}
+}
+
+func TestLatestProposalAnnotation(t *testing.T) {
+	annotations := []api.Annotation{
+		{Action: "FP", UserName: "dev1", Created: "2026-01-01T00:00:00Z"},
+		{Action: "APPDESIGN", UserName: "dev2", Created: "2026-03-01T00:00:00Z", Technique: "M1: Establish and maintain control over all of your inputs"},
+		{Action: "COMMENT", UserName: "lead", Created: "2026-04-01T00:00:00Z"},
+		{Action: "REJECTED", UserName: "lead", Created: "2026-02-01T00:00:00Z"},
+	}
+
+	latest, ok := latestProposalAnnotation(annotations)
+	if !ok || latest.UserName != "dev2" {
+		t.Errorf("Expected the APPDESIGN proposal, got %+v", latest)
+	}
+
+	if _, ok := latestProposalAnnotation([]api.Annotation{{Action: "COMMENT"}}); ok {
+		t.Error("Expected no proposal among comments")
+	}
+}
+
+func TestBuildMitigationProposal(t *testing.T) {
+	finding := api.Finding{
+		ID:            "42",
+		ScanType:      "STATIC",
+		BuildID:       123,
+		CWE:           "CWE-89",
+		SeverityScore: 4,
+		FilePath:      "src/db.go",
+		LineNumber:    17,
+		Annotations: []api.Annotation{
+			{Action: "COMMENT", Comment: "Looking into it", UserName: "dev", Created: "2026-03-02T00:00:00Z"},
+			{
+				Action: "APPDESIGN", Comment: "Allowlisted input", UserName: "dev", Created: "2026-03-01T00:00:00Z",
+				Technique: "M1", Specifics: "Only numeric IDs reach the query", RemainingRisk: "None", Verification: "Unit tests",
+			},
+		},
+	}
+
+	proposal := buildMitigationProposal(finding, nil)
+	if proposal.Action != "Mitigate by Design" || proposal.Submitter != "dev" || proposal.Technique != "M1" ||
+		proposal.Specifics == "" || proposal.RemainingRisk != "None" || proposal.Verification != "Unit tests" {
+		t.Errorf("Expected the TSRV justification from the proposal annotation, got %+v", proposal)
+	}
+	if proposal.CweID != 89 || proposal.Location != "src/db.go:17" || proposal.Severity != string(SeverityHigh) {
+		t.Errorf("Unexpected finding details: %+v", proposal)
+	}
+	if len(proposal.History) != 2 || proposal.History[0].Action != "Mitigate by Design" {
+		t.Errorf("Expected the annotations as history, oldest first, got %+v", proposal.History)
+	}
+
+	thread := []api.MitigationAction{
+		{Action: "appdesign", Desc: "Mitigate by Design", Reviewer: "dev", Date: "2026-03-01 10:00:00", Comment: "Allowlisted input"},
+		{Action: "comment", Reviewer: "lead", Date: "2026-03-03 10:00:00", Comment: "Please add tests"},
+	}
+	proposal = buildMitigationProposal(finding, thread)
+	if len(proposal.History) != 2 || proposal.History[1].Action != "Comment" || proposal.History[1].Submitter != "lead" {
+		t.Errorf("Expected the comment thread as history, got %+v", proposal.History)
+	}
+}
+
+func TestFetchMitigationThreads(t *testing.T) {
+	client := &mitigationInfoClient{threads: map[int64]map[int64][]api.MitigationAction{
+		100: {1: {{Action: "fp", Comment: "Test data"}}},
+	}}
+	proposed := []api.Finding{
+		{ID: "1", ScanType: "STATIC", BuildID: 100},
+		{ID: "2", ScanType: "STATIC", BuildID: 100},
+		{ID: "3", ScanType: "DYNAMIC", BuildID: 200},
+		{ID: "4", ScanType: "SCA", BuildID: 100},
+	}
+
+	threads, warnings := fetchMitigationThreads(context.Background(), client, proposed)
+
+	if len(client.calls) != 2 {
+		t.Errorf("Expected one call per build, got %v", client.calls)
+	}
+	for _, call := range client.calls {
+		for _, flawID := range call {
+			if flawID == 4 {
+				t.Error("SCA findings have no mitigation API thread and shouldn't be requested")
+			}
+		}
+	}
+	if len(threads[mitigationThreadKey(100, "1")]) != 1 {
+		t.Errorf("Expected the thread for flaw 1, got %+v", threads)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "build 200") {
+		t.Errorf("Expected a warning for build 200, got %v", warnings)
+	}
+}
+
+func TestSortMitigationProposals(t *testing.T) {
+	proposals := []MCPMitigationProposal{
+		{FlawID: "1", severityScore: 3, ProposedDate: "2026-01-01T00:00:00Z"},
+		{FlawID: "2", severityScore: 5, ProposedDate: "2026-03-01T00:00:00Z"},
+		{FlawID: "3", severityScore: 3, ProposedDate: "2025-12-01T00:00:00Z"},
+	}
+	sortMitigationProposals(proposals)
+
+	order := []string{proposals[0].FlawID, proposals[1].FlawID, proposals[2].FlawID}
+	if strings.Join(order, ",") != "2,3,1" {
+		t.Errorf("Expected most severe first, then oldest, got %v", order)
+	}
+}
@@ -1,12 +1,10 @@
 package mcp_tools
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"time"
 )
@@ -21,6 +19,7 @@ func init() {
 // PackageWorkspaceRequest represents the parsed parameters for package-workspace
 type PackageWorkspaceRequest struct {
 	ApplicationPath string
+	AllProjects     bool // Package every project of a monorepo workspace file
 }
 
 // parsePackageWorkspaceRequest extracts and validates parameters from the raw args map
@@ -33,6 +32,7 @@ func parsePackageWorkspaceRequest(args map[string]interface{}) (*PackageWorkspac
 	if err != nil {
 		return nil, err
 	}
+	req.AllProjects = extractFlag(args, "all_projects")
 
 	return req, nil
 }
@@ -47,6 +47,11 @@ func handlePackageWorkspace(ctx context.Context, args map[string]interface{}) (i
 		}, nil
 	}
 
+	// A monorepo packages each project in its own directory, so each has its own packaging job
+	if req.AllProjects {
+		return runForEachProject(ctx, PackageWorkspaceToolName, args, handlePackageWorkspace)
+	}
+
 	// Validate and prepare directories
 	outputDir, err := validateAndPrepareDirectories(req.ApplicationPath)
 	if err != nil {
@@ -55,16 +60,25 @@ func handlePackageWorkspace(ctx context.Context, args map[string]interface{}) (i
 		}, nil
 	}
 
-	// Clean up non-log files from output directory
-	err = cleanupOutputDirectory(outputDir)
-	if err != nil {
-		return map[string]interface{}{
-			"error": fmt.Sprintf("Failed to clean output directory: %v", err),
-		}, nil
-	}
-
-	// Build and execute packaging command
-	exitCode, duration, stdout, stderr, logFile, err := executePackagingCommand(ctx, req, outputDir)
+	// Start packaging in the background; the output directory is cleaned when the job starts,
+	// so a packaging job still running in this workspace keeps its files
+	logFile := filepath.Join(outputDir, fmt.Sprintf("veracode-packaging-%s.log", time.Now().Format(pipelineRunTimestamp)))
+	job, err := backgroundJobs.submit(jobSpec{
+		Kind:            PackageWorkspaceToolName,
+		ApplicationPath: req.ApplicationPath,
+		LogFile:         logFile,
+		OutputDir:       outputDir,
+		Exclusive:       true,
+		Prepare: func() ([]string, error) {
+			if err := cleanupOutputDirectory(outputDir); err != nil {
+				return nil, fmt.Errorf("Failed to clean output directory: %v", err)
+			}
+			return packagingArgs(req, outputDir), nil
+		},
+		NextSteps: func(exitCode int) string {
+			return packagingNextSteps(outputDir, exitCode)
+		},
+	})
 	if err != nil {
 		return map[string]interface{}{
 			"error": err.Error(),
@@ -72,7 +86,7 @@ func handlePackageWorkspace(ctx context.Context, args map[string]interface{}) (i
 	}
 
 	// Build and return response
-	return buildPackagingResponse(req, outputDir, exitCode, duration, stdout, stderr, logFile), nil
+	return buildPackagingResponse(req, outputDir, job), nil
 }
 
 // validateAndPrepareDirectories validates the application path and creates the output directory
@@ -86,9 +100,8 @@ func validateAndPrepareDirectories(applicationPath string) (string, error) {
 		return "", fmt.Errorf("Failed to access application path: %v", err)
 	}
 
-	// Create output directory (temp/.veracode/{app}/packaging)
-	outputDir := veracodeWorkDir(applicationPath, "packaging")
-	err = os.MkdirAll(outputDir, 0750)
+	// Create output directory ({workspace_dir}/packaging)
+	outputDir, err := createVeracodeWorkDir(applicationPath, "packaging")
 	if err != nil {
 		return "", fmt.Errorf("Failed to create output directory: %v", err)
 	}
@@ -128,10 +141,9 @@ func cleanupOutputDirectory(outputDir string) error {
 	return nil
 }
 
-// executePackagingCommand builds and executes the Veracode packaging command
-func executePackagingCommand(ctx context.Context, req *PackageWorkspaceRequest, outputDir string) (int, time.Duration, bytes.Buffer, bytes.Buffer, *os.File, error) {
-	// Build command arguments
-	cmdArgs := []string{
+// packagingArgs builds the Veracode packaging command arguments
+func packagingArgs(req *PackageWorkspaceRequest, outputDir string) []string {
+	return []string{
 		"package",
 		"-t", "directory", // Target type is directory
 		"-a",                      // Trust the source directory
@@ -139,116 +151,49 @@ func executePackagingCommand(ctx context.Context, req *PackageWorkspaceRequest,
 		"-o", outputDir, // Output directory
 		"-v", // Verbose flag
 	}
-
-	// #nosec G204 -- veracode command is hardcoded, only arguments are user-controlled and validated
-	cmd := exec.CommandContext(ctx, "veracode", cmdArgs...)
-
-	var stdout, stderr bytes.Buffer
-	var logFile *os.File
-	var err error
-
-	// Set up logging
-	timestamp := time.Now().Format("20060102-150405")
-	logFilePath := filepath.Join(outputDir, fmt.Sprintf("veracode-packaging-%s.log", timestamp))
-	// #nosec G304 -- logFilePath is constructed from validated outputDir and timestamp, not user input
-	logFile, err = os.Create(logFilePath)
-	if err != nil {
-		return 0, 0, stdout, stderr, nil, fmt.Errorf("Failed to create log file: %v", err)
-	}
-
-	// Write to log file
-	cmd.Stdout = logFile
-	cmd.Stderr = logFile
-
-	// Execute the command
-	startTime := time.Now()
-	err = cmd.Run()
-	duration := time.Since(startTime)
-
-	// Extract exit code
-	exitCode := 0
-	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			exitCode = exitErr.ExitCode()
-		}
-	}
-
-	// Write captured output to log file if requested
-	if _, err := logFile.WriteString("=== STDOUT ===\n"); err != nil {
-		log.Printf("Failed to write stdout header to log: %v", err)
-	}
-	if _, err := logFile.Write(stdout.Bytes()); err != nil {
-		log.Printf("Failed to write stdout to log: %v", err)
-	}
-	if _, err := logFile.WriteString("\n\n=== STDERR ===\n"); err != nil {
-		log.Printf("Failed to write stderr header to log: %v", err)
-	}
-	if _, err := logFile.Write(stderr.Bytes()); err != nil {
-		log.Printf("Failed to write stderr to log: %v", err)
-	}
-
-	logFile.Close()
-
-	return exitCode, duration, stdout, stderr, logFile, nil
 }
 
-// buildPackagingResponse constructs the response based on command execution results
-func buildPackagingResponse(req *PackageWorkspaceRequest, outputDir string, exitCode int, duration time.Duration, stdout, stderr bytes.Buffer, logFile *os.File) map[string]interface{} {
-	// Interpret the exit code
-	cliInfo := InterpretVeracodeExitCode(exitCode)
-
-	// Build command arguments for display
-	cmdArgs := []string{
-		"package",
-		"-s", "-t", "directory", "-a",
-		"-s", req.ApplicationPath,
-		"-o", outputDir,
-		"-v",
+// packagingNextSteps describes what to do once packaging has exited
+func packagingNextSteps(outputDir string, exitCode int) string {
+	switch exitCode {
+	case 0:
+		return fmt.Sprintf("Next steps:\n- Review packaging results in: %s\n- Upload the packaged artifact to Veracode\n- Submit for security scanning", outputDir)
+	case 3:
+		return fmt.Sprintf("Next steps:\n- Review packaging results in: %s\n- Check policy violations\n- Address policy issues before submission", outputDir)
+	default:
+		return InterpretVeracodeExitCode(exitCode).NextSteps
 	}
+}
 
-	// Build base response information
-	baseInfo := fmt.Sprintf(`Veracode Workspace Packaging
+// buildPackagingResponse describes the packaging job that was started
+func buildPackagingResponse(req *PackageWorkspaceRequest, outputDir string, job *Job) map[string]interface{} {
+	responseText := fmt.Sprintf(`Veracode Workspace Packaging
 ============================
 
 Application Path: %s
 Output Directory: %s
-Duration: %v
-Exit Code: %d
-
-Command executed:
-veracode %s
-
-`, req.ApplicationPath, outputDir, duration, exitCode, joinArgs(cmdArgs))
-
-	// Customize next steps for packaging context
-	nextSteps := cliInfo.NextSteps
-	switch exitCode {
-	case 0:
-		nextSteps = fmt.Sprintf("Next steps:\n- Review packaging results in: %s\n- Upload the packaged artifact to Veracode\n- Submit for security scanning", outputDir)
-	case 3:
-		nextSteps = fmt.Sprintf("Next steps:\n- Review packaging results in: %s\n- Check policy violations\n- Address policy issues before submission", outputDir)
-	}
+Job: %s
+Status: %s
+Log file: %s
 
-	responseText := baseInfo + fmt.Sprintf("%s %s\n\n", cliInfo.Icon, cliInfo.Message)
+`, req.ApplicationPath, outputDir, job.ID, job.State, job.LogFile)
 
-	responseText += fmt.Sprintf("Log file: %s\n", logFile.Name())
-
-	if stdout.Len() > 0 {
-		responseText += fmt.Sprintf("\nOutput:\n%s\n", stdout.String())
+	// A job that couldn't start (e.g. the Veracode CLI isn't installed) has already failed
+	if job.State == JobFailed {
+		return map[string]interface{}{
+			"error": responseText + fmt.Sprintf("❌ Packaging could not start: %s", job.Error),
+		}
 	}
 
-	if stderr.Len() > 0 {
-		responseText += fmt.Sprintf("\nError output:\n%s\n", stderr.String())
+	if job.Command != "" {
+		responseText += fmt.Sprintf("Command executed:\n%s\n\n", job.Command)
 	}
+	responseText += fmt.Sprintf(`Packaging runs in the background.
 
-	responseText += fmt.Sprintf("\n%s", nextSteps)
-
-	// Return as error for truly failing exit codes, but as content for warnings
-	if exitCode > 0 && !cliInfo.IsWarning {
-		return map[string]interface{}{
-			"error": responseText,
-		}
-	}
+Next steps:
+- Use job-status with job_id %s to check when packaging has finished
+- pipeline-scan started now waits for packaging to finish before scanning
+`, job.ID)
 
 	return map[string]interface{}{
 		"content": []map[string]string{{
@@ -257,20 +202,3 @@ veracode %s
 		}},
 	}
 }
-
-// joinArgs joins command arguments for display purposes
-func joinArgs(args []string) string {
-	result := ""
-	for i, arg := range args {
-		if i > 0 {
-			result += " "
-		}
-		// Quote arguments that contain spaces
-		if len(arg) > 0 && (arg[0] == '-' || filepath.IsAbs(arg)) {
-			result += arg
-		} else {
-			result += fmt.Sprintf("%q", arg)
-		}
-	}
-	return result
-}
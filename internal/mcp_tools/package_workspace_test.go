@@ -119,8 +119,9 @@ func TestPackageWorkspaceTool_HandleNonexistentPath(t *testing.T) {
 func TestPackageWorkspaceTool_HandleValidPath(t *testing.T) {
 	ctx := context.Background()
 
-	// Create a temporary directory
+	// Create a temporary directory, keeping the working directory out of the user cache
 	tempDir := t.TempDir()
+	t.Setenv(workDirEnvVar, t.TempDir())
 
 	// Create some test files
 	testFile := filepath.Join(tempDir, "test.txt")
@@ -148,11 +149,12 @@ func TestPackageWorkspaceTool_HandleValidPath(t *testing.T) {
 }
 
 func TestPackageWorkspaceTool_OutputDirectory(t *testing.T) {
-	// Create a temporary directory
+	// Create a temporary directory, keeping the working directory out of the user cache
 	tempDir := t.TempDir()
+	t.Setenv(workDirEnvVar, t.TempDir())
 
 	// The expected output directory should be created
-	expectedDir := filepath.Join(tempDir, ".veracode", "packaging")
+	expectedDir := veracodeWorkDir(tempDir, "packaging")
 
 	// Call the handler (even if it fails, directory should be checked)
 	ctx := context.Background()
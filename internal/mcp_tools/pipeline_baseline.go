@@ -0,0 +1,345 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const CreatePipelineBaselineToolName = "create-pipeline-baseline"
+
+// Baseline sources
+const (
+	baselineSourcePipeline = "PIPELINE" // Every flaw in the latest pipeline results
+	baselineSourcePlatform = "PLATFORM" // Only flaws with an approved mitigation in the platform
+)
+
+// baselineDisabled is the pipeline-scan baseline value that scans without a baseline
+const baselineDisabled = "none"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(CreatePipelineBaselineToolName, handleCreatePipelineBaseline)
+}
+
+// CreatePipelineBaselineRequest represents the parsed parameters for create-pipeline-baseline
+type CreatePipelineBaselineRequest struct {
+	ApplicationPath string
+	AppProfile      string
+	Sandbox         string
+	Source          string // PIPELINE or PLATFORM
+}
+
+// MCPPipelineBaseline describes a baseline file written by create-pipeline-baseline
+type MCPPipelineBaseline struct {
+	BaselineFile  string `json:"baseline_file"`
+	Source        string `json:"source"`
+	ResultsFile   string `json:"results_file"`
+	PipelineFlaws int    `json:"pipeline_flaws"`           // Flaws in the pipeline results
+	BaselineFlaws int    `json:"baseline_flaws"`           // Flaws written to the baseline
+	ApprovedFlaws int    `json:"approved_flaws,omitempty"` // Platform findings with an approved mitigation (PLATFORM only)
+}
+
+// pipelineResultsDocument keeps a pipeline results file as raw JSON, so flaws are written to the
+// baseline exactly as the scanner produced them, including flaw_match fields this package doesn't model
+type pipelineResultsDocument struct {
+	fields   map[string]json.RawMessage
+	findings []json.RawMessage
+	flaws    []PipelineFlaw // findings decoded, in the same order
+}
+
+// pipelineBaselinePath returns the default baseline location, which is kept in the repository
+// (unlike the scan output) so the whole team scans against the same accepted flaws
+func pipelineBaselinePath(applicationPath string) string {
+	return filepath.Join(applicationPath, ".veracode", "baseline.json")
+}
+
+// parseCreatePipelineBaselineRequest extracts and validates parameters from the raw args map
+func parseCreatePipelineBaselineRequest(args map[string]interface{}) (*CreatePipelineBaselineRequest, error) {
+	req := &CreatePipelineBaselineRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Sandbox, _ = extractOptionalString(args, "sandbox")
+
+	req.Source, err = extractOptionalEnum(args, "source", []string{baselineSourcePipeline, baselineSourcePlatform})
+	if err != nil {
+		return nil, err
+	}
+	if req.Source == "" {
+		req.Source = baselineSourcePipeline
+	}
+
+	return req, nil
+}
+
+// handleCreatePipelineBaseline writes a pipeline scan baseline from the latest pipeline results
+func handleCreatePipelineBaseline(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseCreatePipelineBaselineRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Load the latest pipeline results; both sources need the scanner's flaw_match data
+	resultsFile, err := latestPipelineFile(veracodeWorkDir(req.ApplicationPath, "pipeline"), "results-", ".json")
+	if err != nil {
+		return createBaselineError(req.ApplicationPath, "Error: No pipeline results found\n\nRun a scan with pipeline-scan and wait for pipeline-status to report it complete."), nil
+	}
+	results, err := loadPipelineResultsDocument(resultsFile)
+	if err != nil {
+		return createBaselineError(req.ApplicationPath, fmt.Sprintf("Error: Failed to read %s\n\n%v", resultsFile, err)), nil
+	}
+
+	baseline := &MCPPipelineBaseline{
+		BaselineFile:  pipelineBaselinePath(req.ApplicationPath),
+		Source:        req.Source,
+		ResultsFile:   resultsFile,
+		PipelineFlaws: len(results.flaws),
+	}
+
+	// Step 2: Choose the flaws to accept
+	keep := func(PipelineFlaw) bool { return true }
+	if req.Source == baselineSourcePlatform {
+		approved, message := fetchApprovedStaticFindings(ctx, req)
+		if message != "" {
+			return createBaselineError(req.ApplicationPath, message), nil
+		}
+		baseline.ApprovedFlaws = len(approved)
+		keep = func(flaw PipelineFlaw) bool { return matchesApprovedFinding(flaw, approved) }
+	}
+
+	// Step 3: Write the baseline into the repository
+	baseline.BaselineFlaws, err = writePipelineBaseline(baseline.BaselineFile, results, keep)
+	if err != nil {
+		return createBaselineError(req.ApplicationPath, fmt.Sprintf("Error: Failed to write baseline\n\n%v", err)), nil
+	}
+
+	return formatPipelineBaselineResponse(baseline), nil
+}
+
+// createBaselineError formats an error as an MCP tool response
+func createBaselineError(applicationPath, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Create Pipeline Baseline - Error\n================================\n\nApplication Path: %s\n%s", applicationPath, message),
+		}},
+	}
+}
+
+// fetchApprovedStaticFindings returns the application's static findings with an approved mitigation.
+// On failure it returns a message for the response instead.
+func fetchApprovedStaticFindings(ctx context.Context, req *CreatePipelineBaselineRequest) ([]api.Finding, string) {
+	appProfile := req.AppProfile
+	sandboxName := req.Sandbox
+	if appProfile == "" {
+		wsConfig, err := workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return nil, fmt.Sprintf("Error: Failed to find workspace configuration\n\n%v\n\nPass app_profile to build the baseline from the platform.", err)
+		}
+		appProfile = wsConfig.Application()
+		if sandboxName == "" {
+			sandboxName = wsConfig.Sandbox
+		}
+	}
+
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return nil, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)
+	}
+
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return nil, fmt.Sprintf("Error: Failed to lookup application %q\n\n%v", appProfile, err)
+	}
+
+	sandbox, err := resolveSandbox(ctx, client, application.GetGuid(), sandboxName)
+	if err != nil {
+		return nil, fmt.Sprintf("Error: Failed to find sandbox %q\n\n%v", sandboxName, err)
+	}
+
+	// Every page is read, so no approved mitigation is missing from the baseline;
+	// only the approved findings are kept
+	findingsReq := api.FindingsRequest{AppProfile: application.GetGuid(), Sandbox: sandboxGUID(sandbox)}
+	var approved []api.Finding
+	for finding, err := range client.IterateFindings(ctx, findingsReq, "STATIC") {
+		if err != nil {
+			return nil, fmt.Sprintf("Error: Failed to retrieve static findings for %s\n\n%v", appProfile, err)
+		}
+		if strings.EqualFold(finding.ResolutionStatus, "APPROVED") {
+			approved = append(approved, finding)
+		}
+	}
+	return approved, ""
+}
+
+// matchesApprovedFinding reports whether a pipeline flaw is the same flaw as an approved platform finding.
+// The platform doesn't expose the scanner's flaw hashes, so flaws are matched by CWE and file, then by
+// function where both sides name one, and by line otherwise.
+func matchesApprovedFinding(flaw PipelineFlaw, approved []api.Finding) bool {
+	source := flaw.Files.SourceFile
+	for _, finding := range approved {
+		if strings.TrimPrefix(finding.CWE, "CWE-") != flaw.CWEID || !samePipelineFile(finding.FilePath, source.File) {
+			continue
+		}
+		if finding.Procedure != "" && source.FunctionName != "" {
+			if strings.EqualFold(finding.Procedure, source.FunctionName) {
+				return true
+			}
+			continue
+		}
+		if finding.LineNumber == source.Line {
+			return true
+		}
+	}
+	return false
+}
+
+// samePipelineFile reports whether two source paths name the same file; the platform and the
+// pipeline scanner may report paths relative to different roots, so one may be a suffix of the other
+func samePipelineFile(a, b string) bool {
+	a = strings.TrimPrefix(filepath.ToSlash(a), "/")
+	b = strings.TrimPrefix(filepath.ToSlash(b), "/")
+	if a == "" || b == "" {
+		return false
+	}
+	return a == b || strings.HasSuffix(a, "/"+b) || strings.HasSuffix(b, "/"+a)
+}
+
+// loadPipelineResultsDocument reads a pipeline results or baseline file
+func loadPipelineResultsDocument(path string) (*pipelineResultsDocument, error) {
+	// #nosec G304 -- path is a pipeline results or baseline file located by this package
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &pipelineResultsDocument{}
+	if err := json.Unmarshal(data, &doc.fields); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	if raw, ok := doc.fields["findings"]; ok {
+		if err := json.Unmarshal(raw, &doc.findings); err != nil {
+			return nil, fmt.Errorf("failed to parse findings in %s: %w", filepath.Base(path), err)
+		}
+	}
+
+	doc.flaws = make([]PipelineFlaw, len(doc.findings))
+	for i, raw := range doc.findings {
+		if err := json.Unmarshal(raw, &doc.flaws[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse finding %d in %s: %w", i, filepath.Base(path), err)
+		}
+	}
+	return doc, nil
+}
+
+// writePipelineBaseline writes the flaws accepted by keep to path in the pipeline results format
+// the scanner reads with --baseline-file, returning how many flaws were written
+func writePipelineBaseline(path string, results *pipelineResultsDocument, keep func(PipelineFlaw) bool) (int, error) {
+	kept := make([]json.RawMessage, 0, len(results.findings))
+	for i, flaw := range results.flaws {
+		if keep(flaw) {
+			kept = append(kept, results.findings[i])
+		}
+	}
+
+	fields := make(map[string]json.RawMessage, len(results.fields)+1)
+	for key, value := range results.fields {
+		fields[key] = value
+	}
+	findingsJSON, err := json.Marshal(kept)
+	if err != nil {
+		return 0, err
+	}
+	fields["findings"] = findingsJSON
+
+	data, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	// #nosec G306 -- the baseline is committed to the repository alongside the source
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return 0, err
+	}
+	return len(kept), nil
+}
+
+// loadPipelineBaseline returns the identities of the flaws in the repository's baseline,
+// or nil if the application has no baseline
+func loadPipelineBaseline(applicationPath string) (map[string]bool, error) {
+	path := pipelineBaselinePath(applicationPath)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	baseline, err := loadPipelineResultsDocument(path)
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make(map[string]bool, len(baseline.flaws))
+	for _, flaw := range baseline.flaws {
+		identities[pipelineFlawIdentity(flaw)] = true
+	}
+	return identities, nil
+}
+
+// pipelineFlawIdentity identifies a flaw across scans. Unlike flawKey it leaves out issue_id,
+// which the scanner renumbers on every run.
+func pipelineFlawIdentity(f PipelineFlaw) string {
+	return fmt.Sprintf("%s:%s:%s", f.FlawMatch.FlawHash, f.CWEID, filepath.ToSlash(f.Files.SourceFile.File))
+}
+
+// withoutBaselineFlaws returns the flaws that are not in the baseline
+func withoutBaselineFlaws(flaws []PipelineFlaw, baseline map[string]bool) []PipelineFlaw {
+	remaining := make([]PipelineFlaw, 0, len(flaws))
+	for _, flaw := range flaws {
+		if !baseline[pipelineFlawIdentity(flaw)] {
+			remaining = append(remaining, flaw)
+		}
+	}
+	return remaining
+}
+
+// formatPipelineBaselineResponse formats the written baseline into an MCP tool response
+func formatPipelineBaselineResponse(baseline *MCPPipelineBaseline) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format baseline response: %v", err)}
+	}
+
+	summary := fmt.Sprintf("Wrote %d of %d pipeline flaws to %s.", baseline.BaselineFlaws, baseline.PipelineFlaws, baseline.BaselineFile)
+	if baseline.Source == baselineSourcePlatform {
+		summary += fmt.Sprintf(" %d static findings have an approved mitigation in the platform; flaws were matched by CWE, file and function or line.", baseline.ApprovedFlaws)
+	}
+	summary += "\nCommit the baseline so everyone scans against it. pipeline-scan passes it with --baseline-file, and pipeline-findings hides the flaws it contains."
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
@@ -0,0 +1,161 @@
+package mcp_tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+const testPipelineResults = `{
+  "scan_id": "scan-1",
+  "scan_status": "SUCCESS",
+  "findings": [
+    {"issue_id": 1000, "cwe_id": "89", "severity": 4, "files": {"source_file": {"file": "com/example/UserDao.java", "line": 57, "function_name": "findUser"}},
+     "flaw_match": {"flaw_hash": "111", "procedure_hash": "p1", "prototype_hash": "r1"}},
+    {"issue_id": 1001, "cwe_id": "80", "severity": 3, "files": {"source_file": {"file": "view.jsp", "line": 9}},
+     "flaw_match": {"flaw_hash": "222"}}
+  ]
+}`
+
+// pipelineResultsWorkspace returns an application directory with pipeline results written to its work directory
+func pipelineResultsWorkspace(t *testing.T, name, results string) string {
+	t.Helper()
+	appPath := filepath.Join(t.TempDir(), name)
+	outputDir := veracodeWorkDir(appPath, "pipeline")
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(outputDir)) })
+	if err := os.MkdirAll(outputDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "results-20261001-100000.json"), []byte(results), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return appPath
+}
+
+func TestParseCreatePipelineBaselineRequest(t *testing.T) {
+	req, err := parseCreatePipelineBaselineRequest(map[string]interface{}{"application_path": "/work/app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Source != baselineSourcePipeline {
+		t.Errorf("default source = %q, want %q", req.Source, baselineSourcePipeline)
+	}
+
+	req, err = parseCreatePipelineBaselineRequest(map[string]interface{}{"application_path": "/work/app", "source": "platform", "sandbox": "dev"})
+	if err != nil || req.Source != baselineSourcePlatform || req.Sandbox != "dev" {
+		t.Errorf("unexpected request: %+v, %v", req, err)
+	}
+
+	if _, err := parseCreatePipelineBaselineRequest(map[string]interface{}{"application_path": "/work/app", "source": "sca"}); err == nil {
+		t.Error("expected error for unsupported source")
+	}
+}
+
+func TestCreatePipelineBaseline_FromPipeline(t *testing.T) {
+	appPath := pipelineResultsWorkspace(t, "baseline-pipeline-app", testPipelineResults)
+
+	result, err := handleCreatePipelineBaseline(context.Background(), map[string]interface{}{"application_path": appPath})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	text := result.(map[string]interface{})["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "Wrote 2 of 2 pipeline flaws") {
+		t.Errorf("unexpected response: %s", text)
+	}
+
+	// The baseline keeps the scanner's flaw_match data that PipelineFlaw doesn't model
+	data, err := os.ReadFile(pipelineBaselinePath(appPath))
+	if err != nil {
+		t.Fatalf("baseline was not written: %v", err)
+	}
+	if !strings.Contains(string(data), `"procedure_hash": "p1"`) || !strings.Contains(string(data), `"scan_id": "scan-1"`) {
+		t.Errorf("baseline should keep the results as the scanner wrote them:\n%s", data)
+	}
+
+	baseline, err := loadPipelineBaseline(appPath)
+	if err != nil || len(baseline) != 2 {
+		t.Fatalf("loadPipelineBaseline() = %v, %v", baseline, err)
+	}
+}
+
+func TestCreatePipelineBaseline_NoResults(t *testing.T) {
+	appPath := filepath.Join(t.TempDir(), "baseline-missing-app")
+
+	result, _ := handleCreatePipelineBaseline(context.Background(), map[string]interface{}{"application_path": appPath})
+	text := result.(map[string]interface{})["content"].([]map[string]string)[0]["text"]
+	if !strings.Contains(text, "No pipeline results found") {
+		t.Errorf("unexpected response: %s", text)
+	}
+}
+
+func TestLoadPipelineBaseline_Missing(t *testing.T) {
+	baseline, err := loadPipelineBaseline(t.TempDir())
+	if err != nil || baseline != nil {
+		t.Errorf("expected no baseline, got %v, %v", baseline, err)
+	}
+}
+
+func TestMatchesApprovedFinding(t *testing.T) {
+	flaw := PipelineFlaw{CWEID: "89", Files: FileInfo{SourceFile: SourceFileInfo{File: "com/example/UserDao.java", Line: 57, FunctionName: "findUser"}}}
+
+	tests := []struct {
+		name    string
+		finding api.Finding
+		want    bool
+	}{
+		{"same function, moved line", api.Finding{CWE: "CWE-89", FilePath: "src/main/java/com/example/UserDao.java", Procedure: "findUser", LineNumber: 60}, true},
+		{"different function", api.Finding{CWE: "CWE-89", FilePath: "com/example/UserDao.java", Procedure: "saveUser", LineNumber: 57}, false},
+		{"same line, no function", api.Finding{CWE: "CWE-89", FilePath: "com/example/UserDao.java", LineNumber: 57}, true},
+		{"different CWE", api.Finding{CWE: "CWE-80", FilePath: "com/example/UserDao.java", Procedure: "findUser"}, false},
+		{"different file", api.Finding{CWE: "CWE-89", FilePath: "com/example/OtherUserDao.java", Procedure: "findUser"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesApprovedFinding(flaw, []api.Finding{tt.finding}); got != tt.want {
+				t.Errorf("matchesApprovedFinding() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutBaselineFlaws(t *testing.T) {
+	accepted := PipelineFlaw{IssueID: 1000, CWEID: "89", FlawMatch: FlawMatch{FlawHash: "111"}, Files: FileInfo{SourceFile: SourceFileInfo{File: "UserDao.java"}}}
+	baseline := map[string]bool{pipelineFlawIdentity(accepted): true}
+
+	// The scanner renumbers issue IDs, so the accepted flaw matches under a new ID
+	renumbered := accepted
+	renumbered.IssueID = 2000
+	newFlaw := PipelineFlaw{IssueID: 2001, CWEID: "89", FlawMatch: FlawMatch{FlawHash: "333"}, Files: FileInfo{SourceFile: SourceFileInfo{File: "UserDao.java"}}}
+
+	remaining := withoutBaselineFlaws([]PipelineFlaw{renumbered, newFlaw}, baseline)
+	if len(remaining) != 1 || remaining[0].IssueID != 2001 {
+		t.Errorf("expected only the new flaw, got %+v", remaining)
+	}
+}
+
+func TestPipelineFindings_HidesBaselineFlaws(t *testing.T) {
+	appPath := pipelineResultsWorkspace(t, "baseline-findings-app", testPipelineResults)
+	results, err := loadPipelineResultsDocument(filepath.Join(veracodeWorkDir(appPath, "pipeline"), "results-20261001-100000.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writePipelineBaseline(pipelineBaselinePath(appPath), results, func(f PipelineFlaw) bool { return f.CWEID == "89" }); err != nil {
+		t.Fatal(err)
+	}
+
+	result, _ := handlePipelineFindings(context.Background(), map[string]interface{}{"application_path": appPath, "violates_policy": false})
+	text := result.(map[string]interface{})["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "1 findings in the baseline") || strings.Contains(text, "UserDao.java") {
+		t.Errorf("the baseline flaw should be hidden:\n%s", text)
+	}
+
+	result, _ = handlePipelineFindings(context.Background(), map[string]interface{}{"application_path": appPath, "violates_policy": false, "include_baseline": true})
+	text = result.(map[string]interface{})["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "UserDao.java") {
+		t.Errorf("include_baseline should show the baseline flaw:\n%s", text)
+	}
+}
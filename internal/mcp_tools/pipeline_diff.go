@@ -0,0 +1,316 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+const PipelineDiffToolName = "pipeline-diff"
+
+// maxPipelineDiffFlaws caps each list of flaws in a diff; the summary always has the full counts
+const maxPipelineDiffFlaws = 100
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(PipelineDiffToolName, handlePipelineDiff)
+}
+
+// PipelineDiffRequest represents the parsed parameters for pipeline-diff
+type PipelineDiffRequest struct {
+	ApplicationPath string
+	BaseRun         string // Defaults to the run before HeadRun
+	HeadRun         string // Defaults to the latest run with results
+}
+
+// MCPPipelineDiff compares the flaws found by two pipeline scan runs
+type MCPPipelineDiff struct {
+	BaseRun   MCPPipelineRunInfo    `json:"base_run"`
+	HeadRun   MCPPipelineRunInfo    `json:"head_run"`
+	Summary   MCPPipelineDiffCounts `json:"summary"`
+	New       []MCPPipelineDiffFlaw `json:"new"`
+	Fixed     []MCPPipelineDiffFlaw `json:"fixed"`
+	Unchanged []MCPPipelineDiffFlaw `json:"unchanged"`
+}
+
+// MCPPipelineRunInfo identifies a run in a diff
+type MCPPipelineRunInfo struct {
+	ID         string `json:"id"`
+	StartedAt  string `json:"started_at,omitempty"`
+	ScanTarget string `json:"scan_target,omitempty"`
+	Flaws      int    `json:"flaws"`
+}
+
+// MCPPipelineDiffCounts summarizes a diff
+type MCPPipelineDiffCounts struct {
+	New             int            `json:"new"`
+	Fixed           int            `json:"fixed"`
+	Unchanged       int            `json:"unchanged"`
+	SeverityChanged int            `json:"severity_changed"` // Unchanged flaws whose severity differs between the runs
+	SeverityDelta   map[string]int `json:"severity_delta"`   // Head minus base flaw count per severity
+	Truncated       bool           `json:"truncated,omitempty"`
+}
+
+// MCPPipelineDiffFlaw is a flaw in a diff, as found by the head run (or the base run, for fixed flaws)
+type MCPPipelineDiffFlaw struct {
+	IssueID          int    `json:"issue_id"`
+	Title            string `json:"title"`
+	CweId            string `json:"cwe_id"`
+	Severity         string `json:"severity"`
+	PreviousSeverity string `json:"previous_severity,omitempty"` // Set when an unchanged flaw's severity changed
+	File             string `json:"file"`
+	Line             int    `json:"line"`
+	Function         string `json:"function,omitempty"`
+}
+
+// parsePipelineDiffRequest extracts and validates parameters from the raw args map
+func parsePipelineDiffRequest(args map[string]interface{}) (*PipelineDiffRequest, error) {
+	req := &PipelineDiffRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.BaseRun, _ = extractOptionalString(args, "base_run")
+	req.HeadRun, _ = extractOptionalString(args, "head_run")
+
+	return req, nil
+}
+
+// handlePipelineDiff compares two pipeline scan runs
+func handlePipelineDiff(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parsePipelineDiffRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Choose the runs to compare
+	outputDir := veracodeWorkDir(req.ApplicationPath, "pipeline")
+	runs, err := loadPipelineRuns(outputDir)
+	if err != nil {
+		return pipelineDiffError(req.ApplicationPath, fmt.Sprintf("Error: %v", err)), nil
+	}
+	base, head, err := selectPipelineDiffRuns(completedPipelineRuns(runs), req.BaseRun, req.HeadRun)
+	if err != nil {
+		return pipelineDiffError(req.ApplicationPath, fmt.Sprintf("Error: %v\n\nAvailable runs:\n%s", err, formatPipelineRunList(runs))), nil
+	}
+
+	// Step 2: Load both runs' results
+	baseResults, err := loadPipelineResultsDocument(base.ResultsFile)
+	if err != nil {
+		return pipelineDiffError(req.ApplicationPath, fmt.Sprintf("Error: Failed to read run %s\n\n%v", base.ID, err)), nil
+	}
+	headResults, err := loadPipelineResultsDocument(head.ResultsFile)
+	if err != nil {
+		return pipelineDiffError(req.ApplicationPath, fmt.Sprintf("Error: Failed to read run %s\n\n%v", head.ID, err)), nil
+	}
+
+	// Step 3: Match flaws across the runs
+	diff := diffPipelineFlaws(baseResults.flaws, headResults.flaws)
+	diff.BaseRun = MCPPipelineRunInfo{ID: base.ID, StartedAt: base.StartedAt, ScanTarget: base.ScanTarget, Flaws: len(baseResults.flaws)}
+	diff.HeadRun = MCPPipelineRunInfo{ID: head.ID, StartedAt: head.StartedAt, ScanTarget: head.ScanTarget, Flaws: len(headResults.flaws)}
+
+	return formatPipelineDiffResponse(diff), nil
+}
+
+// pipelineDiffError formats an error as an MCP tool response
+func pipelineDiffError(applicationPath, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Pipeline Diff - Error\n=====================\n\nApplication Path: %s\n%s", applicationPath, message),
+		}},
+	}
+}
+
+// completedPipelineRuns returns the runs that left a results file, oldest first
+func completedPipelineRuns(runs []pipelineRun) []pipelineRun {
+	var completed []pipelineRun
+	for _, run := range runs {
+		if _, err := findMostRecentFile(filepath.Dir(run.ResultsFile), "results-", ".json"); err == nil {
+			completed = append(completed, run)
+		}
+	}
+	return completed
+}
+
+// selectPipelineDiffRuns picks the runs to compare: headID defaults to the latest run and
+// baseID to the run before the head
+func selectPipelineDiffRuns(runs []pipelineRun, baseID, headID string) (*pipelineRun, *pipelineRun, error) {
+	if len(runs) < 2 && (baseID == "" || headID == "") {
+		return nil, nil, fmt.Errorf("at least two completed pipeline runs are needed to compare, found %d", len(runs))
+	}
+
+	find := func(id string) int {
+		for i := range runs {
+			if runs[i].ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	headIndex := len(runs) - 1
+	if headID != "" {
+		if headIndex = find(headID); headIndex < 0 {
+			return nil, nil, fmt.Errorf("head_run %q is not a completed pipeline run", headID)
+		}
+	}
+
+	baseIndex := headIndex - 1
+	if baseID != "" {
+		if baseIndex = find(baseID); baseIndex < 0 {
+			return nil, nil, fmt.Errorf("base_run %q is not a completed pipeline run", baseID)
+		}
+	}
+	if baseIndex < 0 {
+		return nil, nil, fmt.Errorf("run %s is the oldest completed run, so there is nothing to compare it with", runs[headIndex].ID)
+	}
+	if baseIndex == headIndex {
+		return nil, nil, fmt.Errorf("base_run and head_run are the same run")
+	}
+
+	return &runs[baseIndex], &runs[headIndex], nil
+}
+
+// diffPipelineFlaws matches flaws by flaw hash, CWE and file. A flaw found more than once
+// (the same identity at several locations) is matched as many times as it occurs in both runs.
+func diffPipelineFlaws(base, head []PipelineFlaw) *MCPPipelineDiff {
+	diff := &MCPPipelineDiff{
+		New:       []MCPPipelineDiffFlaw{},
+		Fixed:     []MCPPipelineDiffFlaw{},
+		Unchanged: []MCPPipelineDiffFlaw{},
+	}
+	diff.Summary.SeverityDelta = map[string]int{
+		"very high": 0,
+		"high":      0,
+		"medium":    0,
+		"low":       0,
+		"very low":  0,
+		"info":      0,
+	}
+
+	unmatched := make(map[string][]PipelineFlaw)
+	for _, flaw := range base {
+		identity := pipelineFlawIdentity(flaw)
+		unmatched[identity] = append(unmatched[identity], flaw)
+		diff.Summary.SeverityDelta[transformPipelineSeverity(flaw.Severity)]--
+	}
+
+	for _, flaw := range head {
+		diff.Summary.SeverityDelta[transformPipelineSeverity(flaw.Severity)]++
+
+		identity := pipelineFlawIdentity(flaw)
+		previous := unmatched[identity]
+		if len(previous) == 0 {
+			diff.New = append(diff.New, pipelineDiffFlaw(flaw))
+			continue
+		}
+		unmatched[identity] = previous[1:]
+
+		entry := pipelineDiffFlaw(flaw)
+		if previous[0].Severity != flaw.Severity {
+			entry.PreviousSeverity = transformPipelineSeverity(previous[0].Severity)
+			diff.Summary.SeverityChanged++
+		}
+		diff.Unchanged = append(diff.Unchanged, entry)
+	}
+
+	for _, flaw := range base {
+		identity := pipelineFlawIdentity(flaw)
+		if remaining := unmatched[identity]; len(remaining) > 0 {
+			unmatched[identity] = remaining[1:]
+			diff.Fixed = append(diff.Fixed, pipelineDiffFlaw(flaw))
+		}
+	}
+
+	diff.Summary.New = len(diff.New)
+	diff.Summary.Fixed = len(diff.Fixed)
+	diff.Summary.Unchanged = len(diff.Unchanged)
+
+	// Most severe first; severity changes lead the unchanged flaws
+	for _, flaws := range [][]MCPPipelineDiffFlaw{diff.New, diff.Fixed, diff.Unchanged} {
+		sort.SliceStable(flaws, func(i, j int) bool {
+			if (flaws[i].PreviousSeverity != "") != (flaws[j].PreviousSeverity != "") {
+				return flaws[i].PreviousSeverity != ""
+			}
+			return pipelineSeverityRank(flaws[i].Severity) > pipelineSeverityRank(flaws[j].Severity)
+		})
+	}
+	diff.New, diff.Summary.Truncated = truncateDiffFlaws(diff.New, diff.Summary.Truncated)
+	diff.Fixed, diff.Summary.Truncated = truncateDiffFlaws(diff.Fixed, diff.Summary.Truncated)
+	diff.Unchanged, diff.Summary.Truncated = truncateDiffFlaws(diff.Unchanged, diff.Summary.Truncated)
+
+	return diff
+}
+
+// truncateDiffFlaws caps a list at maxPipelineDiffFlaws, reporting whether any list was truncated
+func truncateDiffFlaws(flaws []MCPPipelineDiffFlaw, truncated bool) ([]MCPPipelineDiffFlaw, bool) {
+	if len(flaws) > maxPipelineDiffFlaws {
+		return flaws[:maxPipelineDiffFlaws], true
+	}
+	return flaws, truncated
+}
+
+// pipelineSeverityRank orders the text severities from transformPipelineSeverity
+func pipelineSeverityRank(severity string) int {
+	for score := 5; score >= 0; score-- {
+		if transformPipelineSeverity(score) == severity {
+			return score
+		}
+	}
+	return 0
+}
+
+// pipelineDiffFlaw converts a pipeline flaw to its diff entry
+func pipelineDiffFlaw(flaw PipelineFlaw) MCPPipelineDiffFlaw {
+	return MCPPipelineDiffFlaw{
+		IssueID:  flaw.IssueID,
+		Title:    flaw.Title,
+		CweId:    flaw.CWEID,
+		Severity: transformPipelineSeverity(flaw.Severity),
+		File:     flaw.Files.SourceFile.File,
+		Line:     flaw.Files.SourceFile.Line,
+		Function: flaw.Files.SourceFile.FunctionName,
+	}
+}
+
+// formatPipelineRunList lists the recorded runs for an error message
+func formatPipelineRunList(runs []pipelineRun) string {
+	if len(runs) == 0 {
+		return "(none - run pipeline-scan to record one)\n"
+	}
+	list := ""
+	for i := len(runs) - 1; i >= 0; i-- {
+		list += fmt.Sprintf("- %s: %s\n", runs[i].ID, runs[i].ScanTarget)
+	}
+	return list
+}
+
+// formatPipelineDiffResponse formats the diff into an MCP tool response
+func formatPipelineDiffResponse(diff *MCPPipelineDiff) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format pipeline diff: %v", err)}
+	}
+
+	summary := fmt.Sprintf("Run %s compared with run %s: %d new, %d fixed, %d unchanged (%d with a different severity).",
+		diff.HeadRun.ID, diff.BaseRun.ID, diff.Summary.New, diff.Summary.Fixed, diff.Summary.Unchanged, diff.Summary.SeverityChanged)
+	if diff.Summary.Truncated {
+		summary += fmt.Sprintf(" Each list shows at most %d flaws.", maxPipelineDiffFlaws)
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
@@ -0,0 +1,113 @@
+package mcp_tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testDiffFlaw(issueID int, hash, cwe, file string, severity int) PipelineFlaw {
+	return PipelineFlaw{
+		IssueID:   issueID,
+		CWEID:     cwe,
+		Severity:  severity,
+		FlawMatch: FlawMatch{FlawHash: hash},
+		Files:     FileInfo{SourceFile: SourceFileInfo{File: file}},
+	}
+}
+
+func TestDiffPipelineFlaws(t *testing.T) {
+	base := []PipelineFlaw{
+		testDiffFlaw(1, "aaa", "89", "UserDao.java", 4),
+		testDiffFlaw(2, "bbb", "80", "view.jsp", 3),
+		testDiffFlaw(3, "ccc", "117", "Log.java", 2),
+		testDiffFlaw(4, "ccc", "117", "Log.java", 2),
+	}
+	// Issue IDs are renumbered between runs; identity is the flaw hash, CWE and file
+	head := []PipelineFlaw{
+		testDiffFlaw(10, "aaa", "89", "UserDao.java", 5),
+		testDiffFlaw(11, "ccc", "117", "Log.java", 2),
+		testDiffFlaw(12, "ddd", "78", "Exec.java", 5),
+	}
+
+	diff := diffPipelineFlaws(base, head)
+
+	if diff.Summary.New != 1 || diff.Summary.Fixed != 2 || diff.Summary.Unchanged != 2 || diff.Summary.SeverityChanged != 1 {
+		t.Fatalf("unexpected summary: %+v", diff.Summary)
+	}
+	if diff.New[0].IssueID != 12 {
+		t.Errorf("expected flaw 12 to be new, got %+v", diff.New)
+	}
+	if diff.Fixed[0].IssueID != 2 || diff.Fixed[1].CweId != "117" {
+		t.Errorf("expected view.jsp and one of the two Log.java flaws to be fixed, got %+v", diff.Fixed)
+	}
+	if diff.Unchanged[0].IssueID != 10 || diff.Unchanged[0].Severity != "very high" || diff.Unchanged[0].PreviousSeverity != "high" {
+		t.Errorf("expected the severity change first, got %+v", diff.Unchanged[0])
+	}
+
+	delta := diff.Summary.SeverityDelta
+	if delta["very high"] != 2 || delta["high"] != -1 || delta["medium"] != -1 || delta["low"] != -1 {
+		t.Errorf("unexpected severity delta: %v", delta)
+	}
+}
+
+func TestSelectPipelineDiffRuns(t *testing.T) {
+	runs := []pipelineRun{{ID: "r1"}, {ID: "r2"}, {ID: "r3"}}
+
+	base, head, err := selectPipelineDiffRuns(runs, "", "")
+	if err != nil || base.ID != "r2" || head.ID != "r3" {
+		t.Errorf("default runs: got %v, %v, %v", base, head, err)
+	}
+
+	base, head, err = selectPipelineDiffRuns(runs, "", "r2")
+	if err != nil || base.ID != "r1" || head.ID != "r2" {
+		t.Errorf("head_run r2: got %v, %v, %v", base, head, err)
+	}
+
+	base, _, err = selectPipelineDiffRuns(runs, "r1", "")
+	if err != nil || base.ID != "r1" {
+		t.Errorf("base_run r1: got %v, %v", base, err)
+	}
+
+	for _, tt := range []struct{ base, head string }{{"", "r1"}, {"r9", ""}, {"r2", "r2"}} {
+		if _, _, err := selectPipelineDiffRuns(runs, tt.base, tt.head); err == nil {
+			t.Errorf("base %q head %q: expected error", tt.base, tt.head)
+		}
+	}
+	if _, _, err := selectPipelineDiffRuns(runs[:1], "", ""); err == nil {
+		t.Error("expected error with a single run")
+	}
+}
+
+func TestPipelineDiffTool(t *testing.T) {
+	appPath := filepath.Join(t.TempDir(), "pipeline-diff-app")
+	outputDir := veracodeWorkDir(appPath, "pipeline")
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(outputDir)) })
+
+	writeTestPipelineRun(t, outputDir, "20261001-100000", testPipelineResults)
+	writeTestPipelineRun(t, outputDir, "20261002-100000", `{"findings": [
+		{"issue_id": 2000, "cwe_id": "89", "severity": 4, "files": {"source_file": {"file": "com/example/UserDao.java", "line": 60}}, "flaw_match": {"flaw_hash": "111"}}
+	]}`)
+	writeTestPipelineRun(t, outputDir, "20261003-100000", "") // still scanning
+
+	result, err := handlePipelineDiff(context.Background(), map[string]interface{}{"application_path": appPath})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	text := result.(map[string]interface{})["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "Run 20261002-100000 compared with run 20261001-100000: 0 new, 1 fixed, 1 unchanged") {
+		t.Errorf("unexpected diff:\n%s", text)
+	}
+}
+
+func TestPipelineDiffTool_NoRuns(t *testing.T) {
+	appPath := filepath.Join(t.TempDir(), "pipeline-diff-empty-app")
+
+	result, _ := handlePipelineDiff(context.Background(), map[string]interface{}{"application_path": appPath})
+	text := result.(map[string]interface{})["content"].([]map[string]string)[0]["text"]
+	if !strings.Contains(text, "at least two completed pipeline runs") {
+		t.Errorf("unexpected response:\n%s", text)
+	}
+}
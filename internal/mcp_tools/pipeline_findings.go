@@ -26,6 +26,7 @@ type PipelineFindingsRequest struct {
 	Size            int   `json:"size,omitempty"`
 	Page            int   `json:"page,omitempty"`
 	ViolatesPolicy  *bool `json:"violates_policy,omitempty"`
+	IncludeBaseline bool  `json:"include_baseline,omitempty"` // Also show flaws accepted in the repository's baseline
 }
 
 // parsePipelineFindingsRequest extracts and validates parameters from the raw args map
@@ -48,6 +49,7 @@ func parsePipelineFindingsRequest(args map[string]interface{}) (*PipelineFinding
 		violatesPolicy = &defaultTrue
 	}
 	req.ViolatesPolicy = violatesPolicy
+	req.IncludeBaseline = extractFlag(args, "include_baseline")
 
 	// Validate pagination bounds
 	if err := validatePaginationParams(req.Size, req.Page); err != nil {
@@ -162,14 +164,14 @@ func handlePipelineFindings(ctx context.Context, args map[string]interface{}) (i
 	outputDir := veracodeWorkDir(req.ApplicationPath, "pipeline")
 
 	// Find the most recent full results file (used for totals)
-	resultsFile, err := findMostRecentFile(outputDir, "results-", ".json")
+	resultsFile, err := latestPipelineFile(outputDir, "results-", ".json")
 	if err != nil {
 		// Return empty results with proper structure for UI
 		return formatEmptyPipelineFindingsResponse(req.ApplicationPath), nil
 	}
 
 	// Read and parse the full results file
-	// #nosec G304 -- resultsFile is from findMostRecentFile which validates the directory
+	// #nosec G304 -- resultsFile is from latestPipelineFile which validates the directory
 	resultsData, err := os.ReadFile(resultsFile)
 	if err != nil {
 		return pipelineErrorResponse(fmt.Sprintf("Failed to read results file: %v", err)), nil
@@ -180,9 +182,9 @@ func handlePipelineFindings(ctx context.Context, args map[string]interface{}) (i
 		return pipelineErrorResponse(fmt.Sprintf("Failed to parse results file: %v", err)), nil
 	}
 
-	// Always load filtered results to know which flaws violate policy
+	// Always load filtered results, from the same run, to know which flaws violate policy
 	var filteredResults *PipelineScanResults
-	if filteredFile, ferr := findMostRecentFile(outputDir, "filtered-results-", ".json"); ferr == nil {
+	if filteredFile, ferr := findMostRecentFile(filepath.Dir(resultsFile), "filtered-results-", ".json"); ferr == nil {
 		// #nosec G304 -- filteredFile is from findMostRecentFile which validates the directory
 		if filteredData, ferr := os.ReadFile(filteredFile); ferr == nil {
 			var fr PipelineScanResults
@@ -192,8 +194,41 @@ func handlePipelineFindings(ctx context.Context, args map[string]interface{}) (i
 		}
 	}
 
+	// Hide the flaws accepted in the repository's baseline, so only new flaws are reported
+	baselineHidden := 0
+	if !req.IncludeBaseline {
+		baseline, berr := loadPipelineBaseline(req.ApplicationPath)
+		if berr != nil {
+			return pipelineErrorResponse(fmt.Sprintf("Failed to read baseline file %s: %v", pipelineBaselinePath(req.ApplicationPath), berr)), nil
+		}
+		if baseline != nil {
+			total := len(scanResults.Findings)
+			scanResults.Findings = withoutBaselineFlaws(scanResults.Findings, baseline)
+			baselineHidden = total - len(scanResults.Findings)
+			if filteredResults != nil {
+				filteredResults.Findings = withoutBaselineFlaws(filteredResults.Findings, baseline)
+			}
+		}
+	}
+
 	// Format and return the response
-	return formatPipelineFindingsResponse(ctx, req.ApplicationPath, resultsFile, &scanResults, filteredResults, req), nil
+	result := formatPipelineFindingsResponse(ctx, req.ApplicationPath, resultsFile, &scanResults, filteredResults, req)
+	if baselineHidden > 0 {
+		addPipelineFindingsNote(result, fmt.Sprintf("%d findings in the baseline %s are hidden. Set include_baseline to true to show them.\n",
+			baselineHidden, pipelineBaselinePath(req.ApplicationPath)))
+	}
+	return result, nil
+}
+
+// addPipelineFindingsNote prefixes a note to the text content of a pipeline findings response
+func addPipelineFindingsNote(result map[string]interface{}, note string) {
+	content, ok := result["content"].([]map[string]interface{})
+	if !ok || len(content) == 0 {
+		return
+	}
+	if text, ok := content[0]["text"].(string); ok {
+		content[0]["text"] = note + text
+	}
 }
 
 // buildPipelineSummaryTotals computes summary counts from the full (unfiltered) results.
@@ -410,6 +445,7 @@ func processPipelineFinding(flaw PipelineFlaw, occurrence int) MCPFinding {
 		Severity:       transformPipelineSeverity(flaw.Severity),
 		SeverityScore:  int32(severityScore), // #nosec G115 - validated above
 		CweId:          cweID,
+		CweName:        CWEName(cweID),
 		Description:    CleanDescription(flaw.DisplayText),
 		Status:         "open",                          // Pipeline findings are always open
 		ViolatesPolicy: false,                           // may be overridden by caller when sourced from filtered-results
@@ -0,0 +1,159 @@
+package mcp_tools
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// maxPipelineLogProblems is how many error lines pipeline-status reports, the latest first
+const maxPipelineLogProblems = 5
+
+// Pipeline Scanner stages, in the order they happen
+const (
+	pipelineStageStarting = "starting"
+	pipelineStageUpload   = "upload"
+	pipelineStageAnalysis = "analysis"
+	pipelineStageResults  = "result download"
+)
+
+// pipelineStagePatterns recognise the scanner's progress lines: "Uploading: <file>" and the
+// "Scan Status: <status>" lines it prints while polling, with the Pipeline Scan API's statuses.
+// A scan is in the stage of the last progress line it logged.
+var pipelineStagePatterns = []struct {
+	stage   string
+	pattern *regexp.Regexp
+}{
+	{pipelineStageUpload, regexp.MustCompile(`^(Uploading:|Scan Status: (PENDING|UPLOADING|UPLOADED)\b)`)},
+	{pipelineStageAnalysis, regexp.MustCompile(`^Scan Status: STARTED\b`)},
+	{pipelineStageResults, regexp.MustCompile(`^(Scan Status: SUCCESS\b|Analysis Successful)`)},
+}
+
+var (
+	pipelineScanIDPattern = regexp.MustCompile(`(?i)scan[ _-]?id["']?\s*[:=]?\s*["']?([0-9a-f]{8}-[0-9a-f-]{4,}|[0-9a-f]{16,})`)
+	pipelineErrorPattern  = regexp.MustCompile(`(?i)\b(error|fail(ed|ure)?|exception|unauthori[sz]ed|forbidden|denied|timed? ?out|no modules|unsupported|not supported|too large)\b|\b40[13]\b`)
+)
+
+// pipelineLogDiagnoses explain the errors users can act on
+var pipelineLogDiagnoses = []struct {
+	pattern     *regexp.Regexp
+	explanation string
+}{
+	{regexp.MustCompile(`(?i)\b401\b|unauthori[sz]ed|invalid credentials|authentication`),
+		"Authentication failed: the API credentials are missing, wrong or expired. Check them with api-health or run `veracode configure`."},
+	{regexp.MustCompile(`(?i)\b403\b|forbidden|access denied|permission`),
+		"The API credentials are not allowed to run pipeline scans. Ask a Veracode administrator for the Upload and Scan API role."},
+	{regexp.MustCompile(`(?i)no modules|no scannable|nothing to scan|no supported`),
+		"No modules to analyze: the artifact contains no compiled code the scanner supports. Check the packaging log and scan the archive package-workspace produced."},
+	{regexp.MustCompile(`(?i)unsupported|not supported|invalid file|file type|not a valid`),
+		"The artifact isn't supported by the Pipeline Scanner. Scan a packaged archive (.jar, .war, .zip, ...) produced by package-workspace."},
+	{regexp.MustCompile(`(?i)too large|exceeds|size limit`),
+		"The artifact is larger than the Pipeline Scanner accepts (200 MB). Scan a smaller module or use platform-scan."},
+	{regexp.MustCompile(`(?i)timed? ?out|timeout`),
+		"The scan timed out. Large artifacts can take longer than the scanner waits; retry, or scan a smaller module."},
+}
+
+// pipelineLogProblem is an error line from the scan log, with an explanation when it's a known problem
+type pipelineLogProblem struct {
+	Line        string
+	Explanation string
+}
+
+// pipelineLogStatus is what a scan log says about the progress of a scan
+type pipelineLogStatus struct {
+	Stage    string
+	ScanID   string
+	Problems []pipelineLogProblem // Latest first
+	LastLine string
+}
+
+// parsePipelineLog reads a pipeline scan log and works out the scan's stage and ID, and its errors
+// if diagnose is set. Only diagnose scans that failed: the logs of scans that succeeded, or are
+// still running, mention errors that aren't problems, such as retried requests.
+func parsePipelineLog(logFile string, diagnose bool) (*pipelineLogStatus, error) {
+	// #nosec G304 -- the log file is in the pipeline work directory, named by the job or PID file
+	file, err := os.Open(logFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	status := &pipelineLogStatus{Stage: pipelineStageStarting}
+	var problems []pipelineLogProblem
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// The header written before the scanner starts repeats the command line, which mentions results files
+		if line == "" || strings.HasPrefix(line, "Command: ") {
+			continue
+		}
+		status.LastLine = line
+
+		for _, stage := range pipelineStagePatterns {
+			if stage.pattern.MatchString(line) {
+				status.Stage = stage.stage
+				break
+			}
+		}
+		if match := pipelineScanIDPattern.FindStringSubmatch(line); match != nil {
+			status.ScanID = match[1]
+		}
+		// Once the scan has succeeded the scanner lists the findings, whose CWE names
+		// (e.g. "Generation of Error Message Containing Sensitive Information") aren't errors
+		if diagnose && status.Stage != pipelineStageResults && pipelineErrorPattern.MatchString(line) {
+			problems = append(problems, pipelineLogProblem{Line: line, Explanation: diagnosePipelineLogLine(line)})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Report the latest errors, once per explanation
+	seen := map[string]bool{}
+	for i := len(problems) - 1; i >= 0 && len(status.Problems) < maxPipelineLogProblems; i-- {
+		key := problems[i].Explanation
+		if key == "" {
+			key = problems[i].Line
+		}
+		if !seen[key] {
+			seen[key] = true
+			status.Problems = append(status.Problems, problems[i])
+		}
+	}
+	return status, nil
+}
+
+// diagnosePipelineLogLine explains an error line, or returns "" if it isn't a known problem
+func diagnosePipelineLogLine(line string) string {
+	for _, diagnosis := range pipelineLogDiagnoses {
+		if diagnosis.pattern.MatchString(line) {
+			return diagnosis.explanation
+		}
+	}
+	return ""
+}
+
+// format describes the log status for pipeline-status
+func (s *pipelineLogStatus) format() string {
+	text := "Stage: " + s.Stage + "\n"
+	if s.ScanID != "" {
+		text += "Scan ID: " + s.ScanID + "\n"
+	}
+	if len(s.Problems) > 0 {
+		text += "\nProblems found in the log:\n"
+		for _, problem := range s.Problems {
+			if problem.Explanation != "" {
+				text += "- " + problem.Explanation + "\n  Log: " + problem.Line + "\n"
+			} else {
+				text += "- " + problem.Line + "\n"
+			}
+		}
+	}
+	if s.LastLine != "" {
+		text += "\nLast log line: " + s.LastLine + "\n"
+	}
+	return text
+}
@@ -0,0 +1,145 @@
+package mcp_tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePipelineLog(t *testing.T, content string) string {
+	t.Helper()
+	logFile := filepath.Join(t.TempDir(), "scan-20261019-101500.log")
+	if err := os.WriteFile(logFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return logFile
+}
+
+func TestParsePipelineLog_Stages(t *testing.T) {
+	// The CLI downloads the scanner before the scan; that must not count as downloading results
+	scanLog := `Command: veracode static scan app.jar --results-file results.json
+
+Downloading the Pipeline Scanner...
+Uploading: app.jar
+Scan ID: 4f6c2a10-9b1e-4c55-8a3f-2d7e5b0c9a11
+Scan Status: UPLOADING
+Scan Status: PENDING
+Scan Status: STARTED
+`
+	tests := []struct {
+		log   string
+		stage string
+	}{
+		{"Command: veracode static scan app.jar\n\nDownloading the Pipeline Scanner...\n", pipelineStageStarting},
+		{scanLog[:strings.Index(scanLog, "Scan Status: STARTED")], pipelineStageUpload},
+		{scanLog, pipelineStageAnalysis},
+		{scanLog + "Scan Status: STARTED\nScan Status: SUCCESS\nAnalysis Successful.\n", pipelineStageResults},
+	}
+	for _, tt := range tests {
+		status, err := parsePipelineLog(writePipelineLog(t, tt.log), false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if status.Stage != tt.stage {
+			t.Errorf("expected stage %q, got %q for log:\n%s", tt.stage, status.Stage, tt.log)
+		}
+	}
+
+	status, err := parsePipelineLog(writePipelineLog(t, scanLog), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.ScanID != "4f6c2a10-9b1e-4c55-8a3f-2d7e5b0c9a11" || status.LastLine != "Scan Status: STARTED" || len(status.Problems) != 0 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
+
+// pipelineLogSucceeded is the output of a scan that found flaws: a summary by severity, then the flaws
+const pipelineLogSucceeded = `Uploading: app.jar
+Scan ID: 4f6c2a10-9b1e-4c55-8a3f-2d7e5b0c9a11
+Scan Status: UPLOADING
+Scan Status: PENDING
+Scan Status: STARTED
+Scan Status: SUCCESS
+Analysis Successful.
+
+===================
+Analyzed 1 modules.
+app.jar
+Analysis time: 171 seconds.
+
+====================
+Analysis Successful.
+Found 1 issues of High severity.
+Found 2 issues of Medium severity.
+====================
+
+FAILURE: Found 3 issues!
+Skipping policy evaluation as no policy provided.
+
+High:
+    CWE-306: Missing Authentication for Critical Function: com/example/AdminController.java:42
+Medium:
+    CWE-209: Generation of Error Message Containing Sensitive Information: com/example/ErrorHandler.java:17
+    CWE-209: Generation of Error Message Containing Sensitive Information: com/example/LoginController.java:88
+`
+
+// pipelineLogFailed is the output of a scan whose upload was refused, retried once
+const pipelineLogFailed = `Uploading: app.jar
+Failed to upload file: HTTP 401 Unauthorized
+Uploading: app.jar
+Failed to upload file: HTTP 401 Unauthorized
+Scan Status: FAILURE
+Analysis Failed: No modules found to analyze.
+`
+
+func TestParsePipelineLog_Problems(t *testing.T) {
+	status, err := parsePipelineLog(writePipelineLog(t, pipelineLogFailed), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Stage != pipelineStageUpload || len(status.Problems) != 3 {
+		t.Fatalf("expected the upload stage and three distinct problems, got %+v", status)
+	}
+	if !strings.HasPrefix(status.Problems[0].Explanation, "No modules") || status.Problems[1].Line != "Scan Status: FAILURE" || !strings.HasPrefix(status.Problems[2].Explanation, "Authentication failed") {
+		t.Errorf("expected the latest problem first, got %+v", status.Problems)
+	}
+	if text := status.format(); !strings.Contains(text, "Problems found in the log:") || !strings.Contains(text, "Log: Failed to upload file: HTTP 401 Unauthorized") {
+		t.Errorf("unexpected format:\n%s", text)
+	}
+}
+
+func TestParsePipelineLog_SkipsFindings(t *testing.T) {
+	// CWE names in the flaw listing mention errors and authentication, but aren't problems
+	status, err := parsePipelineLog(writePipelineLog(t, pipelineLogSucceeded), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Stage != pipelineStageResults || len(status.Problems) != 0 {
+		t.Errorf("expected the result stage and no problems, got %+v", status)
+	}
+}
+
+func TestParsePipelineLog_DiagnosesOnlyFailedScans(t *testing.T) {
+	status, err := parsePipelineLog(writePipelineLog(t, pipelineLogFailed), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(status.Problems) != 0 {
+		t.Errorf("expected no problems when not diagnosing, got %+v", status.Problems)
+	}
+}
+
+func TestPipelineLogStartTime(t *testing.T) {
+	startedAt, ok := pipelineLogStartTime("/work/pipeline/20261019-101500/scan-20261019-101500.log")
+	if !ok || startedAt.Hour() != 10 || startedAt.Minute() != 15 {
+		t.Errorf("pipelineLogStartTime() = %v, %v", startedAt, ok)
+	}
+	if startedAt, ok := pipelineLogStartTime("/work/pipeline/20261019-101500-2/scan-20261019-101500-2.log"); !ok || startedAt.Minute() != 15 {
+		t.Errorf("expected the start time of a numbered run, got %v, %v", startedAt, ok)
+	}
+	if _, ok := pipelineLogStartTime("/work/pipeline/output.log"); ok {
+		t.Error("expected no start time for a log without a timestamp")
+	}
+}
@@ -0,0 +1,147 @@
+package mcp_tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// pipelineSeverityOrder lists the pipeline severities from most to least severe, as named by transformPipelineSeverity
+var pipelineSeverityOrder = []string{"very high", "high", "medium", "low", "very low", "info"}
+
+// pipelineProjectResults summarises the latest pipeline scan of one monorepo project
+type pipelineProjectResults struct {
+	State          string // State of the latest scan job, or "" if none is tracked
+	ResultsFile    string
+	BySeverity     map[string]int
+	Total          int
+	PolicyFailures int // Findings in the filtered (policy-failing) results
+	Error          string
+}
+
+// loadPipelineProjectResults reads the latest pipeline scan results of a project directory,
+// leaving out the flaws in the project's baseline as pipeline-findings does
+func loadPipelineProjectResults(applicationPath string) pipelineProjectResults {
+	var project pipelineProjectResults
+	if job := backgroundJobs.latest(applicationPath, PipelineScanToolName); job != nil {
+		project.State = job.State
+	}
+
+	resultsFile, err := latestPipelineFile(veracodeWorkDir(applicationPath, "pipeline"), "results-", ".json")
+	if err != nil {
+		return project
+	}
+	project.ResultsFile = resultsFile
+
+	results, err := readPipelineResults(resultsFile)
+	if err != nil {
+		project.Error = err.Error()
+		return project
+	}
+	baseline, err := loadPipelineBaseline(applicationPath)
+	if err != nil {
+		project.Error = fmt.Sprintf("failed to read baseline file %s: %v", pipelineBaselinePath(applicationPath), err)
+		return project
+	}
+	findings := results.Findings
+	if baseline != nil {
+		findings = withoutBaselineFlaws(findings, baseline)
+	}
+	summary := buildPipelineSummaryTotals(findings)
+	project.BySeverity, project.Total = summary.BySeverity, summary.TotalFindings
+
+	if filteredFile, err := findMostRecentFile(filepath.Dir(resultsFile), "filtered-results-", ".json"); err == nil {
+		if filtered, err := readPipelineResults(filteredFile); err == nil {
+			if baseline != nil {
+				filtered.Findings = withoutBaselineFlaws(filtered.Findings, baseline)
+			}
+			project.PolicyFailures = len(filtered.Findings)
+		}
+	}
+	return project
+}
+
+// readPipelineResults parses a pipeline scan results file
+func readPipelineResults(path string) (*PipelineScanResults, error) {
+	// #nosec G304 -- path is from latestPipelineFile or findMostRecentFile, which look in the work directory
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results file: %v", err)
+	}
+	var results PipelineScanResults
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("failed to parse results file %s: %v", path, err)
+	}
+	return &results, nil
+}
+
+// formatSeverityCounts lists the non-zero severity counts, most severe first
+func formatSeverityCounts(bySeverity map[string]int) string {
+	var counts []string
+	for _, severity := range pipelineSeverityOrder {
+		if bySeverity[severity] > 0 {
+			counts = append(counts, fmt.Sprintf("%s: %d", severity, bySeverity[severity]))
+		}
+	}
+	if len(counts) == 0 {
+		return "no findings"
+	}
+	return strings.Join(counts, ", ")
+}
+
+// pipelineProjectsStatus reports the latest pipeline scan of every project of a monorepo workspace file,
+// with the findings added up per application profile
+func pipelineProjectsStatus(applicationPath string) map[string]interface{} {
+	groups, workspaceFile, err := findWorkspaceProjects(applicationPath)
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+
+	var sections strings.Builder
+	for _, group := range groups {
+		total, failures := 0, 0
+		bySeverity := map[string]int{}
+		var lines []string
+		for _, project := range group.Projects {
+			results := loadPipelineProjectResults(project.Directory)
+			state := results.State
+			if state == "" {
+				state = "no scan tracked"
+			}
+			line := fmt.Sprintf("- %s (project %q): %s", project.Directory, project.Pattern, state)
+			switch {
+			case results.Error != "":
+				line += "\n  ❌ " + results.Error
+			case results.ResultsFile == "":
+				line += "\n  No results yet"
+			default:
+				line += fmt.Sprintf("\n  %d findings (%s), %d fail the policy\n  Results File: %s",
+					results.Total, formatSeverityCounts(results.BySeverity), results.PolicyFailures, results.ResultsFile)
+				total += results.Total
+				failures += results.PolicyFailures
+				for severity, count := range results.BySeverity {
+					bySeverity[severity] += count
+				}
+			}
+			lines = append(lines, line)
+		}
+		fmt.Fprintf(&sections, "\n## Application profile: %s\n\n%d findings (%s), %d fail the policy\n\n%s\n",
+			group.Application, total, formatSeverityCounts(bySeverity), failures, strings.Join(lines, "\n"))
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf(`Pipeline Scan Status - All Projects
+==================
+
+Workspace File: %s
+Findings in a project's baseline are not counted.
+%s
+Use pipeline-findings with a project's directory as application_path to see its findings.
+`, workspaceFile, sections.String()),
+		}},
+	}
+}
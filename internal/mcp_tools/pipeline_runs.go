@@ -0,0 +1,141 @@
+package mcp_tools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// maxPipelineRuns is how many pipeline scan runs are kept; older run directories are deleted
+const maxPipelineRuns = 10
+
+// pipelineRunTimestamp names run directories and result files; it sorts chronologically
+const pipelineRunTimestamp = "20060102-150405"
+
+// pipelineRun records one pipeline scan in the run index
+type pipelineRun struct {
+	ID                  string `json:"id"` // Start time, formatted with pipelineRunTimestamp, and a number if the second was taken
+	StartedAt           string `json:"started_at"`
+	ScanTarget          string `json:"scan_target"`
+	Baseline            string `json:"baseline,omitempty"`
+	ResultsFile         string `json:"results_file"`
+	FilteredResultsFile string `json:"filtered_results_file"`
+	LogFile             string `json:"log_file"`
+	SummaryFile         string `json:"summary_file,omitempty"` // Written only for summary-only scans
+}
+
+// pipelineRunIndex lists the kept runs, oldest first
+type pipelineRunIndex struct {
+	Runs []pipelineRun `json:"runs"`
+}
+
+// pipelineRunsDir returns the directory holding one subdirectory per run, and the index
+func pipelineRunsDir(outputDir string) string {
+	return filepath.Join(outputDir, "runs")
+}
+
+// pipelineRunIndexPath returns the location of the run index
+func pipelineRunIndexPath(outputDir string) string {
+	return filepath.Join(pipelineRunsDir(outputDir), "index.json")
+}
+
+// newPipelineRun creates the directory for a run started at id and returns the run with its file paths.
+// A run started in the same second as another gets a numbered id such as 20261019-101500-2,
+// so two runs never share a directory.
+func newPipelineRun(outputDir, id string) (*pipelineRun, error) {
+	if err := os.MkdirAll(pipelineRunsDir(outputDir), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create run directory: %v", err)
+	}
+	startedAt := id
+	runDir := filepath.Join(pipelineRunsDir(outputDir), id)
+	for n := 2; ; n++ {
+		err := os.Mkdir(runDir, 0750)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create run directory: %v", err)
+		}
+		id = fmt.Sprintf("%s-%d", startedAt, n)
+		runDir = filepath.Join(pipelineRunsDir(outputDir), id)
+	}
+	return &pipelineRun{
+		ID:                  id,
+		ResultsFile:         filepath.Join(runDir, fmt.Sprintf("results-%s.json", id)),
+		FilteredResultsFile: filepath.Join(runDir, fmt.Sprintf("filtered-results-%s.json", id)),
+		LogFile:             filepath.Join(runDir, fmt.Sprintf("scan-%s.log", id)),
+	}, nil
+}
+
+// removePipelineRun deletes the directory of a run that never started
+func removePipelineRun(run *pipelineRun) {
+	runDir := filepath.Dir(run.ResultsFile)
+	if err := os.RemoveAll(runDir); err != nil {
+		log.Printf("Warning: Failed to remove pipeline run %s: %v", runDir, err)
+	}
+}
+
+// loadPipelineRuns reads the run index; a missing index means no runs have been recorded
+func loadPipelineRuns(outputDir string) ([]pipelineRun, error) {
+	// #nosec G304 -- the index path is built from the pipeline work directory and a fixed name
+	data, err := os.ReadFile(pipelineRunIndexPath(outputDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run index: %w", err)
+	}
+
+	var index pipelineRunIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse run index: %w", err)
+	}
+	return index.Runs, nil
+}
+
+// recordPipelineRun adds a run to the index and deletes the oldest runs beyond maxPipelineRuns
+func recordPipelineRun(outputDir string, run *pipelineRun) error {
+	runs, err := loadPipelineRuns(outputDir)
+	if err != nil {
+		// An unreadable index only loses history; start a new one rather than blocking the scan
+		log.Printf("Warning: %v; starting a new pipeline run index", err)
+		runs = nil
+	}
+	runs = append(runs, *run)
+
+	if len(runs) > maxPipelineRuns {
+		for _, old := range runs[:len(runs)-maxPipelineRuns] {
+			runDir := filepath.Join(pipelineRunsDir(outputDir), old.ID)
+			if err := os.RemoveAll(runDir); err != nil {
+				log.Printf("Warning: Failed to remove old pipeline run %s: %v", runDir, err)
+			}
+		}
+		runs = runs[len(runs)-maxPipelineRuns:]
+	}
+
+	data, err := json.MarshalIndent(pipelineRunIndex{Runs: runs}, "", "  ")
+	if err != nil {
+		return err
+	}
+	// #nosec G306 -- the index is read by the status and diff tools
+	return os.WriteFile(pipelineRunIndexPath(outputDir), data, 0644)
+}
+
+// latestPipelineFile finds the newest file with the given prefix and suffix, looking through the
+// runs newest first so a scan still in progress doesn't hide the previous run's results.
+// Results written before runs were kept in their own directories are found in outputDir itself.
+func latestPipelineFile(outputDir, prefix, suffix string) (string, error) {
+	runs, err := loadPipelineRuns(outputDir)
+	if err != nil {
+		log.Printf("Warning: %v", err)
+	}
+	for i := len(runs) - 1; i >= 0; i-- {
+		if file, err := findMostRecentFile(filepath.Join(pipelineRunsDir(outputDir), runs[i].ID), prefix, suffix); err == nil {
+			return file, nil
+		}
+	}
+	return findMostRecentFile(outputDir, prefix, suffix)
+}
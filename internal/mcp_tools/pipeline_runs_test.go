@@ -0,0 +1,89 @@
+package mcp_tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTestPipelineRun records a run in outputDir and, if results is not empty, writes its results file
+func writeTestPipelineRun(t *testing.T, outputDir, id, results string) *pipelineRun {
+	t.Helper()
+	run, err := newPipelineRun(outputDir, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	run.ScanTarget = "app.jar"
+	if results != "" {
+		if err := os.WriteFile(run.ResultsFile, []byte(results), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := recordPipelineRun(outputDir, run); err != nil {
+		t.Fatal(err)
+	}
+	return run
+}
+
+func TestRecordPipelineRun_KeepsLatestRuns(t *testing.T) {
+	outputDir := t.TempDir()
+	for i := 0; i < maxPipelineRuns+2; i++ {
+		writeTestPipelineRun(t, outputDir, fmt.Sprintf("20261001-1000%02d", i), `{"findings": []}`)
+	}
+
+	runs, err := loadPipelineRuns(outputDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(runs) != maxPipelineRuns || runs[0].ID != "20261001-100002" {
+		t.Fatalf("expected the latest %d runs starting at 20261001-100002, got %d starting at %s", maxPipelineRuns, len(runs), runs[0].ID)
+	}
+	if _, err := os.Stat(filepath.Join(pipelineRunsDir(outputDir), "20261001-100001")); !os.IsNotExist(err) {
+		t.Error("the directories of pruned runs should be deleted")
+	}
+}
+
+func TestNewPipelineRun_SameSecond(t *testing.T) {
+	outputDir := t.TempDir()
+	first, err := newPipelineRun(outputDir, "20261019-101500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := newPipelineRun(outputDir, "20261019-101500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != "20261019-101500" || second.ID != "20261019-101500-2" {
+		t.Fatalf("expected the second run of the second to be numbered, got %q and %q", first.ID, second.ID)
+	}
+	if filepath.Dir(first.ResultsFile) == filepath.Dir(second.ResultsFile) {
+		t.Errorf("expected separate run directories, got %s", filepath.Dir(second.ResultsFile))
+	}
+
+	removePipelineRun(second)
+	if _, err := os.Stat(filepath.Dir(second.ResultsFile)); !os.IsNotExist(err) {
+		t.Error("expected the removed run's directory to be deleted")
+	}
+}
+
+func TestLatestPipelineFile(t *testing.T) {
+	outputDir := t.TempDir()
+
+	// Results from before runs had their own directories
+	legacy := filepath.Join(outputDir, "results-20260901-100000.json")
+	if err := os.WriteFile(legacy, []byte(`{}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if file, err := latestPipelineFile(outputDir, "results-", ".json"); err != nil || file != legacy {
+		t.Errorf("expected the legacy results file, got %q, %v", file, err)
+	}
+
+	completed := writeTestPipelineRun(t, outputDir, "20261001-100000", `{"findings": []}`)
+	writeTestPipelineRun(t, outputDir, "20261002-100000", "") // still scanning
+
+	file, err := latestPipelineFile(outputDir, "results-", ".json")
+	if err != nil || file != completed.ResultsFile {
+		t.Errorf("expected the latest completed run's results %q, got %q, %v", completed.ResultsFile, file, err)
+	}
+}
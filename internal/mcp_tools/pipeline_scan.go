@@ -6,12 +6,10 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/dipsylala/veracode-mcp/api"
 	"github.com/dipsylala/veracode-mcp/workspace"
 )
 
@@ -27,6 +25,17 @@ type PipelineScanRequest struct {
 	ApplicationPath string
 	AppProfile      string
 	Filename        string
+	Baseline        string // Baseline file passed with --baseline-file; empty scans without one
+	AllProjects     bool   // Scan every project of a monorepo workspace file
+
+	// Pipeline Scanner options, defaulting to the pipeline_scan section of .veracode-workspace.json
+	FailOnSeverity []string
+	FailOnCWE      []int
+	TimeoutMinutes int // 0 uses the scanner's default
+	IncludeModules []string
+	PolicyName     string // Replaces the first policy of the application profile
+	PolicyFile     string
+	SummaryOnly    bool
 }
 
 // appInfo holds resolved application details used during the scan
@@ -50,11 +59,22 @@ func parsePipelineScanRequest(args map[string]interface{}) (*PipelineScanRequest
 	// Extract optional app profile
 	req.AppProfile, _ = extractOptionalString(args, "app_profile")
 
+	// Each project is scanned with its own profile, packaged file and options, which are parsed per project
+	req.AllProjects = extractFlag(args, "all_projects")
+	if req.AllProjects {
+		for _, field := range []string{"app_profile", "filename"} {
+			if value, _ := extractOptionalString(args, field); value != "" {
+				return nil, fmt.Errorf("%s can't be used with all_projects; each project uses its own from the workspace file", field)
+			}
+		}
+		return req, nil
+	}
+
 	// Extract optional filename
 	if filename, ok := extractOptionalString(args, "filename"); ok && filename != "" {
 		// Check if filename is just a name (no directory separators)
 		if filepath.Base(filename) == filename {
-			// Just a filename - prepend temp .veracode/packaging directory
+			// Just a filename - prepend the packaging work directory
 			req.Filename = filepath.Join(veracodeWorkDir(req.ApplicationPath, "packaging"), filename)
 		} else {
 			// Full or relative path - use as-is
@@ -62,6 +82,25 @@ func parsePipelineScanRequest(args map[string]interface{}) (*PipelineScanRequest
 		}
 	}
 
+	// Use the repository's baseline unless another file is named or baselines are turned off
+	baseline, _ := extractOptionalString(args, "baseline")
+	switch {
+	case strings.EqualFold(baseline, baselineDisabled):
+		req.Baseline = ""
+	case baseline == "":
+		if _, err := os.Stat(pipelineBaselinePath(req.ApplicationPath)); err == nil {
+			req.Baseline = pipelineBaselinePath(req.ApplicationPath)
+		}
+	case filepath.IsAbs(baseline):
+		req.Baseline = baseline
+	default:
+		req.Baseline = filepath.Join(req.ApplicationPath, baseline)
+	}
+
+	if err := parsePipelineScanOptions(req, args); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -73,48 +112,106 @@ func handlePipelineScan(ctx context.Context, args map[string]interface{}) (inter
 		return map[string]interface{}{"error": err.Error()}, nil
 	}
 
-	outputDir, err := prepareOutputDir(req.ApplicationPath)
-	if err != nil {
-		return map[string]interface{}{"error": err.Error()}, nil
+	if req.AllProjects {
+		return runForEachProject(ctx, PipelineScanToolName, args, handlePipelineScan)
 	}
 
-	scanTarget, err := resolveScanTarget(req)
+	outputDir, err := prepareOutputDir(req.ApplicationPath)
 	if err != nil {
 		return map[string]interface{}{"error": err.Error()}, nil
 	}
 
-	// Resolve application info (numeric ID for --app-id, policy GUID for policy fetch)
-	info := resolveAppInfo(ctx, req.ApplicationPath, req.AppProfile)
-
-	// Fetch and save policy if we have a name (best-effort; does not block the scan)
-	var savedPolicyPath string
-	if info.PolicyName != "" {
-		savedPolicyPath = fetchAndSavePolicy(ctx, outputDir, info.PolicyName)
+	// A scan started right after package-workspace waits for the packaging job,
+	// and picks the file to scan once packaging has finished
+	var packagingJob string
+	if req.Filename == "" {
+		if packaging := backgroundJobs.latest(req.ApplicationPath, PackageWorkspaceToolName); packaging != nil && packaging.isActive() {
+			packagingJob = packaging.ID
+		}
 	}
 
-	// Build pipeline scan command
-	timestamp := time.Now().Format("20060102-150405")
-	resultsFile := filepath.Join(outputDir, fmt.Sprintf("results-%s.json", timestamp))
-	filteredResultsFile := filepath.Join(outputDir, fmt.Sprintf("filtered-results-%s.json", timestamp))
-	logFile := filepath.Join(outputDir, fmt.Sprintf("scan-%s.log", timestamp))
-
-	cmdArgs := []string{
-		"static", "scan", scanTarget,
-		"--results-file", resultsFile,
-		"--filtered-json-output-file", filteredResultsFile,
-		"-v",
+	var scanTarget string
+	if packagingJob == "" {
+		scanTarget, err = resolveScanTarget(req)
+		if err != nil {
+			return map[string]interface{}{"error": err.Error()}, nil
+		}
 	}
-	if info.NumericID != "" {
-		cmdArgs = append(cmdArgs, "--app-id", info.NumericID)
+
+	if req.Baseline != "" {
+		if _, err := os.Stat(req.Baseline); err != nil {
+			return map[string]interface{}{"error": fmt.Sprintf("baseline file does not exist: %s. Create one with create-pipeline-baseline", req.Baseline)}, nil
+		}
 	}
-	if savedPolicyPath != "" {
-		cmdArgs = append(cmdArgs, "--policy-file", savedPolicyPath)
+	if req.PolicyFile != "" {
+		if _, err := os.Stat(req.PolicyFile); err != nil {
+			return map[string]interface{}{"error": fmt.Sprintf("policy file does not exist: %s", req.PolicyFile)}, nil
+		}
 	}
 
-	pid, err := launchScan(ctx, cmdArgs, outputDir, resultsFile, logFile)
+	// Resolve application info (numeric ID for --app-id, policy GUID for policy fetch)
+	info := resolveAppInfo(ctx, req.ApplicationPath, req.AppProfile)
+
+	// Each run writes to its own directory so earlier runs stay available to pipeline-diff,
+	// and scans running at the same time don't share files
+	startedAt := time.Now()
+	run, err := newPipelineRun(outputDir, startedAt.Format(pipelineRunTimestamp))
 	if err != nil {
 		return map[string]interface{}{"error": err.Error()}, nil
 	}
+	run.StartedAt = startedAt.Format(time.RFC3339)
+	run.Baseline = req.Baseline
+	if req.SummaryOnly {
+		run.SummaryFile = filepath.Join(filepath.Dir(run.ResultsFile), fmt.Sprintf("summary-%s.txt", run.ID))
+	}
+
+	// Fetch and save the policy (best-effort; does not block the scan). An explicit policy
+	// replaces the first policy of the application profile
+	policyFile := req.PolicyFile
+	policyName := req.PolicyName
+	if policyName == "" && policyFile == "" {
+		policyName = info.PolicyName
+	}
+	if policyName != "" {
+		policyFile = fetchAndSavePolicy(ctx, req.ApplicationPath, filepath.Dir(run.ResultsFile), policyName)
+	}
+
+	job, err := backgroundJobs.submit(jobSpec{
+		Kind:            PipelineScanToolName,
+		ApplicationPath: req.ApplicationPath,
+		LogFile:         run.LogFile,
+		OutputDir:       outputDir,
+		ResultFile:      run.ResultsFile,
+		After:           packagingJob,
+		Prepare: func() ([]string, error) {
+			target := scanTarget
+			if target == "" {
+				resolved, err := resolveScanTarget(req)
+				if err != nil {
+					return nil, err
+				}
+				target = resolved
+			}
+			run.ScanTarget = target
+			if err := recordPipelineRun(outputDir, run); err != nil {
+				log.Printf("Warning: Failed to record pipeline run %s: %v", run.ID, err)
+			}
+			return pipelineScanArgs(target, run, info.NumericID, policyFile, req), nil
+		},
+		NextSteps: func(exitCode int) string {
+			return pipelineScanNextSteps(run, exitCode)
+		},
+		Cleanup: func() {
+			removePipelineRun(run)
+		},
+	})
+	if err != nil {
+		removePipelineRun(run)
+		return map[string]interface{}{"error": err.Error()}, nil
+	}
+	if job.State == JobFailed {
+		return map[string]interface{}{"error": fmt.Sprintf("failed to start pipeline scan: %s", job.Error)}, nil
+	}
 
 	// Build optional warnings section
 	var warningsSection string
@@ -125,27 +222,46 @@ func handlePipelineScan(ctx context.Context, args map[string]interface{}) (inter
 		}
 	}
 
+	baselineFile := req.Baseline
+	if baselineFile == "" {
+		baselineFile = "none"
+	}
+
+	status := "✓ Pipeline scan started successfully in background"
+	commandSection := fmt.Sprintf("Command executed:\n%s\n", job.Command)
+	targetDescription := scanTarget
+	if targetDescription == "" {
+		targetDescription = "the largest packaged file, chosen once packaging has finished"
+	}
+	if job.State == JobQueued {
+		commandSection = ""
+		status = "⏳ Pipeline scan queued"
+		if packagingJob != "" {
+			status += fmt.Sprintf("; it starts when packaging job %s has finished", packagingJob)
+		}
+	}
+
 	responseText := fmt.Sprintf(`Veracode Pipeline Static Scan - Started
 ============================
 
 Application Path: %s
 Scan Target: %s
-PID: %d
+Baseline: %s
+Run: %s
+Job: %s
 Results File: %s
 Filtered Results File: %s
 Log File: %s
+%s%s
+%s
 %s
-Command executed:
-veracode %s
-
-✓ Pipeline scan started successfully in background
 
 Next steps:
-- Use pipeline-status tool to check scan progress
+- Use pipeline-status or job-status to check scan progress
 - Results will be available in: %s
 - Filtered results will be available in: %s
 - Log output will be in: %s
-`, req.ApplicationPath, scanTarget, pid, resultsFile, filteredResultsFile, logFile, warningsSection, strings.Join(cmdArgs, " "), resultsFile, filteredResultsFile, logFile)
+`, req.ApplicationPath, targetDescription, baselineFile, run.ID, job.ID, run.ResultsFile, run.FilteredResultsFile, run.LogFile, describePipelineScanOptions(req), warningsSection, commandSection, status, run.ResultsFile, run.FilteredResultsFile, run.LogFile)
 
 	return map[string]interface{}{
 		"content": []map[string]string{{
@@ -155,8 +271,43 @@ Next steps:
 	}, nil
 }
 
-// prepareOutputDir creates the pipeline output directory, cleans up prior run artifacts,
-// and validates that the application path exists.
+// pipelineScanArgs builds the pipeline scan command arguments for a run
+func pipelineScanArgs(scanTarget string, run *pipelineRun, appID, policyFile string, req *PipelineScanRequest) []string {
+	cmdArgs := []string{
+		"static", "scan", scanTarget,
+		"--results-file", run.ResultsFile,
+		"--filtered-json-output-file", run.FilteredResultsFile,
+		"-v",
+	}
+	if appID != "" {
+		cmdArgs = append(cmdArgs, "--app-id", appID)
+	}
+	if req.Baseline != "" {
+		cmdArgs = append(cmdArgs, "--baseline-file", req.Baseline)
+	}
+	return append(cmdArgs, pipelineScanOptionArgs(req, run, policyFile)...)
+}
+
+// pipelineScanNextSteps describes what to do once a pipeline scan has exited
+func pipelineScanNextSteps(run *pipelineRun, exitCode int) string {
+	info := InterpretVeracodeExitCode(exitCode)
+	if exitCode != 0 && !info.IsWarning {
+		return info.NextSteps
+	}
+	nextSteps := fmt.Sprintf("Next steps:\n- Use pipeline-findings to review the results in: %s\n- Use pipeline-diff to compare run %s with the previous run", run.ResultsFile, run.ID)
+	if run.SummaryFile == "" {
+		return nextSteps
+	}
+	// #nosec G304 -- the summary file is in the run directory
+	summary, err := os.ReadFile(run.SummaryFile)
+	if err != nil {
+		return nextSteps
+	}
+	return fmt.Sprintf("Summary (%s):\n%s\n\n%s", run.SummaryFile, strings.TrimSpace(string(summary)), nextSteps)
+}
+
+// prepareOutputDir creates the pipeline output directory, cleans up files left there by earlier versions
+// (PID, policy and results files), and validates that the application path exists. Runs are kept in their own directories.
 func prepareOutputDir(applicationPath string) (string, error) {
 	if _, err := os.Stat(applicationPath); os.IsNotExist(err) {
 		return "", fmt.Errorf("application path does not exist: %s", applicationPath)
@@ -164,8 +315,8 @@ func prepareOutputDir(applicationPath string) (string, error) {
 		return "", fmt.Errorf("failed to access application path: %v", err)
 	}
 
-	outputDir := veracodeWorkDir(applicationPath, "pipeline")
-	if err := os.MkdirAll(outputDir, 0750); err != nil {
+	outputDir, err := createVeracodeWorkDir(applicationPath, "pipeline")
+	if err != nil {
 		return "", fmt.Errorf("failed to create output directory: %v", err)
 	}
 	if err := cleanupPipelineDirectory(outputDir); err != nil {
@@ -184,47 +335,13 @@ func resolveScanTarget(req *PipelineScanRequest) (string, error) {
 		return req.Filename, nil
 	}
 	packagingDir := veracodeWorkDir(req.ApplicationPath, "packaging")
-	target, err := findLargestFile(packagingDir)
+	target, err := findLargestFile(packagingDir, packageSelection(req.ApplicationPath))
 	if err != nil {
 		return "", fmt.Errorf("failed to find file to scan: %v. Either specify a filename parameter or ensure .veracode/packaging contains packaged files", err)
 	}
 	return target, nil
 }
 
-// launchScan starts the veracode static scan process, writes the log header and PID file,
-// and returns the process PID on success.
-func launchScan(ctx context.Context, cmdArgs []string, outputDir, resultsFile, logFile string) (int, error) {
-	// #nosec G204 -- veracode command is hardcoded, only arguments are user-controlled and validated
-	cmd := exec.CommandContext(ctx, "veracode", cmdArgs...)
-
-	// #nosec G304 -- logFile is constructed from validated outputDir and timestamp, not user input
-	logFileHandle, err := os.Create(logFile)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create log file: %v", err)
-	}
-	// Intentionally not closing logFileHandle here: the async child process continues
-	// writing to it after this function returns. The OS will close the handle on process exit.
-
-	fmt.Fprintf(logFileHandle, "Command: veracode %s\n\n", strings.Join(cmdArgs, " "))
-	cmd.Stdout = logFileHandle
-	cmd.Stderr = logFileHandle
-
-	if err := cmd.Start(); err != nil {
-		return 0, fmt.Errorf("failed to start pipeline scan: %v", err)
-	}
-
-	pid := cmd.Process.Pid
-	pidInfo := fmt.Sprintf(`{"pid":%d,"results_file":"%s","log_file":"%s"}`,
-		pid, filepath.ToSlash(resultsFile), filepath.ToSlash(logFile))
-	pidFile := filepath.Join(outputDir, "pipeline.pid")
-	// #nosec G306 -- PID file needs to be readable by status checker, contains process info
-	if err := os.WriteFile(pidFile, []byte(pidInfo), 0644); err != nil {
-		_ = cmd.Process.Kill()
-		return 0, fmt.Errorf("failed to write PID file: %v", err)
-	}
-	return pid, nil
-}
-
 // resolveAppInfo looks up the numeric Veracode application ID and policy details.
 // It uses app_profile if provided, otherwise falls back to .veracode-workspace.json.
 // All fields are best-effort: warnings are returned for informational failures.
@@ -241,14 +358,14 @@ func resolveAppInfo(ctx context.Context, applicationPath, appProfile string) app
 		}
 	}
 
-	client, err := api.NewClient()
+	client, err := newWorkspaceClient(applicationPath)
 	if err != nil {
 		log.Printf("pipeline-scan: skipping app lookup, failed to create API client: %v", err)
 		info.Warnings = append(info.Warnings, fmt.Sprintf("Could not connect to Veracode API: %v", err))
 		return info
 	}
 
-	application, err := client.GetApplicationByName(ctx, name)
+	application, err := resolveApplication(ctx, client, name)
 	if err != nil || application == nil {
 		log.Printf("pipeline-scan: skipping app lookup, could not resolve app '%s': %v", name, err)
 		info.Warnings = append(info.Warnings, fmt.Sprintf("Application '%s' not found in Veracode. This scan did not use an application profile or a non-standard policy.", name))
@@ -269,10 +386,10 @@ func resolveAppInfo(ctx context.Context, applicationPath, appProfile string) app
 	return info
 }
 
-// fetchAndSavePolicy retrieves the policy by name and saves it to outputDir/policy.json.
+// fetchAndSavePolicy retrieves the policy by name and saves it to outputDir/policy.json (the run directory).
 // Returns the saved file path on success, or empty string on failure.
-func fetchAndSavePolicy(ctx context.Context, outputDir, policyName string) string {
-	client, err := api.NewClient()
+func fetchAndSavePolicy(ctx context.Context, applicationPath, outputDir, policyName string) string {
+	client, err := newWorkspaceClient(applicationPath)
 	if err != nil {
 		log.Printf("pipeline-scan: skipping policy fetch, failed to create API client: %v", err)
 		return ""
@@ -301,7 +418,8 @@ func fetchAndSavePolicy(ctx context.Context, outputDir, policyName string) strin
 }
 
 // findLargestFile finds the largest file in the specified directory, ignoring log files
-func findLargestFile(dir string) (string, error) {
+// and the files the workspace's package configuration doesn't select
+func findLargestFile(dir string, selection *workspace.PackageConfig) (string, error) {
 	// Check if directory exists
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
 		return "", fmt.Errorf("directory does not exist: %s", dir)
@@ -321,7 +439,7 @@ func findLargestFile(dir string) (string, error) {
 		}
 
 		// Skip log files
-		if strings.HasSuffix(strings.ToLower(entry.Name()), ".log") {
+		if strings.HasSuffix(strings.ToLower(entry.Name()), ".log") || !selection.Selects(entry.Name()) {
 			continue
 		}
 
@@ -337,13 +455,17 @@ func findLargestFile(dir string) (string, error) {
 	}
 
 	if largestFile == "" {
+		if selection != nil {
+			return "", fmt.Errorf("no files selected by the package include/exclude patterns of %s found in directory: %s", workspace.WorkspaceFileName, dir)
+		}
 		return "", fmt.Errorf("no files found in directory: %s", dir)
 	}
 
 	return largestFile, nil
 }
 
-// cleanupPipelineDirectory removes all files from the pipeline directory before starting a new scan
+// cleanupPipelineDirectory removes the files directly in the pipeline directory before starting a new scan.
+// Run directories are left alone; recordPipelineRun prunes them.
 func cleanupPipelineDirectory(outputDir string) error {
 	// Read directory contents
 	entries, err := os.ReadDir(outputDir)
@@ -367,3 +489,13 @@ func cleanupPipelineDirectory(outputDir string) error {
 
 	return nil
 }
+
+// packageSelection returns the workspace's package configuration, which selects the packaged artifacts to scan;
+// nil selects every artifact
+func packageSelection(applicationPath string) *workspace.PackageConfig {
+	config, err := workspace.LoadWorkspaceConfig(applicationPath)
+	if err != nil {
+		return nil
+	}
+	return config.Package
+}
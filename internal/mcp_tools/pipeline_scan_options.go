@@ -0,0 +1,228 @@
+package mcp_tools
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+// parsePipelineScanOptions extracts the Pipeline Scanner options from args,
+// falling back to the pipeline_scan defaults in .veracode-workspace.json, whose paths are relative to the file
+func parsePipelineScanOptions(req *PipelineScanRequest, args map[string]interface{}) error {
+	var defaults workspace.PipelineScanConfig
+	configDir := req.ApplicationPath
+	if config, err := workspace.LoadWorkspaceConfig(req.ApplicationPath); err == nil {
+		if config.PipelineScan != nil {
+			defaults = *config.PipelineScan
+		}
+		// The workspace's policy applies unless the pipeline scan defaults name their own
+		if defaults.PolicyName == "" && defaults.PolicyFile == "" {
+			defaults.PolicyName = config.Policy
+		}
+		configDir = config.Dir()
+	}
+
+	severities, err := extractStringList(args, "fail_on_severity")
+	if err != nil {
+		return err
+	}
+	if severities == nil {
+		severities = defaults.FailOnSeverity
+	}
+	if req.FailOnSeverity, err = normalizePipelineSeverities(severities); err != nil {
+		return err
+	}
+
+	if _, ok := args["fail_on_cwe"]; ok {
+		if req.FailOnCWE, err = extractPipelineCWEs(args["fail_on_cwe"]); err != nil {
+			return err
+		}
+	} else {
+		req.FailOnCWE = defaults.FailOnCWE
+	}
+
+	req.TimeoutMinutes = defaults.TimeoutMinutes
+	_, hasTimeout := args["timeout_minutes"]
+	if hasTimeout {
+		req.TimeoutMinutes = extractInt(args, "timeout_minutes", 0)
+	}
+	if hasTimeout || req.TimeoutMinutes != 0 {
+		if err := validateIntRange(req.TimeoutMinutes, 1, workspace.MaxPipelineScanTimeoutMinutes, "timeout_minutes"); err != nil {
+			return err
+		}
+	}
+
+	modules, err := extractStringList(args, "include_modules")
+	if err != nil {
+		return err
+	}
+	if modules == nil {
+		modules = defaults.IncludeModules
+	}
+	for _, module := range modules {
+		// The scanner takes the patterns as one comma-separated list
+		if strings.Contains(module, ",") {
+			return fmt.Errorf("include_modules patterns must not contain commas, got %q", module)
+		}
+	}
+	req.IncludeModules = modules
+
+	// A policy argument replaces both policy defaults, so a name passed to the tool wins over a default file
+	policyName, hasPolicyName := extractOptionalString(args, "policy_name")
+	policyFile, hasPolicyFile := extractOptionalString(args, "policy_file")
+	if policyName != "" && policyFile != "" {
+		return fmt.Errorf("use either policy_name or policy_file, not both")
+	}
+	req.PolicyName = strings.TrimSpace(policyName)
+	if policyFile != "" {
+		req.PolicyFile = resolveRelativeFile(req.ApplicationPath, policyFile)
+	}
+	if !hasPolicyName && !hasPolicyFile {
+		req.PolicyName = defaults.PolicyName
+		if defaults.PolicyFile != "" {
+			req.PolicyFile = resolveRelativeFile(configDir, defaults.PolicyFile)
+		}
+	}
+
+	if req.Filename == "" && defaults.Filename != "" {
+		if filepath.Base(defaults.Filename) == defaults.Filename {
+			req.Filename = filepath.Join(veracodeWorkDir(req.ApplicationPath, "packaging"), defaults.Filename)
+		} else {
+			req.Filename = resolveRelativeFile(configDir, defaults.Filename)
+		}
+	}
+
+	req.SummaryOnly = defaults.SummaryOnly
+	if summaryOnly, ok := extractOptionalBool(args, "summary_only"); ok {
+		req.SummaryOnly = *summaryOnly
+	}
+	return nil
+}
+
+// normalizePipelineSeverities validates severity names case-insensitively and returns the scanner's spelling
+func normalizePipelineSeverities(values []string) ([]string, error) {
+	var result []string
+	for _, value := range values {
+		name := strings.ReplaceAll(strings.TrimSpace(value), "_", " ")
+		found := false
+		for _, severity := range workspace.PipelineScanSeverities {
+			if strings.EqualFold(name, severity) {
+				result = append(result, severity)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("fail_on_severity must contain %s, got %q", strings.Join(workspace.PipelineScanSeverities, ", "), value)
+		}
+	}
+	return result, nil
+}
+
+// extractPipelineCWEs parses a list of CWE IDs given as numbers, "89" or "CWE-89"
+func extractPipelineCWEs(value interface{}) ([]int, error) {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		var cwe int
+		switch id := v.(type) {
+		case float64:
+			cwe = int(id)
+		case string:
+			parsed, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(id)), "CWE-"))
+			if err != nil {
+				return nil, fmt.Errorf("fail_on_cwe must contain CWE IDs such as 89 or \"CWE-89\", got %q", id)
+			}
+			cwe = parsed
+		default:
+			return nil, fmt.Errorf("fail_on_cwe must contain CWE IDs such as 89 or \"CWE-89\", got %v", id)
+		}
+		if cwe <= 0 {
+			return nil, fmt.Errorf("fail_on_cwe must contain positive CWE IDs, got %d", cwe)
+		}
+		result = append(result, cwe)
+	}
+	return result, nil
+}
+
+// resolveRelativeFile resolves a path relative to dir
+func resolveRelativeFile(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// pipelineScanOptionArgs builds the scanner arguments for the options of a request;
+// policyFile is the policy saved for the scan, if any
+func pipelineScanOptionArgs(req *PipelineScanRequest, run *pipelineRun, policyFile string) []string {
+	var args []string
+	if len(req.FailOnSeverity) > 0 {
+		args = append(args, "--fail-on-severity", strings.Join(req.FailOnSeverity, ","))
+	}
+	if len(req.FailOnCWE) > 0 {
+		cwes := make([]string, len(req.FailOnCWE))
+		for i, cwe := range req.FailOnCWE {
+			cwes[i] = strconv.Itoa(cwe)
+		}
+		args = append(args, "--fail-on-cwe", strings.Join(cwes, ","))
+	}
+	if req.TimeoutMinutes > 0 {
+		args = append(args, "--timeout", strconv.Itoa(req.TimeoutMinutes))
+	}
+	if len(req.IncludeModules) > 0 {
+		args = append(args, "--include", strings.Join(req.IncludeModules, ","))
+	}
+	switch {
+	case policyFile != "":
+		args = append(args, "--policy-file", policyFile)
+	case req.PolicyName != "":
+		// The policy couldn't be downloaded, so let the scanner fetch it by name
+		args = append(args, "--policy-name", req.PolicyName)
+	}
+	if req.SummaryOnly {
+		args = append(args, "--summary-output", run.SummaryFile)
+	}
+	return args
+}
+
+// describePipelineScanOptions lists the options a scan runs with, or "" if it uses the scanner's defaults
+func describePipelineScanOptions(req *PipelineScanRequest) string {
+	var lines []string
+	if len(req.FailOnSeverity) > 0 {
+		lines = append(lines, "Fail on severity: "+strings.Join(req.FailOnSeverity, ", "))
+	}
+	if len(req.FailOnCWE) > 0 {
+		cwes := make([]string, len(req.FailOnCWE))
+		for i, cwe := range req.FailOnCWE {
+			cwes[i] = fmt.Sprintf("CWE-%d", cwe)
+		}
+		lines = append(lines, "Fail on CWE: "+strings.Join(cwes, ", "))
+	}
+	if req.TimeoutMinutes > 0 {
+		lines = append(lines, fmt.Sprintf("Timeout: %d minutes", req.TimeoutMinutes))
+	}
+	if len(req.IncludeModules) > 0 {
+		lines = append(lines, "Include modules: "+strings.Join(req.IncludeModules, ", "))
+	}
+	if req.PolicyName != "" {
+		lines = append(lines, "Policy: "+req.PolicyName)
+	}
+	if req.PolicyFile != "" {
+		lines = append(lines, "Policy file: "+req.PolicyFile)
+	}
+	if req.SummaryOnly {
+		lines = append(lines, "Summary only: yes")
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\nOptions:\n- " + strings.Join(lines, "\n- ") + "\n"
+}
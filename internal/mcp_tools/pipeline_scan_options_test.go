@@ -0,0 +1,102 @@
+package mcp_tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+func TestParsePipelineScanRequest_Options(t *testing.T) {
+	appPath := t.TempDir()
+
+	req, err := parsePipelineScanRequest(map[string]interface{}{
+		"application_path": appPath,
+		"fail_on_severity": []interface{}{"very high", "HIGH"},
+		"fail_on_cwe":      []interface{}{float64(89), "CWE-79"},
+		"timeout_minutes":  float64(30),
+		"include_modules":  []interface{}{"app*.jar"},
+		"policy_name":      "Pipeline Policy",
+		"summary_only":     true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Join(req.FailOnSeverity, ",") != "Very High,High" || len(req.FailOnCWE) != 2 || req.FailOnCWE[1] != 79 {
+		t.Errorf("Unexpected fail-on options: %+v", req)
+	}
+	if req.TimeoutMinutes != 30 || req.IncludeModules[0] != "app*.jar" || req.PolicyName != "Pipeline Policy" || !req.SummaryOnly {
+		t.Errorf("Unexpected options: %+v", req)
+	}
+
+	run := &pipelineRun{ResultsFile: "results.json", FilteredResultsFile: "filtered.json", SummaryFile: "summary.txt"}
+	args := strings.Join(pipelineScanArgs("app.jar", run, "", "", req), " ")
+	for _, want := range []string{"--fail-on-severity Very High,High", "--fail-on-cwe 89,79", "--timeout 30", "--include app*.jar", "--policy-name Pipeline Policy", "--summary-output summary.txt"} {
+		if !strings.Contains(args, want) {
+			t.Errorf("Expected %q in the scan arguments: %s", want, args)
+		}
+	}
+}
+
+func TestParsePipelineScanRequest_InvalidOptions(t *testing.T) {
+	appPath := t.TempDir()
+
+	for _, args := range []map[string]interface{}{
+		{"fail_on_severity": "critical"},
+		{"fail_on_cwe": []interface{}{"SQL injection"}},
+		{"fail_on_cwe": []interface{}{float64(-1)}},
+		{"timeout_minutes": float64(0)},
+		{"timeout_minutes": float64(workspace.MaxPipelineScanTimeoutMinutes + 1)},
+		{"include_modules": "a.jar,b.jar"},
+		{"policy_name": "Pipeline Policy", "policy_file": "policy.json"},
+	} {
+		args["application_path"] = appPath
+		if _, err := parsePipelineScanRequest(args); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
+
+func TestParsePipelineScanRequest_WorkspaceDefaults(t *testing.T) {
+	appPath := t.TempDir()
+	content := `{
+  "name": "PipelineApp",
+  "pipeline_scan": {
+    "fail_on_severity": ["Very High"],
+    "timeout_minutes": 45,
+    "policy_file": "policies/pipeline.json",
+    "filename": "app.war",
+    "summary_only": true
+  }
+}`
+	if err := os.WriteFile(filepath.Join(appPath, workspace.WorkspaceFileName), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := parsePipelineScanRequest(map[string]interface{}{"application_path": appPath})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if req.FailOnSeverity[0] != "Very High" || req.TimeoutMinutes != 45 || !req.SummaryOnly {
+		t.Errorf("Expected the workspace defaults, got %+v", req)
+	}
+	if req.PolicyFile != filepath.Join(appPath, "policies", "pipeline.json") || req.Filename != filepath.Join(veracodeWorkDir(appPath, "packaging"), "app.war") {
+		t.Errorf("Expected paths resolved from the application, got %+v", req)
+	}
+
+	// Arguments take precedence, and a policy argument replaces the default policy file
+	req, err = parsePipelineScanRequest(map[string]interface{}{
+		"application_path": appPath,
+		"timeout_minutes":  float64(10),
+		"policy_name":      "Pipeline Policy",
+		"summary_only":     false,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if req.TimeoutMinutes != 10 || req.PolicyName != "Pipeline Policy" || req.PolicyFile != "" || req.SummaryOnly {
+		t.Errorf("Expected the arguments to override the defaults, got %+v", req)
+	}
+}
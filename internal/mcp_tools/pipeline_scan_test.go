@@ -277,7 +277,7 @@ func TestFindLargestFile_Success(t *testing.T) {
 	}
 
 	// Find the largest file
-	result, err := findLargestFile(tempDir)
+	result, err := findLargestFile(tempDir, nil)
 	if err != nil {
 		t.Fatalf("Failed to find largest file: %v", err)
 	}
@@ -288,7 +288,7 @@ func TestFindLargestFile_Success(t *testing.T) {
 }
 
 func TestFindLargestFile_NonexistentDir(t *testing.T) {
-	_, err := findLargestFile("/nonexistent/directory")
+	_, err := findLargestFile("/nonexistent/directory", nil)
 	if err == nil {
 		t.Error("Expected error for nonexistent directory")
 	}
@@ -297,7 +297,7 @@ func TestFindLargestFile_NonexistentDir(t *testing.T) {
 func TestFindLargestFile_EmptyDir(t *testing.T) {
 	tempDir := t.TempDir()
 
-	_, err := findLargestFile(tempDir)
+	_, err := findLargestFile(tempDir, nil)
 	if err == nil {
 		t.Error("Expected error for empty directory")
 	}
@@ -319,7 +319,7 @@ func TestFindLargestFile_IgnoresDirectories(t *testing.T) {
 	}
 
 	// Find the largest file (should skip the directory)
-	result, err := findLargestFile(tempDir)
+	result, err := findLargestFile(tempDir, nil)
 	if err != nil {
 		t.Fatalf("Failed to find largest file: %v", err)
 	}
@@ -351,7 +351,7 @@ func TestFindLargestFile_IgnoresLogFiles(t *testing.T) {
 	}
 
 	// Find the largest file (should skip log files and return the jar)
-	result, err := findLargestFile(tempDir)
+	result, err := findLargestFile(tempDir, nil)
 	if err != nil {
 		t.Fatalf("Failed to find largest file: %v", err)
 	}
@@ -360,3 +360,41 @@ func TestFindLargestFile_IgnoresLogFiles(t *testing.T) {
 		t.Errorf("Expected largest file to be '%s', got '%s'", smallFile, result)
 	}
 }
+
+func TestParsePipelineScanRequest_Baseline(t *testing.T) {
+	appPath := t.TempDir()
+
+	req, err := parsePipelineScanRequest(map[string]interface{}{"application_path": appPath})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if req.Baseline != "" {
+		t.Errorf("Expected no baseline without a baseline file, got '%s'", req.Baseline)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pipelineBaselinePath(appPath)), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(pipelineBaselinePath(appPath), []byte(`{"findings": []}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		baseline string
+		want     string
+	}{
+		{"", pipelineBaselinePath(appPath)},
+		{"none", ""},
+		{"baselines/release.json", filepath.Join(appPath, "baselines", "release.json")},
+		{"/shared/baseline.json", "/shared/baseline.json"},
+	}
+	for _, tt := range tests {
+		req, err := parsePipelineScanRequest(map[string]interface{}{"application_path": appPath, "baseline": tt.baseline})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if req.Baseline != tt.want {
+			t.Errorf("baseline %q: expected '%s', got '%s'", tt.baseline, tt.want, req.Baseline)
+		}
+	}
+}
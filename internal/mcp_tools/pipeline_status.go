@@ -23,6 +23,7 @@ func init() {
 // PipelineStatusRequest represents the parsed parameters for pipeline-status
 type PipelineStatusRequest struct {
 	ApplicationPath string
+	AllProjects     bool // Report every project of a monorepo workspace file, grouped by application profile
 }
 
 // parsePipelineStatusRequest extracts and validates parameters from the raw args map
@@ -35,6 +36,7 @@ func parsePipelineStatusRequest(args map[string]interface{}) (*PipelineStatusReq
 	if err != nil {
 		return nil, err
 	}
+	req.AllProjects = extractFlag(args, "all_projects")
 
 	return req, nil
 }
@@ -49,6 +51,15 @@ func handlePipelineStatus(ctx context.Context, args map[string]interface{}) (int
 		}, nil
 	}
 
+	if req.AllProjects {
+		return pipelineProjectsStatus(req.ApplicationPath), nil
+	}
+
+	// Scans are tracked as background jobs; only scans started by earlier versions have a PID file
+	if job := backgroundJobs.latest(req.ApplicationPath, PipelineScanToolName); job != nil {
+		return pipelineJobStatus(req.ApplicationPath, job), nil
+	}
+
 	// Locate the PID file
 	outputDir := veracodeWorkDir(req.ApplicationPath, "pipeline")
 	pidFile := filepath.Join(outputDir, "pipeline.pid")
@@ -59,11 +70,7 @@ func handlePipelineStatus(ctx context.Context, args map[string]interface{}) (int
 	if err != nil {
 		if os.IsNotExist(err) {
 			// No PID file — check whether a completed results file exists
-			matches, globErr := filepath.Glob(filepath.Join(outputDir, "results-*.json"))
-			if globErr == nil && len(matches) > 0 {
-				// Use the most recent results file (Glob returns lexicographic order,
-				// and the timestamp format sorts correctly)
-				latest := matches[len(matches)-1]
+			if latest, findErr := latestPipelineFile(outputDir, "results-", ".json"); findErr == nil {
 				return map[string]interface{}{
 					"content": []map[string]string{{
 						"type": "text",
@@ -135,22 +142,28 @@ To start a new scan, use the pipeline-static-scan tool.
 	isRunning, _ := checkProcessStatus(pid)
 
 	// Build response
+	elapsed := ""
+	if startedAt, ok := pipelineLogStartTime(pidInfo.LogFile); ok {
+		elapsed = formatElapsed(time.Since(startedAt))
+	}
 	if isRunning {
+		logSection := pipelineLogSection(pidInfo.LogFile, false)
 		responseText := fmt.Sprintf(`Pipeline Scan Status
 ==================
 
 Application Path: %s
 PID: %d
 Status: RUNNING ⏳
+Elapsed: %s
 Results File: %s
 Log File: %s
-
+%s
 The pipeline scan is currently in progress.
 
 Check again later to see if the scan has completed.
 Results will be available in: %s
 Log output is being written to: %s
-`, req.ApplicationPath, pid, pidInfo.ResultsFile, pidInfo.LogFile, pidInfo.ResultsFile, pidInfo.LogFile)
+`, req.ApplicationPath, pid, elapsed, pidInfo.ResultsFile, pidInfo.LogFile, logSection, pidInfo.ResultsFile, pidInfo.LogFile)
 
 		return map[string]interface{}{
 			"content": []map[string]string{{
@@ -160,7 +173,32 @@ Log output is being written to: %s
 		}, nil
 	}
 
+	// Clean up PID file now that the process has exited
+	_ = os.Remove(pidFile)
+
+	// A process that exited without writing results crashed or was killed, leaving a stale PID file
+	if _, statErr := os.Stat(pidInfo.ResultsFile); statErr != nil {
+		logSection := pipelineLogSection(pidInfo.LogFile, true)
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf(`Pipeline Scan Status
+==================
+
+Application Path: %s
+PID: %d
+Status: FAILED ❌
+Results File: %s (not written)
+Log File: %s
+%s
+%s
+`, req.ApplicationPath, pid, pidInfo.ResultsFile, pidInfo.LogFile, logSection, pipelineScanCrashExplanation),
+			}},
+		}, nil
+	}
+
 	// Process has completed
+	logSection := pipelineLogSection(pidInfo.LogFile, false)
 	responseText := fmt.Sprintf(`Pipeline Scan Status
 ==================
 
@@ -172,12 +210,9 @@ The pipeline scan has finished.
 
 Results File: %s
 Log File: %s
-
+%s
 Check the log file for detailed output from the scan.
-`, req.ApplicationPath, pid, pidInfo.ResultsFile, pidInfo.LogFile)
-
-	// Clean up PID file now that scan is complete
-	_ = os.Remove(pidFile)
+`, req.ApplicationPath, pid, pidInfo.ResultsFile, pidInfo.LogFile, logSection)
 
 	return map[string]interface{}{
 		"content": []map[string]string{{
@@ -187,6 +222,98 @@ Check the log file for detailed output from the scan.
 	}, nil
 }
 
+// pipelineScanCrashExplanation explains a scan process that exited without writing results
+const pipelineScanCrashExplanation = `The scan process is no longer running but it never wrote the results file. It crashed or was killed
+before finishing: for example it ran out of memory, the machine slept or restarted, or the MCP server
+was stopped while it ran. Check the problems and last log line above, then start a new scan with pipeline-scan.`
+
+// pipelineLogSection describes the stage recorded in a scan log, and its problems if the scan failed,
+// or returns "" if the log can't be read
+func pipelineLogSection(logFile string, failed bool) string {
+	status, err := parsePipelineLog(logFile, failed)
+	if err != nil {
+		return ""
+	}
+	return "\n" + status.format()
+}
+
+// pipelineLogStartTime reads the start time from a scan log named scan-{timestamp}.log,
+// or scan-{timestamp}-{n}.log for a run started in the same second as another
+func pipelineLogStartTime(logFile string) (time.Time, bool) {
+	name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(logFile), "scan-"), ".log")
+	if len(name) > len(pipelineRunTimestamp) && name[len(pipelineRunTimestamp)] == '-' {
+		name = name[:len(pipelineRunTimestamp)]
+	}
+	startedAt, err := time.ParseInLocation(pipelineRunTimestamp, name, time.Local)
+	return startedAt, err == nil
+}
+
+// formatElapsed formats a duration to the second
+func formatElapsed(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
+
+// pipelineJobStatus describes the latest pipeline scan job of a workspace
+func pipelineJobStatus(applicationPath string, job *Job) map[string]interface{} {
+	var status, details, elapsed string
+	if startedAt, err := time.Parse(time.RFC3339, job.StartedAt); err == nil {
+		endedAt := time.Now()
+		if parsed, err := time.Parse(time.RFC3339, job.EndedAt); err == nil {
+			endedAt = parsed
+		}
+		elapsed = formatElapsed(endedAt.Sub(startedAt))
+	}
+
+	switch job.State {
+	case JobQueued:
+		status = "QUEUED ⏳"
+		details = "The pipeline scan is waiting to start."
+		if job.After != "" {
+			details = fmt.Sprintf("The pipeline scan starts when job %s has finished packaging the application.", job.After)
+		}
+	case JobRunning:
+		status = "RUNNING ⏳"
+		details = fmt.Sprintf("The pipeline scan is currently in progress (PID %d).\n\nCheck again later to see if the scan has completed.", job.PID)
+	case JobSucceeded:
+		status = "COMPLETED ✓"
+		details = fmt.Sprintf("The pipeline scan has finished. %s\n\n%s", job.Result, job.NextSteps)
+	case JobCancelled:
+		status = "CANCELLED"
+		details = "The pipeline scan was cancelled. Start a new one with pipeline-scan."
+	default:
+		status = "FAILED ❌"
+		details = strings.TrimSpace(fmt.Sprintf("The pipeline scan failed. %s %s", job.Result, job.Error))
+		if _, err := os.Stat(job.ResultFile); err != nil && job.StartedAt != "" {
+			details += "\n\n" + pipelineScanCrashExplanation
+		} else {
+			details += "\n\nCheck the log file (or job-logs) for the scanner's output."
+		}
+	}
+
+	var logSection string
+	if job.State != JobQueued {
+		logSection = pipelineLogSection(job.LogFile, job.State == JobFailed)
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf(`Pipeline Scan Status
+==================
+
+Application Path: %s
+Job: %s
+Status: %s
+Elapsed: %s
+Results File: %s
+Log File: %s
+%s
+%s
+`, applicationPath, job.ID, status, elapsed, job.ResultFile, job.LogFile, logSection, details),
+		}},
+	}
+}
+
 // checkProcessStatus checks if a process is running and returns its exit code if available
 func checkProcessStatus(pid int) (isRunning bool, exitCode int) {
 	if runtime.GOOS == "windows" {
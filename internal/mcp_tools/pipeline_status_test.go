@@ -148,7 +148,31 @@ func TestPipelineStatusTool_WithPIDFile(t *testing.T) {
 	}
 
 	if resultMap["content"] == nil {
-		t.Error("Expected content in result")
+		t.Fatal("Expected content in result")
+	}
+
+	// The process is gone and never wrote results, so the PID file is stale
+	text := resultMap["content"].([]map[string]string)[0]["text"]
+	if !strings.Contains(text, "FAILED") || !strings.Contains(text, "crashed or was killed") {
+		t.Errorf("Expected a crashed scan, got: %s", text)
+	}
+	if _, err := os.Stat(pidFile); !os.IsNotExist(err) {
+		t.Error("Expected the stale PID file to be removed")
+	}
+}
+
+func TestPipelineJobStatus_ReportsLogStage(t *testing.T) {
+	appPath := t.TempDir()
+	logFile := filepath.Join(appPath, "scan.log")
+	if err := os.WriteFile(logFile, []byte("Uploading: app.jar\nScan ID: 4f6c2a10-9b1e-4c55-8a3f-2d7e5b0c9a11\nScan Status: PENDING\nScan Status: STARTED\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	job := &Job{ID: "pipeline-scan-20261019-101500-a1b2", State: JobRunning, StartedAt: "2026-10-19T10:15:00Z",
+		LogFile: logFile, ResultFile: filepath.Join(appPath, "results.json")}
+
+	text := pipelineJobStatus(appPath, job)["content"].([]map[string]string)[0]["text"]
+	if !strings.Contains(text, "Stage: analysis") || !strings.Contains(text, "Scan ID: 4f6c2a10-9b1e-4c55-8a3f-2d7e5b0c9a11") || !strings.Contains(text, "Elapsed: ") {
+		t.Errorf("Expected the stage, scan ID and elapsed time, got: %s", text)
 	}
 }
 
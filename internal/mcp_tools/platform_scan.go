@@ -0,0 +1,367 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const PlatformScanToolName = "platform-scan"
+
+// Stages of a platform scan submission, recorded in build.json
+const (
+	platformStageCreated        = "BUILD_CREATED"
+	platformStageUploaded       = "FILES_UPLOADED"
+	platformStagePrescanStarted = "PRESCAN_STARTED"
+	platformStageBuildDeleted   = "BUILD_DELETED" // A step failed and the incomplete build was deleted
+)
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(PlatformScanToolName, handlePlatformScan)
+}
+
+// PlatformScanRequest represents the parsed parameters for platform-scan
+type PlatformScanRequest struct {
+	ApplicationPath string
+	AppProfile      string
+	Sandbox         string
+	ScanName        string
+	Filenames       []string
+}
+
+// platformScanRecord is the local record of the most recent platform scan submitted for a workspace
+type platformScanRecord struct {
+	AppProfile  string    `json:"app_profile"`
+	AppGUID     string    `json:"app_guid"`
+	AppID       int64     `json:"app_id"`
+	SandboxName string    `json:"sandbox_name,omitempty"`
+	SandboxGUID string    `json:"sandbox_guid,omitempty"`
+	SandboxID   int64     `json:"sandbox_id,omitempty"`
+	BuildID     int64     `json:"build_id"`
+	ScanName    string    `json:"scan_name"`
+	Files       []string  `json:"files"`
+	Stage       string    `json:"stage"`
+	Error       string    `json:"error,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// parsePlatformScanRequest extracts and validates parameters from the raw args map
+func parsePlatformScanRequest(args map[string]interface{}) (*PlatformScanRequest, error) {
+	req := &PlatformScanRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Sandbox, _ = extractOptionalString(args, "sandbox")
+	req.ScanName, _ = extractOptionalString(args, "scan_name")
+	if req.ScanName == "" {
+		req.ScanName = "MCP scan " + time.Now().Format("2006-01-02 15:04:05")
+	}
+
+	filenames, err := extractStringList(args, "filenames")
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range filenames {
+		// Bare filenames refer to the package-workspace output directory
+		if filepath.Base(filename) == filename {
+			filename = filepath.Join(veracodeWorkDir(req.ApplicationPath, "packaging"), filename)
+		}
+		req.Filenames = append(req.Filenames, filename)
+	}
+
+	return req, nil
+}
+
+// resolvePlatformArtifacts returns the files to upload: the requested files, or every
+// artifact package-workspace produced for the application
+func resolvePlatformArtifacts(req *PlatformScanRequest) ([]string, error) {
+	if len(req.Filenames) > 0 {
+		for _, filename := range req.Filenames {
+			info, err := os.Stat(filename)
+			if err != nil {
+				return nil, fmt.Errorf("artifact not found: %s", filename)
+			}
+			if info.IsDir() {
+				return nil, fmt.Errorf("artifact is a directory: %s", filename)
+			}
+		}
+		return req.Filenames, nil
+	}
+
+	packagingDir := veracodeWorkDir(req.ApplicationPath, "packaging")
+	entries, err := os.ReadDir(packagingDir)
+	if err != nil {
+		return nil, fmt.Errorf("no packaged artifacts found in %s - run package-workspace first", packagingDir)
+	}
+
+	selection := packageSelection(req.ApplicationPath)
+	var artifacts []string
+	for _, entry := range entries {
+		// Skip log files, and artifacts the workspace's package configuration excludes
+		if entry.IsDir() || strings.HasSuffix(strings.ToLower(entry.Name()), ".log") || !selection.Selects(entry.Name()) {
+			continue
+		}
+		artifacts = append(artifacts, filepath.Join(packagingDir, entry.Name()))
+	}
+	if len(artifacts) == 0 && selection != nil {
+		return nil, fmt.Errorf("no packaged artifacts in %s match the package include/exclude patterns of %s", packagingDir, workspace.WorkspaceFileName)
+	}
+	if len(artifacts) == 0 {
+		return nil, fmt.Errorf("no packaged artifacts found in %s - run package-workspace first", packagingDir)
+	}
+	sort.Strings(artifacts)
+	return artifacts, nil
+}
+
+// platformScanRecordPath returns where the platform scan record for a workspace is kept
+func platformScanRecordPath(applicationPath string) string {
+	return filepath.Join(veracodeWorkDir(applicationPath, "platform"), "build.json")
+}
+
+// savePlatformScanRecord writes the platform scan record for a workspace
+func savePlatformScanRecord(applicationPath string, record *platformScanRecord) error {
+	recordPath := platformScanRecordPath(applicationPath)
+	if _, err := createVeracodeWorkDir(applicationPath, "platform"); err != nil {
+		return fmt.Errorf("failed to create platform directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode platform scan record: %w", err)
+	}
+	if err := os.WriteFile(recordPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write platform scan record: %w", err)
+	}
+	return nil
+}
+
+// loadPlatformScanRecord reads the platform scan record for a workspace, or returns nil if there is none
+func loadPlatformScanRecord(applicationPath string) (*platformScanRecord, error) {
+	// #nosec G304 -- path is constructed from the Veracode work directory and a fixed filename
+	data, err := os.ReadFile(platformScanRecordPath(applicationPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read platform scan record: %w", err)
+	}
+
+	var record platformScanRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse platform scan record: %w", err)
+	}
+	return &record, nil
+}
+
+// resolvePlatformSandbox looks up a sandbox by name, returning the numeric ID the XML API needs.
+// An empty name means the policy scan and returns 0.
+func resolvePlatformSandbox(ctx context.Context, client api.Client, applicationGUID, name string) (*MCPSandbox, int64, error) {
+	if name == "" {
+		return nil, 0, nil
+	}
+
+	sandbox, err := client.GetSandboxByName(ctx, applicationGUID, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	if sandbox.Id == nil {
+		return nil, 0, fmt.Errorf("sandbox %s has no numeric ID", name)
+	}
+
+	resolved := &MCPSandbox{Name: name, ID: sandbox.GetGuid()}
+	if sandbox.Name != nil {
+		resolved.Name = *sandbox.Name
+	}
+	return resolved, int64(*sandbox.Id), nil
+}
+
+// handlePlatformScan uploads packaged artifacts to the Veracode platform and starts a policy or sandbox scan
+func handlePlatformScan(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parsePlatformScanRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+
+		// The workspace's default sandbox only applies to the workspace's own application
+		if req.Sandbox == "" {
+			req.Sandbox = wsConfig.Sandbox
+		}
+	}
+
+	// Step 2: Find the artifacts before touching the platform
+	artifacts, err := resolvePlatformArtifacts(req)
+	if err != nil {
+		return platformScanError(appProfile, fmt.Sprintf("Error: %v", err)), nil
+	}
+
+	// Step 3: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return platformScanError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 4: Look up the numeric application and sandbox IDs the XML API uses
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return platformScanError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+	}
+	if application.GetId() == 0 {
+		return platformScanError(appProfile, "Error: The application has no numeric ID"), nil
+	}
+
+	sandbox, sandboxID, err := resolvePlatformSandbox(ctx, client, application.GetGuid(), req.Sandbox)
+	if err != nil {
+		return platformScanError(appProfile, fmt.Sprintf("Error: Failed to find sandbox %q\n\n%v", req.Sandbox, err)), nil
+	}
+
+	record := &platformScanRecord{
+		AppProfile:  appProfile,
+		AppGUID:     application.GetGuid(),
+		AppID:       int64(application.GetId()),
+		SandboxGUID: sandboxGUID(sandbox),
+		SandboxID:   sandboxID,
+		ScanName:    req.ScanName,
+		SubmittedAt: time.Now().UTC(),
+	}
+	if sandbox != nil {
+		record.SandboxName = sandbox.Name
+	}
+
+	// Step 5: Create the build, upload the artifacts and start the pre-scan
+	err = submitPlatformScan(ctx, client, req.ApplicationPath, record, artifacts)
+
+	return formatPlatformScanResponse(req.ApplicationPath, record, err), nil
+}
+
+// submitPlatformScan runs createbuild, uploadfile and beginprescan, recording progress after each step
+func submitPlatformScan(ctx context.Context, client api.Client, applicationPath string, record *platformScanRecord, artifacts []string) error {
+	build, err := client.CreateBuild(ctx, record.AppID, record.SandboxID, record.ScanName)
+	if err != nil {
+		return fmt.Errorf("failed to create build: %w", err)
+	}
+	record.BuildID = build.BuildID
+	record.Stage = platformStageCreated
+	if err := savePlatformScanRecord(applicationPath, record); err != nil {
+		return err
+	}
+
+	for _, artifact := range artifacts {
+		if _, err := client.UploadFile(ctx, record.AppID, record.SandboxID, artifact); err != nil {
+			return failPlatformScan(ctx, client, applicationPath, record, fmt.Errorf("failed to upload %s: %w", filepath.Base(artifact), err))
+		}
+		record.Files = append(record.Files, filepath.Base(artifact))
+	}
+	record.Stage = platformStageUploaded
+	if err := savePlatformScanRecord(applicationPath, record); err != nil {
+		return err
+	}
+
+	if _, err := client.BeginPrescan(ctx, record.AppID, record.SandboxID); err != nil {
+		return failPlatformScan(ctx, client, applicationPath, record, fmt.Errorf("failed to begin pre-scan: %w", err))
+	}
+	record.Stage = platformStagePrescanStarted
+	return savePlatformScanRecord(applicationPath, record)
+}
+
+// failPlatformScan deletes the build left incomplete by a failed step, so the next scan can create a new one,
+// and notes the failure in the platform scan record
+func failPlatformScan(ctx context.Context, client api.Client, applicationPath string, record *platformScanRecord, stepErr error) error {
+	if _, err := client.DeleteBuild(ctx, record.AppID, record.SandboxID); err != nil {
+		stepErr = fmt.Errorf("%w; deleting the incomplete build also failed: %v", stepErr, err)
+	} else {
+		record.Stage = platformStageBuildDeleted
+	}
+	record.Error = stepErr.Error()
+	if err := savePlatformScanRecord(applicationPath, record); err != nil {
+		return fmt.Errorf("%w (%v)", stepErr, err)
+	}
+	return stepErr
+}
+
+// platformScanError formats an error as an MCP tool response
+func platformScanError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Platform Scan - Error\n=====================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// formatPlatformScanResponse formats the submitted (or partially submitted) scan into an MCP tool response
+func formatPlatformScanResponse(applicationPath string, record *platformScanRecord, submitErr error) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format platform scan response: %v", err)}
+	}
+
+	target := "policy scan"
+	if record.SandboxName != "" {
+		target = fmt.Sprintf("sandbox %q", record.SandboxName)
+	}
+
+	var summary string
+	switch {
+	case submitErr != nil && record.BuildID == 0:
+		summary = fmt.Sprintf("Nothing was submitted to the %s of %s: %v", target, record.AppProfile, submitErr)
+	case submitErr != nil && record.Stage == platformStageBuildDeleted:
+		summary = fmt.Sprintf(`Nothing was submitted to the %s of %s: %v
+Build %d was deleted again, so the scan can be submitted again once the problem is fixed.`,
+			target, record.AppProfile, submitErr, record.BuildID)
+	case submitErr != nil:
+		summary = fmt.Sprintf(`Build %d of %s (%s) was created but is incomplete: %v
+The build remains in the platform. Fix the problem and delete or finish the build in the Veracode platform before submitting another scan.`,
+			record.BuildID, record.AppProfile, target, submitErr)
+	default:
+		summary = fmt.Sprintf(`Uploaded %d file(s) to build %d of %s (%s) and started the pre-scan. The scan starts automatically once the pre-scan succeeds.
+Use platform-scan-status to follow the scan. Record: %s`,
+			len(record.Files), record.BuildID, record.AppProfile, target, platformScanRecordPath(applicationPath))
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
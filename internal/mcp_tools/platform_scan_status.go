@@ -0,0 +1,249 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const PlatformScanStatusToolName = "platform-scan-status"
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(PlatformScanStatusToolName, handlePlatformScanStatus)
+}
+
+// PlatformScanStatusRequest represents the parsed parameters for platform-scan-status
+type PlatformScanStatusRequest struct {
+	ApplicationPath string
+	BuildID         int64
+}
+
+// MCPPlatformScanStatus is the status of a platform scan build
+type MCPPlatformScanStatus struct {
+	AppProfile             string      `json:"app_profile"`
+	Sandbox                *MCPSandbox `json:"sandbox,omitempty"`
+	BuildID                int64       `json:"build_id"`
+	ScanName               string      `json:"scan_name"`
+	Submitter              string      `json:"submitter,omitempty"`
+	AnalysisStatus         string      `json:"analysis_status"`
+	ResultsReady           bool        `json:"results_ready"`
+	PublishedDate          string      `json:"published_date,omitempty"`
+	PolicyName             string      `json:"policy_name,omitempty"`
+	PolicyComplianceStatus string      `json:"policy_compliance_status,omitempty"`
+}
+
+// parsePlatformScanStatusRequest extracts and validates parameters from the raw args map
+func parsePlatformScanStatusRequest(args map[string]interface{}) (*PlatformScanStatusRequest, error) {
+	req := &PlatformScanStatusRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	buildID := extractInt(args, "build_id", 0)
+	if buildID < 0 {
+		return nil, fmt.Errorf("build_id must be a positive number")
+	}
+	req.BuildID = int64(buildID)
+
+	return req, nil
+}
+
+// handlePlatformScanStatus reports the status of the platform scan recorded by platform-scan
+func handlePlatformScanStatus(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parsePlatformScanStatusRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Load the record platform-scan left for this workspace; a build_id doesn't need one
+	record, err := loadPlatformScanRecord(req.ApplicationPath)
+	if err != nil && req.BuildID == 0 {
+		return map[string]interface{}{"error": err.Error()}, nil
+	}
+	if record == nil && req.BuildID == 0 {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf(`Platform Scan Status
+====================
+
+Application Path: %s
+Record: %s
+
+❌ No platform scan found
+
+No platform scan has been submitted from this workspace.
+
+To start one, package the workspace with package-workspace and then use the platform-scan tool,
+or pass the build_id of a scan submitted another way.
+`, req.ApplicationPath, platformScanRecordPath(req.ApplicationPath)),
+			}},
+		}, nil
+	}
+	if record != nil && record.Stage == platformStageBuildDeleted && req.BuildID == 0 {
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": fmt.Sprintf(`Platform Scan Status
+====================
+
+Application Path: %s
+Record: %s
+
+❌ The last platform scan was not submitted
+
+%s
+Build %d was deleted again. Fix the problem and submit the scan again with platform-scan.
+`, req.ApplicationPath, platformScanRecordPath(req.ApplicationPath), record.Error, record.BuildID),
+			}},
+		}, nil
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		appProfile := ""
+		if record != nil {
+			appProfile = record.AppProfile
+		}
+		return platformScanStatusError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 3: Without a record, look up the workspace's application and sandbox
+	if record == nil {
+		if record, err = workspacePlatformScanRecord(ctx, client, req.ApplicationPath); err != nil {
+			return map[string]interface{}{"error": err.Error()}, nil
+		}
+	}
+	buildID := record.BuildID
+	if req.BuildID != 0 {
+		buildID = req.BuildID
+	}
+
+	// Step 4: Read the build's status
+	build, err := client.GetBuildInfo(ctx, record.AppID, record.SandboxID, buildID)
+	if err != nil {
+		return platformScanStatusError(record.AppProfile, fmt.Sprintf("Error: Failed to get build %d\n\n%v", buildID, err)), nil
+	}
+
+	return formatPlatformScanStatusResponse(record, build), nil
+}
+
+// workspacePlatformScanRecord describes the application and sandbox of the workspace configuration
+// the way platform-scan records them, for checking a build platform-scan didn't record
+func workspacePlatformScanRecord(ctx context.Context, client api.Client, applicationPath string) (*platformScanRecord, error) {
+	wsConfig, err := workspace.LoadWorkspaceConfig(applicationPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workspace configuration: %v", err)
+	}
+	appProfile := wsConfig.Application()
+
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup application %q: %v", appProfile, err)
+	}
+	if application.GetId() == 0 {
+		return nil, fmt.Errorf("application %q has no numeric ID", appProfile)
+	}
+
+	sandbox, sandboxID, err := resolvePlatformSandbox(ctx, client, application.GetGuid(), wsConfig.Sandbox)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sandbox %q: %v", wsConfig.Sandbox, err)
+	}
+
+	record := &platformScanRecord{
+		AppProfile:  appProfile,
+		AppGUID:     application.GetGuid(),
+		AppID:       int64(application.GetId()),
+		SandboxGUID: sandboxGUID(sandbox),
+		SandboxID:   sandboxID,
+	}
+	if sandbox != nil {
+		record.SandboxName = sandbox.Name
+	}
+	return record, nil
+}
+
+// platformScanStatusError formats an error as an MCP tool response
+func platformScanStatusError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Platform Scan Status - Error\n============================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// buildPlatformScanStatus combines the local record with the platform's build information
+func buildPlatformScanStatus(record *platformScanRecord, build *api.BuildInfo) *MCPPlatformScanStatus {
+	status := &MCPPlatformScanStatus{
+		AppProfile:             record.AppProfile,
+		BuildID:                build.BuildID,
+		ScanName:               build.Version,
+		Submitter:              build.Submitter,
+		AnalysisStatus:         build.AnalysisStatus,
+		ResultsReady:           build.ResultsReady,
+		PublishedDate:          build.PublishedDate,
+		PolicyName:             build.PolicyName,
+		PolicyComplianceStatus: build.PolicyComplianceStatus,
+	}
+	if record.SandboxName != "" {
+		status.Sandbox = &MCPSandbox{Name: record.SandboxName, ID: record.SandboxGUID}
+	}
+	return status
+}
+
+// platformScanNextSteps suggests what to do with a build in its current state
+func platformScanNextSteps(status *MCPPlatformScanStatus) string {
+	sandboxHint := ""
+	if status.Sandbox != nil {
+		sandboxHint = fmt.Sprintf(" with sandbox %q", status.Sandbox.Name)
+	}
+
+	analysisStatus := strings.ToLower(status.AnalysisStatus)
+	switch {
+	case status.ResultsReady:
+		return fmt.Sprintf("Results are ready. Use static-findings%s to review the findings, and policy-compliance to check the application against its policy.", sandboxHint)
+	case strings.Contains(analysisStatus, "fail"), strings.Contains(analysisStatus, "cancel"):
+		return "The scan did not complete. Check the build in the Veracode platform, fix the problem and submit a new scan with platform-scan."
+	case strings.Contains(analysisStatus, "incomplete"):
+		return "The build is incomplete: its files were not all uploaded or the pre-scan never started. Finish or delete it in the Veracode platform, or submit a new scan with platform-scan."
+	default:
+		return "The scan is in progress. Check again later with platform-scan-status."
+	}
+}
+
+// formatPlatformScanStatusResponse formats the build status into an MCP tool response
+func formatPlatformScanStatusResponse(record *platformScanRecord, build *api.BuildInfo) map[string]interface{} {
+	status := buildPlatformScanStatus(record, build)
+
+	responseJSON, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format platform scan status: %v", err)}
+	}
+
+	summary := fmt.Sprintf("Build %d (%s) of %s: %s\n%s",
+		status.BuildID, status.ScanName, status.AppProfile, status.AnalysisStatus, platformScanNextSteps(status))
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
@@ -0,0 +1,245 @@
+package mcp_tools
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+// buildClient records the XML build calls; every other method is unimplemented
+type buildClient struct {
+	api.Client
+	failUpload string
+	failDelete bool
+	uploaded   []string
+	prescan    bool
+	deleted    bool
+}
+
+func (c *buildClient) CreateBuild(_ context.Context, appID, sandboxID int64, version string) (*api.BuildInfo, error) {
+	return &api.BuildInfo{AppID: appID, SandboxID: sandboxID, BuildID: 900, Version: version}, nil
+}
+
+func (c *buildClient) UploadFile(_ context.Context, _, _ int64, filePath string) ([]api.UploadedFile, error) {
+	if filepath.Base(filePath) == c.failUpload {
+		return nil, errors.New("API returned status 400")
+	}
+	c.uploaded = append(c.uploaded, filepath.Base(filePath))
+	return nil, nil
+}
+
+func (c *buildClient) BeginPrescan(_ context.Context, _, _ int64) (*api.BuildInfo, error) {
+	c.prescan = true
+	return &api.BuildInfo{BuildID: 900}, nil
+}
+
+func (c *buildClient) DeleteBuild(_ context.Context, _, _ int64) ([]api.BuildSummary, error) {
+	if c.failDelete {
+		return nil, errors.New("API returned status 500")
+	}
+	c.deleted = true
+	return nil, nil
+}
+
+// platformScanWorkspace returns an application path with its own Veracode work directory
+func platformScanWorkspace(t *testing.T, name string) string {
+	t.Helper()
+	appPath := filepath.Join(t.TempDir(), name)
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(veracodeWorkDir(appPath, "platform"))) })
+	return appPath
+}
+
+func TestParsePlatformScanRequest(t *testing.T) {
+	req, err := parsePlatformScanRequest(map[string]interface{}{
+		"application_path": "/work/app",
+		"sandbox":          "dev",
+		"scan_name":        "release 1.2",
+		"filenames":        []interface{}{"app.jar", "/elsewhere/lib.jar"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Sandbox != "dev" || req.ScanName != "release 1.2" {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	want := []string{filepath.Join(veracodeWorkDir("/work/app", "packaging"), "app.jar"), "/elsewhere/lib.jar"}
+	if len(req.Filenames) != 2 || req.Filenames[0] != want[0] || req.Filenames[1] != want[1] {
+		t.Errorf("Filenames = %v, want %v", req.Filenames, want)
+	}
+
+	req, err = parsePlatformScanRequest(map[string]interface{}{"application_path": "/work/app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(req.ScanName, "MCP scan ") {
+		t.Errorf("default ScanName = %q", req.ScanName)
+	}
+
+	if _, err := parsePlatformScanRequest(map[string]interface{}{}); err == nil {
+		t.Error("expected error for missing application_path")
+	}
+}
+
+func TestResolvePlatformArtifacts(t *testing.T) {
+	appPath := platformScanWorkspace(t, "platform-artifacts-app")
+	req := &PlatformScanRequest{ApplicationPath: appPath}
+
+	if _, err := resolvePlatformArtifacts(req); err == nil || !strings.Contains(err.Error(), "package-workspace") {
+		t.Errorf("expected package-workspace hint, got %v", err)
+	}
+
+	packagingDir := veracodeWorkDir(appPath, "packaging")
+	if err := os.MkdirAll(packagingDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"web.war", "api.jar", "packaging.log"} {
+		if err := os.WriteFile(filepath.Join(packagingDir, name), []byte("x"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	artifacts, err := resolvePlatformArtifacts(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(artifacts) != 2 || filepath.Base(artifacts[0]) != "api.jar" || filepath.Base(artifacts[1]) != "web.war" {
+		t.Errorf("artifacts = %v, want api.jar and web.war", artifacts)
+	}
+
+	req.Filenames = []string{filepath.Join(packagingDir, "missing.jar")}
+	if _, err := resolvePlatformArtifacts(req); err == nil {
+		t.Error("expected error for missing artifact")
+	}
+}
+
+func TestSubmitPlatformScan(t *testing.T) {
+	appPath := platformScanWorkspace(t, "platform-submit-app")
+	client := &buildClient{}
+	record := &platformScanRecord{AppProfile: "App", AppID: 10, SandboxID: 20, ScanName: "scan 1"}
+
+	if err := submitPlatformScan(context.Background(), client, appPath, record, []string{"/pkg/a.jar", "/pkg/b.jar"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !client.prescan || len(client.uploaded) != 2 {
+		t.Errorf("expected both uploads and the pre-scan, got %v (prescan %v)", client.uploaded, client.prescan)
+	}
+
+	saved, err := loadPlatformScanRecord(appPath)
+	if err != nil || saved == nil {
+		t.Fatalf("failed to load record: %v", err)
+	}
+	if saved.BuildID != 900 || saved.Stage != platformStagePrescanStarted || saved.SandboxID != 20 || len(saved.Files) != 2 {
+		t.Errorf("unexpected record: %+v", saved)
+	}
+}
+
+func TestSubmitPlatformScan_UploadFailure(t *testing.T) {
+	appPath := platformScanWorkspace(t, "platform-failure-app")
+	client := &buildClient{failUpload: "b.jar"}
+	record := &platformScanRecord{AppProfile: "App", AppID: 10, ScanName: "scan 1"}
+
+	submitErr := submitPlatformScan(context.Background(), client, appPath, record, []string{"/pkg/a.jar", "/pkg/b.jar"})
+	if submitErr == nil || !strings.Contains(submitErr.Error(), "b.jar") {
+		t.Fatalf("expected upload failure for b.jar, got %v", submitErr)
+	}
+	if client.prescan {
+		t.Error("pre-scan should not start after a failed upload")
+	}
+	if !client.deleted {
+		t.Error("the incomplete build should be deleted")
+	}
+
+	saved, err := loadPlatformScanRecord(appPath)
+	if err != nil || saved == nil {
+		t.Fatalf("failed to load record: %v", err)
+	}
+	if saved.Stage != platformStageBuildDeleted || saved.Error == "" || saved.BuildID != 900 {
+		t.Errorf("unexpected record: %+v", saved)
+	}
+
+	text := formatPlatformScanResponse(appPath, record, submitErr)["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "Build 900 was deleted again") {
+		t.Errorf("expected deleted build note, got %q", text)
+	}
+}
+
+func TestSubmitPlatformScan_UploadFailureDeleteFails(t *testing.T) {
+	appPath := platformScanWorkspace(t, "platform-delete-failure-app")
+	client := &buildClient{failUpload: "a.jar", failDelete: true}
+	record := &platformScanRecord{AppProfile: "App", AppID: 10, ScanName: "scan 1"}
+
+	submitErr := submitPlatformScan(context.Background(), client, appPath, record, []string{"/pkg/a.jar"})
+	if submitErr == nil || !strings.Contains(submitErr.Error(), "deleting the incomplete build also failed") {
+		t.Fatalf("expected the failed delete to be reported, got %v", submitErr)
+	}
+	if record.Stage != platformStageCreated {
+		t.Errorf("expected the build to remain created, got %s", record.Stage)
+	}
+
+	text := formatPlatformScanResponse(appPath, record, submitErr)["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "incomplete") {
+		t.Errorf("expected incomplete build note, got %q", text)
+	}
+}
+
+func TestLoadPlatformScanRecord_Missing(t *testing.T) {
+	appPath := platformScanWorkspace(t, "platform-missing-app")
+	record, err := loadPlatformScanRecord(appPath)
+	if err != nil || record != nil {
+		t.Errorf("expected no record, got %+v, %v", record, err)
+	}
+}
+
+func TestPlatformScanNextSteps(t *testing.T) {
+	tests := []struct {
+		name   string
+		status MCPPlatformScanStatus
+		want   string
+	}{
+		{"results ready", MCPPlatformScanStatus{ResultsReady: true, AnalysisStatus: "Results Ready", Sandbox: &MCPSandbox{Name: "dev"}}, `static-findings with sandbox "dev"`},
+		{"pre-scan failed", MCPPlatformScanStatus{AnalysisStatus: "Pre-Scan Failed"}, "did not complete"},
+		{"incomplete", MCPPlatformScanStatus{AnalysisStatus: "Incomplete"}, "incomplete"},
+		{"in progress", MCPPlatformScanStatus{AnalysisStatus: "Scan In Process"}, "in progress"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := platformScanNextSteps(&tt.status); !strings.Contains(got, tt.want) {
+				t.Errorf("platformScanNextSteps() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// applicationLookupClient finds one application by name; every other method is unimplemented
+type applicationLookupClient struct {
+	api.Client
+}
+
+func (c *applicationLookupClient) GetApplicationByName(_ context.Context, name string) (*applications.Application, error) {
+	if name != "MyApp" {
+		return nil, errors.New("application not found")
+	}
+	return &applications.Application{Guid: stringPtr("app-guid"), Id: int32Ptr(42)}, nil
+}
+
+func TestWorkspacePlatformScanRecord(t *testing.T) {
+	appPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(appPath, workspace.WorkspaceFileName), []byte(`{"name": "MyApp"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := workspacePlatformScanRecord(context.Background(), &applicationLookupClient{}, appPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.AppProfile != "MyApp" || record.AppID != 42 || record.SandboxID != 0 {
+		t.Errorf("expected the workspace's policy scan of application 42, got %+v", record)
+	}
+}
@@ -0,0 +1,772 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/policy"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const PolicyComplianceToolName = "policy-compliance"
+
+// maxPolicyFindingsPerScanType caps the policy-violating findings fetched for each scan type
+const maxPolicyFindingsPerScanType = 1000
+
+// maxViolationsPerRule caps the findings listed under each rule; the counts still cover every finding
+const maxViolationsPerRule = 20
+
+// Rule and scan frequency evaluation results
+const (
+	ruleStatusFailing       = "FAILING"
+	ruleStatusInGracePeriod = "IN_GRACE_PERIOD"
+	ruleStatusPassing       = "PASSING"
+	ruleStatusNotEvaluated  = "NOT_EVALUATED"
+
+	scanFrequencyMet          = "MET"
+	scanFrequencyOverdue      = "OVERDUE"
+	scanFrequencyNeverScanned = "NEVER_SCANNED"
+	scanFrequencyNotEvaluated = "NOT_EVALUATED"
+)
+
+// policyScanTypes are the Findings API scan types a policy's finding rules can apply to
+var policyScanTypes = []string{"STATIC", "DYNAMIC", "MANUAL", "SCA"}
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(PolicyComplianceToolName, handlePolicyCompliance)
+}
+
+// PolicyComplianceRequest represents the parsed parameters for policy-compliance
+type PolicyComplianceRequest struct {
+	ApplicationPath string `json:"application_path"`
+	AppProfile      string `json:"app_profile,omitempty"`
+}
+
+// MCPPolicyCompliance explains an application's policy compliance status
+type MCPPolicyCompliance struct {
+	Application      MCPApplication           `json:"application"`
+	Policy           MCPPolicySummary         `json:"policy"`
+	ComplianceStatus string                   `json:"compliance_status"` // PASSED, CONDITIONAL_PASS, DID_NOT_PASS, NOT_ASSESSED, DETERMINING or VENDOR_REVIEW
+	Rules            []MCPPolicyRuleResult    `json:"rules"`
+	OtherViolations  *MCPPolicyRuleResult     `json:"other_violations,omitempty"` // Policy-violating findings no evaluated rule accounts for
+	ScanFrequency    []MCPScanFrequencyResult `json:"scan_frequency,omitempty"`
+	GracePeriods     MCPPolicyGracePeriods    `json:"grace_periods"`
+	NextSteps        []string                 `json:"next_steps"`
+	Warnings         []string                 `json:"warnings,omitempty"`
+}
+
+// MCPPolicySummary identifies the policy an application is evaluated against
+type MCPPolicySummary struct {
+	Name        string `json:"name"`
+	GUID        string `json:"guid"`
+	Version     int32  `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// MCPPolicyRuleResult reports one finding rule and the findings that break it
+type MCPPolicyRuleResult struct {
+	Type                string               `json:"type"`
+	ScanTypes           []string             `json:"scan_types,omitempty"`
+	Value               string               `json:"value,omitempty"`
+	Description         string               `json:"description"`
+	Status              string               `json:"status"` // FAILING, IN_GRACE_PERIOD, PASSING or NOT_EVALUATED
+	FailingFindings     int                  `json:"failing_findings"`
+	InGracePeriod       int                  `json:"in_grace_period"`
+	EarliestGraceExpiry string               `json:"earliest_grace_expiry,omitempty"` // RFC3339
+	Findings            []MCPPolicyViolation `json:"findings,omitempty"`
+	Truncated           bool                 `json:"truncated,omitempty"` // More findings break the rule than are listed
+	Note                string               `json:"note,omitempty"`
+}
+
+// MCPPolicyViolation is a finding that breaks a policy rule
+type MCPPolicyViolation struct {
+	FlawID             string  `json:"flaw_id"`
+	ScanType           string  `json:"scan_type"`
+	Severity           string  `json:"severity"`
+	SeverityScore      int32   `json:"severity_score"`
+	CweId              int32   `json:"cwe_id,omitempty"`
+	CweName            string  `json:"cwe_name,omitempty"`
+	Location           string  `json:"location,omitempty"` // File and line, URL or component
+	CVE                string  `json:"cve,omitempty"`
+	CVSS               float64 `json:"cvss,omitempty"`
+	GracePeriodExpires string  `json:"grace_period_expires,omitempty"` // RFC3339
+	GracePeriodExpired bool    `json:"grace_period_expired"`
+}
+
+// MCPScanFrequencyResult reports whether a scan type has been scanned as often as the policy requires
+type MCPScanFrequencyResult struct {
+	ScanType  string `json:"scan_type"`
+	Frequency string `json:"frequency"`
+	LastScan  string `json:"last_scan,omitempty"` // RFC3339
+	DueBy     string `json:"due_by,omitempty"`    // RFC3339
+	Status    string `json:"status"`              // MET, OVERDUE, NEVER_SCANNED or NOT_EVALUATED
+}
+
+// MCPPolicyGracePeriods summarises the days a policy allows to fix a finding before it fails the application
+type MCPPolicyGracePeriods struct {
+	BySeverity          map[string]int32 `json:"by_severity,omitempty"`           // Severity name -> days
+	ScaBySeverity       map[string]int32 `json:"sca_by_severity,omitempty"`       // Severity name -> days
+	ScaBlocklist        *int32           `json:"sca_blocklist,omitempty"`         // Days for component blocklist rules
+	ScaLicenseRisk      *int32           `json:"sca_license_risk,omitempty"`      // Days for license risk rules
+	ScaByCVSS           []string         `json:"sca_by_cvss,omitempty"`           // "lower-upper: N days"
+	Score               *int32           `json:"score,omitempty"`                 // Days for the minimum score rule
+	ExpiredFindings     int              `json:"expired_findings"`                // Policy-violating findings past their grace period
+	InGracePeriod       int              `json:"in_grace_period"`                 // Policy-violating findings still within their grace period
+	EarliestGraceExpiry string           `json:"earliest_grace_expiry,omitempty"` // RFC3339
+}
+
+// parsePolicyComplianceRequest extracts and validates parameters from the raw args map
+func parsePolicyComplianceRequest(args map[string]interface{}) (*PolicyComplianceRequest, error) {
+	req := &PolicyComplianceRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+
+	return req, nil
+}
+
+func handlePolicyCompliance(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parsePolicyComplianceRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return policyComplianceError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 3: Look up the application, which carries its policy and latest scans
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return policyComplianceError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+	}
+
+	appPolicy := applicationPolicy(application)
+	if appPolicy == nil || appPolicy.Guid == nil {
+		return policyComplianceError(appProfile, "Error: The application has no policy assigned"), nil
+	}
+
+	// Step 4: Load the policy's rules and grace periods
+	policyVersion, err := client.GetPolicyByGUID(ctx, *appPolicy.Guid)
+	if err != nil {
+		return policyComplianceError(appProfile, fmt.Sprintf(`Error: Failed to retrieve policy %q
+
+%v
+
+Please verify API credentials have access to view policies.`, appPolicy.GetName(), err)), nil
+	}
+
+	// Step 5: Fetch the open findings that violate policy for every scan type the rules cover
+	violatesPolicy := true
+	var violations []api.Finding
+	var warnings []string
+	for _, scanType := range ruleScanTypes(policyVersion.FindingRules) {
+		findingsReq := api.FindingsRequest{
+			AppProfile:     application.GetGuid(),
+			ViolatesPolicy: &violatesPolicy,
+			IncludeExpDate: true,
+		}
+		findingsResp, err := client.FetchAllFindings(ctx, findingsReq, scanType, maxPolicyFindingsPerScanType)
+		if err != nil {
+			log.Printf("Policy compliance: failed to retrieve %s findings: %v", scanType, err)
+			warnings = append(warnings, fmt.Sprintf("Could not retrieve %s findings: %v", scanType, err))
+			continue
+		}
+		if findingsResp.Truncated {
+			warnings = append(warnings, fmt.Sprintf("Only the first %d of %d policy-violating %s findings were evaluated", len(findingsResp.Findings), findingsResp.TotalCount, scanType))
+		}
+		for _, finding := range findingsResp.Findings {
+			if finding.Status != string(StatusClosed) {
+				violations = append(violations, finding)
+			}
+		}
+	}
+
+	// Step 6: Explain the compliance status
+	compliance := evaluatePolicyCompliance(application, appPolicy, policyVersion, violations, time.Now())
+	compliance.Warnings = append(compliance.Warnings, warnings...)
+
+	return formatPolicyComplianceResponse(compliance), nil
+}
+
+// policyComplianceError formats an error as an MCP tool response
+func policyComplianceError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Policy Compliance - Error\n=========================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// applicationPolicy returns the policy the application is evaluated against
+func applicationPolicy(application *applications.Application) *applications.AppPolicy {
+	if application.Profile == nil || len(application.Profile.Policies) == 0 {
+		return nil
+	}
+	for i, appPolicy := range application.Profile.Policies {
+		if appPolicy.GetIsDefault() {
+			return &application.Profile.Policies[i]
+		}
+	}
+	return &application.Profile.Policies[0]
+}
+
+// ruleScanTypes returns the Findings API scan types referenced by a policy's finding rules
+func ruleScanTypes(rules []policy.FindingRule) []string {
+	var scanTypes []string
+	for _, scanType := range policyScanTypes {
+		for _, rule := range rules {
+			if ruleAppliesToScanType(rule, scanType) {
+				scanTypes = append(scanTypes, scanType)
+				break
+			}
+		}
+	}
+	return scanTypes
+}
+
+// ruleAppliesToScanType reports whether a finding rule is enforced on findings of scanType
+func ruleAppliesToScanType(rule policy.FindingRule, scanType string) bool {
+	for _, ruleScanType := range rule.ScanType {
+		switch ruleScanType {
+		case "ALL":
+			return true
+		case "DYNAMICMP":
+			if scanType == "DYNAMIC" {
+				return true
+			}
+		default:
+			if ruleScanType == scanType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evaluatePolicyCompliance attributes each policy-violating finding to the rules it breaks and
+// checks the application's latest scans against the policy's scan frequency rules
+func evaluatePolicyCompliance(application *applications.Application, appPolicy *applications.AppPolicy, policyVersion *policy.PolicyVersion, violations []api.Finding, now time.Time) *MCPPolicyCompliance {
+	compliance := &MCPPolicyCompliance{
+		Application: MCPApplication{
+			Name: application.Profile.GetName(),
+			ID:   application.GetGuid(),
+		},
+		Policy: MCPPolicySummary{
+			Name:        policyVersion.GetName(),
+			GUID:        policyVersion.GetGuid(),
+			Version:     policyVersion.GetVersion(),
+			Description: policyVersion.GetDescription(),
+		},
+		ComplianceStatus: appPolicy.GetPolicyComplianceStatus(),
+		Rules:            []MCPPolicyRuleResult{},
+		GracePeriods:     policyGracePeriods(policyVersion),
+	}
+	if application.Profile != nil {
+		compliance.Application.BusinessCriticality = application.Profile.GetBusinessCriticality()
+	}
+
+	attributed := make(map[string]bool)
+	for _, rule := range policyVersion.FindingRules {
+		result := MCPPolicyRuleResult{
+			Type:        rule.GetType(),
+			ScanTypes:   rule.ScanType,
+			Value:       rule.GetValue(),
+			Description: describeFindingRule(rule),
+		}
+
+		if note := unevaluatedRuleNote(rule.GetType()); note != "" {
+			result.Status = ruleStatusNotEvaluated
+			result.Note = note
+			compliance.Rules = append(compliance.Rules, result)
+			continue
+		}
+
+		var matches []api.Finding
+		for _, finding := range violations {
+			if findingBreaksRule(rule, finding) {
+				matches = append(matches, finding)
+				attributed[finding.ScanType+"/"+finding.ID] = true
+			}
+		}
+		addRuleViolations(&result, matches, now)
+		compliance.Rules = append(compliance.Rules, result)
+	}
+
+	// The platform flagged these findings, but none of the rules evaluated here explains why
+	var unattributed []api.Finding
+	for _, finding := range violations {
+		if !attributed[finding.ScanType+"/"+finding.ID] {
+			unattributed = append(unattributed, finding)
+		}
+	}
+	if len(unattributed) > 0 {
+		other := &MCPPolicyRuleResult{
+			Type:        "OTHER",
+			Description: "Findings the platform marks as violating policy that no evaluated rule accounts for",
+		}
+		addRuleViolations(other, unattributed, now)
+		compliance.OtherViolations = other
+	}
+
+	// Grace period totals count each finding once, however many rules it breaks
+	var earliest time.Time
+	for _, finding := range violations {
+		expires, expired := graceExpiry(finding, now)
+		if expired {
+			compliance.GracePeriods.ExpiredFindings++
+			continue
+		}
+		compliance.GracePeriods.InGracePeriod++
+		if earliest.IsZero() || expires.Before(earliest) {
+			earliest = expires
+		}
+	}
+	if !earliest.IsZero() {
+		compliance.GracePeriods.EarliestGraceExpiry = earliest.Format(time.RFC3339)
+	}
+
+	compliance.ScanFrequency = evaluateScanFrequency(policyVersion.ScanFrequencyRules, application, now)
+	compliance.NextSteps = policyNextSteps(compliance)
+
+	return compliance
+}
+
+// unevaluatedRuleNote explains why a rule type can't be checked against individual findings, or returns "" if it can
+func unevaluatedRuleNote(ruleType string) string {
+	switch ruleType {
+	case "MIN_SCORE":
+		return "The policy score isn't available from the Findings API; check the application's score in the Veracode platform"
+	case "SECURITY_STANDARD":
+		return "Security standard mappings (e.g. OWASP Top 10) aren't available per finding; review this rule in the Veracode platform"
+	case "BLACKLIST", "ALLOWLIST":
+		return "Component blocklist and allowlist violations aren't reported as findings; review the application's SCA components in the Veracode platform"
+	case "LICENSE_RISK":
+		return "License risk violations aren't reported as findings; review the application's component licenses in the Veracode platform"
+	default:
+		return ""
+	}
+}
+
+// findingBreaksRule reports whether a policy-violating finding is accounted for by a finding rule
+func findingBreaksRule(rule policy.FindingRule, finding api.Finding) bool {
+	if !ruleAppliesToScanType(rule, finding.ScanType) {
+		return false
+	}
+
+	value := strings.TrimSpace(rule.GetValue())
+	switch rule.GetType() {
+	case "FAIL_ALL":
+		return true
+	case "CWE":
+		cweID := strings.TrimPrefix(finding.CWE, "CWE-")
+		for _, ruleCWE := range strings.Split(value, ",") {
+			if strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(ruleCWE)), "CWE-") == cweID {
+				return true
+			}
+		}
+		return false
+	case "CATEGORY":
+		return finding.CategoryName != "" && (strings.EqualFold(value, finding.CategoryName) || value == strconv.Itoa(int(finding.CategoryID)))
+	case "MAX_SEVERITY":
+		minSeverity, err := strconv.Atoi(value)
+		return err == nil && int(finding.SeverityScore) >= minSeverity
+	case "CVSS":
+		minCVSS, err := strconv.ParseFloat(value, 64)
+		return err == nil && finding.CVSS > 0 && finding.CVSS >= minCVSS
+	case "CVE":
+		return finding.CVE != "" && strings.EqualFold(finding.CVE, value)
+	default:
+		return false
+	}
+}
+
+// describeFindingRule explains a finding rule in plain words
+func describeFindingRule(rule policy.FindingRule) string {
+	value := rule.GetValue()
+	scanTypes := strings.Join(rule.ScanType, ", ")
+
+	var description string
+	switch rule.GetType() {
+	case "FAIL_ALL":
+		description = "No findings allowed"
+	case "CWE":
+		description = fmt.Sprintf("No findings of CWE %s", value)
+	case "CATEGORY":
+		description = fmt.Sprintf("No findings in category %s", value)
+	case "MAX_SEVERITY":
+		severity, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			description = fmt.Sprintf("No findings of severity %s or higher", value)
+			break
+		}
+		score := int32(severity)
+		description = fmt.Sprintf("No findings of severity %s (%d) or higher", TransformSeverity(&score), score)
+	case "CVSS":
+		description = fmt.Sprintf("No vulnerabilities with a CVSS score of %s or higher", value)
+	case "CVE":
+		description = fmt.Sprintf("No components affected by %s", value)
+	case "MIN_SCORE":
+		description = fmt.Sprintf("Policy score must be at least %s", value)
+	case "SECURITY_STANDARD":
+		description = fmt.Sprintf("No findings in security standard %s", value)
+	case "BLACKLIST":
+		description = "No blocklisted components"
+	case "ALLOWLIST":
+		description = "Only allowlisted components"
+	case "LICENSE_RISK":
+		description = fmt.Sprintf("No component licenses of risk %s or higher", value)
+	default:
+		description = fmt.Sprintf("%s rule %s", rule.GetType(), value)
+	}
+
+	if scanTypes != "" {
+		description += fmt.Sprintf(" (%s)", scanTypes)
+	}
+	return description
+}
+
+// addRuleViolations records the findings that break a rule and sets its status from their grace periods
+func addRuleViolations(result *MCPPolicyRuleResult, matches []api.Finding, now time.Time) {
+	// List expired findings first, then the most severe
+	sort.SliceStable(matches, func(i, j int) bool {
+		_, expiredI := graceExpiry(matches[i], now)
+		_, expiredJ := graceExpiry(matches[j], now)
+		if expiredI != expiredJ {
+			return expiredI
+		}
+		return matches[i].SeverityScore > matches[j].SeverityScore
+	})
+
+	var earliest time.Time
+	for _, finding := range matches {
+		expires, expired := graceExpiry(finding, now)
+		if expired {
+			result.FailingFindings++
+		} else {
+			result.InGracePeriod++
+			if earliest.IsZero() || expires.Before(earliest) {
+				earliest = expires
+			}
+		}
+
+		if len(result.Findings) < maxViolationsPerRule {
+			result.Findings = append(result.Findings, policyViolation(finding, expired))
+		} else {
+			result.Truncated = true
+		}
+	}
+	if !earliest.IsZero() {
+		result.EarliestGraceExpiry = earliest.Format(time.RFC3339)
+	}
+
+	switch {
+	case result.FailingFindings > 0:
+		result.Status = ruleStatusFailing
+	case result.InGracePeriod > 0:
+		result.Status = ruleStatusInGracePeriod
+	default:
+		result.Status = ruleStatusPassing
+	}
+}
+
+// graceExpiry returns when a finding's grace period ends and whether it already has.
+// A finding without a grace period expiry date fails policy immediately.
+func graceExpiry(finding api.Finding, now time.Time) (time.Time, bool) {
+	if finding.GracePeriodExpires == "" {
+		return time.Time{}, true
+	}
+	expires, err := time.Parse(time.RFC3339, finding.GracePeriodExpires)
+	if err != nil {
+		return time.Time{}, true
+	}
+	return expires, !expires.After(now)
+}
+
+// policyViolation converts a finding into an MCPPolicyViolation
+func policyViolation(finding api.Finding, expired bool) MCPPolicyViolation {
+	severity := finding.SeverityScore
+	violation := MCPPolicyViolation{
+		FlawID:             finding.ID,
+		ScanType:           finding.ScanType,
+		Severity:           string(TransformSeverity(&severity)),
+		SeverityScore:      finding.SeverityScore,
+		CVE:                finding.CVE,
+		CVSS:               finding.CVSS,
+		GracePeriodExpires: finding.GracePeriodExpires,
+		GracePeriodExpired: expired,
+	}
+
+	if cweID, err := strconv.ParseInt(strings.TrimPrefix(finding.CWE, "CWE-"), 10, 32); err == nil {
+		violation.CweId = int32(cweID)
+		violation.CweName = CWEName(violation.CweId)
+	}
+
+	switch {
+	case finding.FilePath != "" && finding.LineNumber > 0:
+		violation.Location = fmt.Sprintf("%s:%d", finding.FilePath, finding.LineNumber)
+	case finding.FilePath != "":
+		violation.Location = finding.FilePath
+	case finding.URL != "":
+		violation.Location = finding.URL
+	case finding.ComponentFilename != "":
+		violation.Location = strings.TrimSpace(finding.ComponentFilename + " " + finding.ComponentVersion)
+	}
+
+	return violation
+}
+
+// policyGracePeriods summarises a policy's grace periods
+func policyGracePeriods(policyVersion *policy.PolicyVersion) MCPPolicyGracePeriods {
+	gracePeriods := MCPPolicyGracePeriods{
+		BySeverity: severityGracePeriods([]*int32{
+			policyVersion.Sev0GracePeriod,
+			policyVersion.Sev1GracePeriod,
+			policyVersion.Sev2GracePeriod,
+			policyVersion.Sev3GracePeriod,
+			policyVersion.Sev4GracePeriod,
+			policyVersion.Sev5GracePeriod,
+		}),
+		ScaBlocklist: policyVersion.ScaBlacklistGracePeriod,
+		Score:        policyVersion.ScoreGracePeriod,
+	}
+
+	if sca := policyVersion.ScaGracePeriods; sca != nil {
+		if sca.ScaBlacklistGracePeriod != nil {
+			gracePeriods.ScaBlocklist = sca.ScaBlacklistGracePeriod
+		}
+		gracePeriods.ScaLicenseRisk = sca.LicenseRiskGracePeriod
+		if bySeverity := sca.SeverityGracePeriod; bySeverity != nil {
+			gracePeriods.ScaBySeverity = severityGracePeriods([]*int32{
+				bySeverity.Sev0GracePeriod,
+				bySeverity.Sev1GracePeriod,
+				bySeverity.Sev2GracePeriod,
+				bySeverity.Sev3GracePeriod,
+				bySeverity.Sev4GracePeriod,
+			})
+		}
+		for _, cvss := range sca.CvssScoreGracePeriod {
+			if cvss.Days != nil {
+				gracePeriods.ScaByCVSS = append(gracePeriods.ScaByCVSS, fmt.Sprintf("%.1f-%.1f: %d days", cvss.GetLower(), cvss.GetUpper(), *cvss.Days))
+			}
+		}
+	}
+
+	return gracePeriods
+}
+
+// severityGracePeriods maps grace periods indexed by severity score to severity names
+func severityGracePeriods(days []*int32) map[string]int32 {
+	bySeverity := make(map[string]int32)
+	for score, gracePeriod := range days {
+		if gracePeriod == nil {
+			continue
+		}
+		severity := int32(score) // #nosec G115 - at most six severities
+		bySeverity[string(TransformSeverity(&severity))] = *gracePeriod
+	}
+	if len(bySeverity) == 0 {
+		return nil
+	}
+	return bySeverity
+}
+
+// evaluateScanFrequency checks the application's latest scans against the policy's scan frequency rules
+func evaluateScanFrequency(rules []policy.ScanFrequency, application *applications.Application, now time.Time) []MCPScanFrequencyResult {
+	var results []MCPScanFrequencyResult
+	for _, rule := range rules {
+		frequency := rule.GetFrequency()
+		if frequency == "" || frequency == "NOT_REQUIRED" {
+			continue
+		}
+
+		result := MCPScanFrequencyResult{
+			ScanType:  rule.GetScanType(),
+			Frequency: frequency,
+		}
+
+		lastScan := latestScan(application, result.ScanType)
+		if !lastScan.IsZero() {
+			result.LastScan = lastScan.Format(time.RFC3339)
+		}
+
+		switch {
+		case frequency == "SET_BY_VL_POLICY" || frequency == "SET_BY_POLICY_RULE":
+			result.Status = scanFrequencyNotEvaluated
+		case lastScan.IsZero():
+			result.Status = scanFrequencyNeverScanned
+		case frequency == "ONCE":
+			result.Status = scanFrequencyMet
+		default:
+			dueBy := addScanInterval(lastScan, frequency)
+			result.DueBy = dueBy.Format(time.RFC3339)
+			if dueBy.Before(now) {
+				result.Status = scanFrequencyOverdue
+			} else {
+				result.Status = scanFrequencyMet
+			}
+		}
+
+		results = append(results, result)
+	}
+	return results
+}
+
+// latestScan returns when the application was last scanned with scanType ("ANY" for any scan type)
+func latestScan(application *applications.Application, scanType string) time.Time {
+	var latest time.Time
+	for _, scan := range application.Scans {
+		if scan.ModifiedDate == nil || (scanType != "ANY" && scan.GetScanType() != scanType) {
+			continue
+		}
+		if scan.ModifiedDate.After(latest) {
+			latest = *scan.ModifiedDate
+		}
+	}
+	if scanType == "ANY" && application.LastCompletedScanDate != nil && application.LastCompletedScanDate.After(latest) {
+		latest = *application.LastCompletedScanDate
+	}
+	return latest
+}
+
+// addScanInterval returns when the next scan is due after a scan at last
+func addScanInterval(last time.Time, frequency string) time.Time {
+	switch frequency {
+	case "WEEKLY":
+		return last.AddDate(0, 0, 7)
+	case "MONTHLY":
+		return last.AddDate(0, 1, 0)
+	case "QUARTERLY":
+		return last.AddDate(0, 3, 0)
+	case "SEMI_ANNUALLY":
+		return last.AddDate(0, 6, 0)
+	case "ANNUALLY":
+		return last.AddDate(1, 0, 0)
+	case "EVERY_18_MONTHS":
+		return last.AddDate(0, 18, 0)
+	case "EVERY_2_YEARS":
+		return last.AddDate(2, 0, 0)
+	case "EVERY_3_YEARS":
+		return last.AddDate(3, 0, 0)
+	default:
+		return last
+	}
+}
+
+// policyNextSteps lists what would bring the application back into compliance
+func policyNextSteps(compliance *MCPPolicyCompliance) []string {
+	var steps []string
+
+	rules := compliance.Rules
+	if compliance.OtherViolations != nil {
+		rules = append(rules[:len(rules):len(rules)], *compliance.OtherViolations)
+	}
+	for _, rule := range rules {
+		switch rule.Status {
+		case ruleStatusFailing:
+			steps = append(steps, fmt.Sprintf("Fix or mitigate %d finding(s) breaking %q, e.g. flaw IDs %s", rule.FailingFindings, rule.Description, exampleFlawIDs(rule.Findings)))
+		case ruleStatusNotEvaluated:
+			steps = append(steps, fmt.Sprintf("Review %q in the Veracode platform: %s", rule.Description, rule.Note))
+		}
+	}
+
+	for _, scan := range compliance.ScanFrequency {
+		switch scan.Status {
+		case scanFrequencyNeverScanned:
+			steps = append(steps, fmt.Sprintf("Run a %s scan: the policy requires one %s and the application has never had one", scan.ScanType, strings.ToLower(scan.Frequency)))
+		case scanFrequencyOverdue:
+			steps = append(steps, fmt.Sprintf("Run a %s scan: the policy requires one %s, and the last (%s) was due again by %s", scan.ScanType, strings.ToLower(scan.Frequency), scan.LastScan, scan.DueBy))
+		}
+	}
+
+	if compliance.GracePeriods.InGracePeriod > 0 {
+		steps = append(steps, fmt.Sprintf("Fix or mitigate the %d finding(s) still within their grace period before %s, or the application will fail policy", compliance.GracePeriods.InGracePeriod, compliance.GracePeriods.EarliestGraceExpiry))
+	}
+
+	if len(steps) == 0 {
+		switch compliance.ComplianceStatus {
+		case "PASSED":
+			steps = append(steps, "Nothing to do: the application passes policy")
+		case "DETERMINING":
+			steps = append(steps, "Wait for Veracode to finish evaluating the latest scan, then check again")
+		case "NOT_ASSESSED":
+			steps = append(steps, "Run a policy scan: the application hasn't been assessed against its policy")
+		default:
+			steps = append(steps, "No failing rules were found in the current findings; rescan the application so Veracode re-evaluates its compliance")
+		}
+	}
+
+	return steps
+}
+
+// exampleFlawIDs lists the flaw IDs of up to five findings
+func exampleFlawIDs(violations []MCPPolicyViolation) string {
+	var ids []string
+	for _, violation := range violations {
+		if len(ids) == 5 {
+			break
+		}
+		ids = append(ids, violation.FlawID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// formatPolicyComplianceResponse formats the compliance explanation into an MCP tool response
+func formatPolicyComplianceResponse(compliance *MCPPolicyCompliance) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(compliance, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format policy compliance: %v", err)}
+	}
+
+	summary := fmt.Sprintf("%s is %s against policy %q.\n\n", compliance.Application.Name, compliance.ComplianceStatus, compliance.Policy.Name)
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + string(responseJSON),
+		}},
+	}
+}
@@ -0,0 +1,184 @@
+package mcp_tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/policy"
+)
+
+var policyTestNow = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func findingRule(ruleType, value string, scanTypes ...string) policy.FindingRule {
+	return policy.FindingRule{Type: stringPtr(ruleType), Value: stringPtr(value), ScanType: scanTypes}
+}
+
+func testPolicyApplication(scans ...applications.ApplicationScan) (*applications.Application, *applications.AppPolicy) {
+	appPolicy := applications.AppPolicy{
+		Guid:                   stringPtr("policy-guid"),
+		Name:                   stringPtr("Veracode Recommended Medium"),
+		PolicyComplianceStatus: stringPtr("DID_NOT_PASS"),
+	}
+	application := &applications.Application{
+		Guid: stringPtr("app-guid"),
+		Profile: &applications.ApplicationProfile{
+			Name:     stringPtr("MyApp"),
+			Policies: []applications.AppPolicy{appPolicy},
+		},
+		Scans: scans,
+	}
+	return application, &application.Profile.Policies[0]
+}
+
+func TestParsePolicyComplianceRequest(t *testing.T) {
+	if _, err := parsePolicyComplianceRequest(map[string]interface{}{}); err == nil {
+		t.Error("Expected error when application_path is missing")
+	}
+
+	req, err := parsePolicyComplianceRequest(map[string]interface{}{"application_path": "/app", "app_profile": "MyApp"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if req.ApplicationPath != "/app" || req.AppProfile != "MyApp" {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+}
+
+func TestFindingBreaksRule(t *testing.T) {
+	static := api.Finding{ID: "1", ScanType: "STATIC", CWE: "CWE-89", SeverityScore: 4, CategoryID: 19, CategoryName: "SQL Injection"}
+	sca := api.Finding{ID: "2", ScanType: "SCA", CWE: "CWE-502", SeverityScore: 3, CVE: "CVE-2021-44228", CVSS: 10}
+
+	tests := []struct {
+		name    string
+		rule    policy.FindingRule
+		finding api.Finding
+		want    bool
+	}{
+		{"fail all", findingRule("FAIL_ALL", "", "ALL"), static, true},
+		{"scan type mismatch", findingRule("FAIL_ALL", "", "DYNAMIC"), static, false},
+		{"cwe list", findingRule("CWE", "79, 89", "STATIC"), static, true},
+		{"cwe miss", findingRule("CWE", "79", "STATIC"), static, false},
+		{"category by name", findingRule("CATEGORY", "sql injection", "STATIC"), static, true},
+		{"category by id", findingRule("CATEGORY", "19", "STATIC"), static, true},
+		{"severity at threshold", findingRule("MAX_SEVERITY", "4", "STATIC"), static, true},
+		{"severity below threshold", findingRule("MAX_SEVERITY", "5", "STATIC"), static, false},
+		{"cvss", findingRule("CVSS", "9.0", "SCA"), sca, true},
+		{"cvss static finding", findingRule("CVSS", "9.0", "ALL"), static, false},
+		{"cve", findingRule("CVE", "cve-2021-44228", "SCA"), sca, true},
+		{"unevaluated type", findingRule("MIN_SCORE", "80", "STATIC"), static, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findingBreaksRule(tt.rule, tt.finding); got != tt.want {
+				t.Errorf("findingBreaksRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicyCompliance(t *testing.T) {
+	application, appPolicy := testPolicyApplication(applications.ApplicationScan{
+		ScanType:     stringPtr("STATIC"),
+		ModifiedDate: timePtr(policyTestNow.AddDate(0, -4, 0)),
+	})
+	policyVersion := &policy.PolicyVersion{
+		Guid: stringPtr("policy-guid"),
+		Name: stringPtr("Veracode Recommended Medium"),
+		FindingRules: []policy.FindingRule{
+			findingRule("MAX_SEVERITY", "4", "STATIC", "DYNAMIC"),
+			findingRule("MIN_SCORE", "80", "STATIC"),
+		},
+		ScanFrequencyRules: []policy.ScanFrequency{
+			{ScanType: stringPtr("STATIC"), Frequency: stringPtr("QUARTERLY")},
+			{ScanType: stringPtr("DYNAMIC"), Frequency: stringPtr("ANNUALLY")},
+			{ScanType: stringPtr("SCA"), Frequency: stringPtr("NOT_REQUIRED")},
+		},
+		Sev4GracePeriod: int32Ptr(30),
+		ScaGracePeriods: &policy.ScaGracePeriods{ScaBlacklistGracePeriod: int32Ptr(14)},
+	}
+	violations := []api.Finding{
+		{ID: "101", ScanType: "STATIC", CWE: "CWE-89", SeverityScore: 4, FilePath: "app/db.go", LineNumber: 42, GracePeriodExpires: "2026-05-01T00:00:00Z"},
+		{ID: "102", ScanType: "STATIC", CWE: "CWE-79", SeverityScore: 5, GracePeriodExpires: "2026-06-15T00:00:00Z"},
+		{ID: "103", ScanType: "STATIC", CWE: "CWE-117", SeverityScore: 3},
+	}
+
+	compliance := evaluatePolicyCompliance(application, appPolicy, policyVersion, violations, policyTestNow)
+
+	if compliance.ComplianceStatus != "DID_NOT_PASS" || compliance.Policy.Name != "Veracode Recommended Medium" {
+		t.Errorf("Unexpected compliance header: %+v", compliance)
+	}
+
+	severityRule := compliance.Rules[0]
+	if severityRule.Status != ruleStatusFailing || severityRule.FailingFindings != 1 || severityRule.InGracePeriod != 1 {
+		t.Errorf("Expected one expired and one in-grace finding, got %+v", severityRule)
+	}
+	if severityRule.Findings[0].FlawID != "101" || severityRule.Findings[0].Location != "app/db.go:42" {
+		t.Errorf("Expected the expired finding listed first with its location, got %+v", severityRule.Findings[0])
+	}
+	if severityRule.EarliestGraceExpiry != "2026-06-15T00:00:00Z" {
+		t.Errorf("Unexpected earliest grace expiry: %s", severityRule.EarliestGraceExpiry)
+	}
+
+	if compliance.Rules[1].Status != ruleStatusNotEvaluated || compliance.Rules[1].Note == "" {
+		t.Errorf("Expected MIN_SCORE to be reported as not evaluated, got %+v", compliance.Rules[1])
+	}
+
+	if compliance.OtherViolations == nil || compliance.OtherViolations.FailingFindings != 1 || compliance.OtherViolations.Findings[0].FlawID != "103" {
+		t.Errorf("Expected the medium finding reported as an other violation, got %+v", compliance.OtherViolations)
+	}
+
+	if len(compliance.ScanFrequency) != 2 {
+		t.Fatalf("Expected two scan frequency results (NOT_REQUIRED skipped), got %+v", compliance.ScanFrequency)
+	}
+	if compliance.ScanFrequency[0].Status != scanFrequencyOverdue || compliance.ScanFrequency[1].Status != scanFrequencyNeverScanned {
+		t.Errorf("Expected STATIC overdue and DYNAMIC never scanned, got %+v", compliance.ScanFrequency)
+	}
+
+	if compliance.GracePeriods.BySeverity["HIGH"] != 30 || compliance.GracePeriods.ScaBlocklist == nil || *compliance.GracePeriods.ScaBlocklist != 14 {
+		t.Errorf("Unexpected grace periods: %+v", compliance.GracePeriods)
+	}
+	if compliance.GracePeriods.ExpiredFindings != 2 || compliance.GracePeriods.InGracePeriod != 1 {
+		t.Errorf("Expected 2 expired and 1 in-grace findings, got %+v", compliance.GracePeriods)
+	}
+
+	steps := strings.Join(compliance.NextSteps, "\n")
+	for _, want := range []string{"flaw IDs 101", "Run a STATIC scan", "Run a DYNAMIC scan", "Policy score must be at least 80", "before 2026-06-15"} {
+		if !strings.Contains(steps, want) {
+			t.Errorf("Expected next steps to mention %q, got:\n%s", want, steps)
+		}
+	}
+}
+
+func TestEvaluatePolicyCompliance_Passing(t *testing.T) {
+	application, appPolicy := testPolicyApplication()
+	appPolicy.PolicyComplianceStatus = stringPtr("PASSED")
+	policyVersion := &policy.PolicyVersion{
+		FindingRules: []policy.FindingRule{findingRule("MAX_SEVERITY", "4", "ALL")},
+	}
+
+	compliance := evaluatePolicyCompliance(application, appPolicy, policyVersion, nil, policyTestNow)
+
+	if compliance.Rules[0].Status != ruleStatusPassing {
+		t.Errorf("Expected the rule to pass, got %+v", compliance.Rules[0])
+	}
+	if compliance.OtherViolations != nil {
+		t.Errorf("Expected no other violations, got %+v", compliance.OtherViolations)
+	}
+	if len(compliance.NextSteps) != 1 || !strings.Contains(compliance.NextSteps[0], "passes policy") {
+		t.Errorf("Unexpected next steps: %v", compliance.NextSteps)
+	}
+}
+
+func TestAddScanInterval(t *testing.T) {
+	last := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	if got := addScanInterval(last, "WEEKLY"); !got.Equal(last.AddDate(0, 0, 7)) {
+		t.Errorf("Unexpected weekly due date: %v", got)
+	}
+	if got := addScanInterval(last, "EVERY_18_MONTHS"); got.Year() != 2027 || got.Month() != time.July {
+		t.Errorf("Unexpected 18 month due date: %v", got)
+	}
+}
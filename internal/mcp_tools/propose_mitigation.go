@@ -0,0 +1,413 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const ProposeMitigationToolName = "propose-mitigation"
+
+// mitigationActions are the updatemitigationinfo.do actions the tool accepts, with the wording the platform shows for each
+var mitigationActions = map[string]string{
+	"comment":    "Comment",
+	"fp":         "Potential False Positive",
+	"appdesign":  "Mitigate by Design",
+	"osenv":      "Mitigate by OS Environment",
+	"netenv":     "Mitigate by Network Environment",
+	"library":    "Reported to Library Maintainer",
+	"acceptrisk": "Accept the Risk",
+	"accepted":   "Approve Mitigation",
+	"rejected":   "Reject Mitigation",
+}
+
+// mitigationActionNames lists the accepted actions for validation, in upper case for extractOptionalEnum
+var mitigationActionNames = []string{"COMMENT", "FP", "APPDESIGN", "OSENV", "NETENV", "LIBRARY", "ACCEPTRISK", "ACCEPTED", "REJECTED"}
+
+// maxMitigationFlaws caps the flaws a single call can act on
+const maxMitigationFlaws = 100
+
+// mitigationScanTypes are searched, in order, for the flaws to act on. SCA is included so SCA flaws
+// are reported as such rather than as missing.
+var mitigationScanTypes = []string{"STATIC", "DYNAMIC", "MANUAL", "SCA"}
+
+// Per-flaw outcomes reported in the response
+const (
+	mitigationFlawPending   = "PENDING_CONFIRMATION"
+	mitigationFlawSubmitted = "SUBMITTED"
+	mitigationFlawFailed    = "FAILED"
+)
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(ProposeMitigationToolName, handleProposeMitigation)
+}
+
+// ProposeMitigationRequest represents the parsed parameters for propose-mitigation
+type ProposeMitigationRequest struct {
+	ApplicationPath string  `json:"application_path"`
+	AppProfile      string  `json:"app_profile,omitempty"`
+	Sandbox         string  `json:"sandbox,omitempty"`
+	FlawIDs         []int32 `json:"flaw_ids"`
+	Action          string  `json:"action"` // Lower case, as sent to updatemitigationinfo.do
+	Comment         string  `json:"comment"`
+	Review          bool    `json:"review,omitempty"`  // The caller asked to approve or reject a mitigation
+	Confirm         bool    `json:"confirm,omitempty"` // The user confirmed the submission
+}
+
+// MCPMitigationSubmission describes a mitigation action and the flaws it applies to
+type MCPMitigationSubmission struct {
+	ApplicationPath string              `json:"application_path"`
+	AppProfile      string              `json:"app_profile"`
+	Sandbox         *MCPSandbox         `json:"sandbox,omitempty"`
+	Action          string              `json:"action"`
+	ActionName      string              `json:"action_name"`
+	Comment         string              `json:"comment"`
+	Submitted       bool                `json:"submitted"` // False until the call is confirmed
+	Flaws           []MCPMitigationFlaw `json:"flaws"`
+}
+
+// MCPMitigationFlaw is the outcome of a mitigation action for one flaw
+type MCPMitigationFlaw struct {
+	FlawID           int32  `json:"flaw_id"`
+	ScanType         string `json:"scan_type,omitempty"`
+	BuildID          int64  `json:"build_id,omitempty"`
+	CWE              string `json:"cwe,omitempty"`
+	Severity         string `json:"severity,omitempty"`
+	Location         string `json:"location,omitempty"`
+	ResolutionStatus string `json:"resolution_status,omitempty"` // Before this action
+	Status           string `json:"status"`                      // PENDING_CONFIRMATION, SUBMITTED or FAILED
+	Error            string `json:"error,omitempty"`
+}
+
+// parseProposeMitigationRequest extracts and validates parameters from the raw args map
+func parseProposeMitigationRequest(args map[string]interface{}) (*ProposeMitigationRequest, error) {
+	req := &ProposeMitigationRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+	req.Sandbox, _ = extractOptionalString(args, "sandbox")
+
+	req.FlawIDs, err = extractInt32List(args, "flaw_ids")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.FlawIDs) == 0 {
+		return nil, fmt.Errorf("flaw_ids is required")
+	}
+	if len(req.FlawIDs) > maxMitigationFlaws {
+		return nil, fmt.Errorf("flaw_ids can contain at most %d flaws, got %d", maxMitigationFlaws, len(req.FlawIDs))
+	}
+	req.FlawIDs = uniqueFlawIDs(req.FlawIDs)
+
+	action, err := extractOptionalEnum(args, "action", mitigationActionNames)
+	if err != nil {
+		return nil, err
+	}
+	if action == "" {
+		return nil, fmt.Errorf("action is required")
+	}
+	req.Action = strings.ToLower(action)
+
+	req.Comment, err = extractRequiredString(args, "comment")
+	if err != nil {
+		return nil, err
+	}
+	req.Comment = strings.TrimSpace(req.Comment)
+	if len(req.Comment) > api.MaxMitigationCommentLength {
+		return nil, fmt.Errorf("comment must be at most %d characters, got %d", api.MaxMitigationCommentLength, len(req.Comment))
+	}
+
+	req.Review = extractFlag(args, "review")
+	req.Confirm = extractFlag(args, "confirm")
+
+	// Approving or rejecting is a reviewer's decision, so it must be asked for explicitly
+	isReviewAction := api.IsMitigationReviewAction(req.Action)
+	if isReviewAction && !req.Review {
+		return nil, fmt.Errorf("action %q approves or rejects a mitigation; set review to true only if the user explicitly asked to review mitigations", req.Action)
+	}
+	if req.Review && !isReviewAction {
+		return nil, fmt.Errorf("review can only be used with the accepted or rejected actions, got %q", req.Action)
+	}
+
+	return req, nil
+}
+
+// uniqueFlawIDs removes repeated flaw IDs, keeping the first occurrence of each
+func uniqueFlawIDs(flawIDs []int32) []int32 {
+	unique := make([]int32, 0, len(flawIDs))
+	for _, flawID := range flawIDs {
+		if !slices.Contains(unique, flawID) {
+			unique = append(unique, flawID)
+		}
+	}
+	return unique
+}
+
+func handleProposeMitigation(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseProposeMitigationRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		if err != nil {
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
+			}, nil
+		}
+		appProfile = wsConfig.Application()
+
+		// The workspace's default sandbox only applies to the workspace's own application
+		if req.Sandbox == "" {
+			req.Sandbox = wsConfig.Sandbox
+		}
+	}
+
+	// Step 2: Create API client
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		return proposeMitigationError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+	}
+
+	// Step 3: Look up the application and sandbox the flaws belong to
+	application, err := resolveApplication(ctx, client, appProfile)
+	if err != nil {
+		return proposeMitigationError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+	}
+
+	sandbox, err := resolveSandbox(ctx, client, application.GetGuid(), req.Sandbox)
+	if err != nil {
+		return proposeMitigationError(appProfile, fmt.Sprintf("Error: Failed to find sandbox %q\n\n%v", req.Sandbox, err)), nil
+	}
+
+	// Step 4: Find each flaw's build, which updatemitigationinfo.do needs. Nothing is submitted unless every flaw checks out.
+	submission := &MCPMitigationSubmission{
+		ApplicationPath: req.ApplicationPath,
+		AppProfile:      appProfile,
+		Sandbox:         sandbox,
+		Action:          req.Action,
+		ActionName:      mitigationActions[req.Action],
+		Comment:         req.Comment,
+	}
+	findings, searchErrs := findMitigationFindings(ctx, client, application.GetGuid(), sandboxGUID(sandbox), req.FlawIDs)
+	failed := false
+	for _, flawID := range req.FlawIDs {
+		var lookupErr error
+		finding, found := findings[strconv.Itoa(int(flawID))]
+		if !found {
+			lookupErr = fmt.Errorf("issue %d is not in the application's findings", flawID)
+			if len(searchErrs) > 0 {
+				lookupErr = fmt.Errorf("%w (%w)", lookupErr, errors.Join(searchErrs...))
+			}
+		}
+		flaw := mitigationFlaw(flawID, finding, lookupErr, req.Action)
+		if flaw.Status == mitigationFlawFailed {
+			failed = true
+		}
+		submission.Flaws = append(submission.Flaws, flaw)
+	}
+	if failed || !req.Confirm {
+		return formatMitigationResponse(submission), nil
+	}
+
+	// Step 5: Submit the action once per build
+	submitMitigation(ctx, client, submission)
+
+	return formatMitigationResponse(submission), nil
+}
+
+// proposeMitigationError formats an error as an MCP tool response
+func proposeMitigationError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("Propose Mitigation - Error\n==========================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// findMitigationFindings walks the findings of each scan type once, indexing the requested flaws by issue ID,
+// and stops as soon as every flaw has been found. A scan type that can't be read (e.g. one the application
+// isn't entitled to) is skipped and its error returned so missing flaws can explain it.
+func findMitigationFindings(ctx context.Context, client api.Client, applicationGUID, sandboxGUID string, flawIDs []int32) (map[string]*api.Finding, []error) {
+	wanted := make(map[string]bool, len(flawIDs))
+	for _, flawID := range flawIDs {
+		wanted[strconv.Itoa(int(flawID))] = true
+	}
+
+	found := make(map[string]*api.Finding, len(flawIDs))
+	var searchErrs []error
+	findingsReq := api.FindingsRequest{AppProfile: applicationGUID, Sandbox: sandboxGUID, IncludeAnnot: true}
+	for _, scanType := range mitigationScanTypes {
+		for finding, err := range client.IterateFindings(ctx, findingsReq, scanType) {
+			if err != nil {
+				searchErrs = append(searchErrs, fmt.Errorf("%s: %w", scanType, err))
+				break
+			}
+			if wanted[finding.ID] {
+				found[finding.ID] = &finding
+				if len(found) == len(wanted) {
+					return found, nil
+				}
+			}
+		}
+	}
+	return found, searchErrs
+}
+
+// mitigationFlaw checks that a flaw can take a mitigation action, pending confirmation
+func mitigationFlaw(flawID int32, finding *api.Finding, lookupErr error, action string) MCPMitigationFlaw {
+	flaw := MCPMitigationFlaw{FlawID: flawID, Status: mitigationFlawPending}
+	if lookupErr != nil {
+		flaw.Status = mitigationFlawFailed
+		flaw.Error = fmt.Sprintf("finding not found: %v", lookupErr)
+		return flaw
+	}
+
+	flaw.ScanType = finding.ScanType
+	flaw.BuildID = finding.BuildID
+	flaw.CWE = finding.CWE
+	severity := finding.SeverityScore
+	flaw.Severity = string(TransformSeverity(&severity))
+	flaw.ResolutionStatus = finding.ResolutionStatus
+	switch {
+	case finding.FilePath != "":
+		flaw.Location = fmt.Sprintf("%s:%d", finding.FilePath, finding.LineNumber)
+	case finding.URL != "":
+		flaw.Location = finding.URL
+	}
+
+	switch {
+	case finding.ScanType == "SCA":
+		flaw.Status = mitigationFlawFailed
+		flaw.Error = "SCA findings are mitigated per component in the Veracode platform, not through the mitigation API"
+	case finding.BuildID == 0:
+		flaw.Status = mitigationFlawFailed
+		flaw.Error = "the finding has no build ID, so the mitigation API can't address it"
+	case api.IsMitigationReviewAction(action) && !strings.EqualFold(finding.ResolutionStatus, string(MitigationProposed)):
+		flaw.Status = mitigationFlawFailed
+		flaw.Error = fmt.Sprintf("there is no proposed mitigation to review (resolution status %s)", finding.ResolutionStatus)
+	}
+	return flaw
+}
+
+// submitMitigation sends the action for every flaw, one call per build, and records each flaw's outcome
+func submitMitigation(ctx context.Context, client api.Client, submission *MCPMitigationSubmission) {
+	flawsByBuild := make(map[int64][]int)
+	for i, flaw := range submission.Flaws {
+		flawsByBuild[flaw.BuildID] = append(flawsByBuild[flaw.BuildID], i)
+	}
+	buildIDs := make([]int64, 0, len(flawsByBuild))
+	for buildID := range flawsByBuild {
+		buildIDs = append(buildIDs, buildID)
+	}
+	sort.Slice(buildIDs, func(i, j int) bool { return buildIDs[i] < buildIDs[j] })
+
+	for _, buildID := range buildIDs {
+		indexes := flawsByBuild[buildID]
+		flawIDs := make([]int64, len(indexes))
+		for i, index := range indexes {
+			flawIDs[i] = int64(submission.Flaws[index].FlawID)
+		}
+
+		info, err := client.UpdateMitigationInfo(ctx, buildID, submission.Action, submission.Comment, flawIDs)
+		if err != nil {
+			log.Printf("Propose mitigation: updatemitigationinfo failed for build %d: %v", buildID, err)
+		} else {
+			submission.Submitted = true
+		}
+		for _, index := range indexes {
+			applyMitigationOutcome(&submission.Flaws[index], info, err)
+		}
+	}
+}
+
+// applyMitigationOutcome records whether the API accepted the action for a flaw
+func applyMitigationOutcome(flaw *MCPMitigationFlaw, info *api.MitigationInfo, submitErr error) {
+	if submitErr != nil {
+		flaw.Status = mitigationFlawFailed
+		flaw.Error = submitErr.Error()
+		return
+	}
+
+	flawID := strconv.Itoa(int(flaw.FlawID))
+	for _, mitigationErr := range info.Errors {
+		for _, failedID := range strings.Split(mitigationErr.FlawIDList, ",") {
+			if strings.TrimSpace(failedID) == flawID {
+				flaw.Status = mitigationFlawFailed
+				flaw.Error = fmt.Sprintf("rejected by the API: %s", mitigationErr.Type)
+				return
+			}
+		}
+	}
+	flaw.Status = mitigationFlawSubmitted
+}
+
+// formatMitigationResponse formats the submission, or the preview awaiting confirmation, into an MCP tool response
+func formatMitigationResponse(submission *MCPMitigationSubmission) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(submission, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format mitigation response: %v", err)}
+	}
+
+	counts := make(map[string]int)
+	for _, flaw := range submission.Flaws {
+		counts[flaw.Status]++
+	}
+
+	var summary string
+	switch {
+	case counts[mitigationFlawPending] == len(submission.Flaws):
+		summary = fmt.Sprintf(`Nothing has been submitted yet. Show the user the %d flaw(s), the action %q (%s) and the comment below, and ask them to confirm.
+Once they confirm, call propose-mitigation again with the same parameters and confirm set to true.`,
+			len(submission.Flaws), submission.Action, submission.ActionName)
+	case !submission.Submitted:
+		summary = fmt.Sprintf("Nothing was submitted: %d of %d flaw(s) can't take this action. Remove or correct them and try again.",
+			counts[mitigationFlawFailed], len(submission.Flaws))
+	default:
+		summary = fmt.Sprintf("Submitted %q (%s) for %d of %d flaw(s).", submission.Action, submission.ActionName, counts[mitigationFlawSubmitted], len(submission.Flaws))
+		if !api.IsMitigationReviewAction(submission.Action) && submission.Action != "comment" {
+			summary += " The proposal now awaits review by a mitigation approver."
+		}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + "\n\n" + string(responseJSON),
+		}},
+	}
+}
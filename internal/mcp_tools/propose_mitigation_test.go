@@ -0,0 +1,207 @@
+package mcp_tools
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+func mitigationArgs(overrides map[string]interface{}) map[string]interface{} {
+	args := map[string]interface{}{
+		"application_path": "/app",
+		"flaw_ids":         []interface{}{float64(7), float64(8), float64(7)},
+		"action":           "appdesign",
+		"comment":          "  Input is validated against an allowlist  ",
+	}
+	for key, value := range overrides {
+		args[key] = value
+	}
+	return args
+}
+
+func TestParseProposeMitigationRequest(t *testing.T) {
+	req, err := parseProposeMitigationRequest(mitigationArgs(nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if req.Action != "appdesign" || req.Comment != "Input is validated against an allowlist" || req.Confirm {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+	if len(req.FlawIDs) != 2 || req.FlawIDs[0] != 7 || req.FlawIDs[1] != 8 {
+		t.Errorf("Expected repeated flaw IDs to be removed, got %v", req.FlawIDs)
+	}
+
+	req, err = parseProposeMitigationRequest(mitigationArgs(map[string]interface{}{"action": "FP", "confirm": true}))
+	if err != nil || req.Action != "fp" || !req.Confirm {
+		t.Errorf("Expected a confirmed fp action, got %+v, %v", req, err)
+	}
+}
+
+func TestParseProposeMitigationRequest_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]interface{}
+		wantErr   string
+	}{
+		{"missing flaw_ids", map[string]interface{}{"flaw_ids": nil}, "flaw_ids is required"},
+		{"invalid flaw id", map[string]interface{}{"flaw_ids": []interface{}{float64(-1)}}, "positive"},
+		{"missing action", map[string]interface{}{"action": ""}, "action is required"},
+		{"unknown action", map[string]interface{}{"action": "remediated"}, "action must be one of"},
+		{"missing comment", map[string]interface{}{"comment": ""}, "comment"},
+		{"comment too long", map[string]interface{}{"comment": strings.Repeat("a", api.MaxMitigationCommentLength+1)}, "at most"},
+		{"approve without review", map[string]interface{}{"action": "accepted"}, "set review to true"},
+		{"reject without review", map[string]interface{}{"action": "rejected", "review": false}, "set review to true"},
+		{"review with a proposal", map[string]interface{}{"action": "fp", "review": true}, "accepted or rejected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseProposeMitigationRequest(mitigationArgs(tt.overrides))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if _, err := parseProposeMitigationRequest(mitigationArgs(map[string]interface{}{"action": "accepted", "review": true})); err != nil {
+		t.Errorf("Expected an explicitly requested review to be allowed, got %v", err)
+	}
+}
+
+func TestMitigationFlaw(t *testing.T) {
+	static := &api.Finding{ID: "7", ScanType: "STATIC", BuildID: 123, CWE: "89", SeverityScore: 4, ResolutionStatus: "NONE", FilePath: "src/db.go", LineNumber: 42}
+
+	flaw := mitigationFlaw(7, static, nil, "appdesign")
+	if flaw.Status != mitigationFlawPending || flaw.BuildID != 123 || flaw.Location != "src/db.go:42" || flaw.Severity != string(SeverityHigh) {
+		t.Errorf("Unexpected flaw: %+v", flaw)
+	}
+
+	tests := []struct {
+		name    string
+		finding *api.Finding
+		err     error
+		action  string
+	}{
+		{"not found", nil, errors.New("finding not found"), "fp"},
+		{"SCA finding", &api.Finding{ScanType: "SCA", BuildID: 1}, nil, "fp"},
+		{"no build", &api.Finding{ScanType: "DYNAMIC"}, nil, "fp"},
+		{"review without proposal", static, nil, "accepted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flaw := mitigationFlaw(1, tt.finding, tt.err, tt.action)
+			if flaw.Status != mitigationFlawFailed || flaw.Error == "" {
+				t.Errorf("Expected the flaw to fail validation, got %+v", flaw)
+			}
+		})
+	}
+
+	proposed := *static
+	proposed.ResolutionStatus = "PROPOSED"
+	if flaw := mitigationFlaw(7, &proposed, nil, "accepted"); flaw.Status != mitigationFlawPending {
+		t.Errorf("Expected a proposed mitigation to be reviewable, got %+v", flaw)
+	}
+}
+
+func TestApplyMitigationOutcome(t *testing.T) {
+	info := &api.MitigationInfo{
+		BuildID: 123,
+		Errors:  []api.MitigationError{{Type: "not_found", FlawIDList: "8, 9"}},
+	}
+
+	submitted := MCPMitigationFlaw{FlawID: 7}
+	applyMitigationOutcome(&submitted, info, nil)
+	if submitted.Status != mitigationFlawSubmitted {
+		t.Errorf("Expected flaw 7 to be submitted, got %+v", submitted)
+	}
+
+	rejected := MCPMitigationFlaw{FlawID: 9}
+	applyMitigationOutcome(&rejected, info, nil)
+	if rejected.Status != mitigationFlawFailed || !strings.Contains(rejected.Error, "not_found") {
+		t.Errorf("Expected flaw 9 to be rejected, got %+v", rejected)
+	}
+
+	failed := MCPMitigationFlaw{FlawID: 7}
+	applyMitigationOutcome(&failed, nil, errors.New("API returned status 403"))
+	if failed.Status != mitigationFlawFailed || failed.Error != "API returned status 403" {
+		t.Errorf("Expected the call error to be recorded, got %+v", failed)
+	}
+}
+
+func TestFormatMitigationResponse(t *testing.T) {
+	submission := &MCPMitigationSubmission{
+		Action:     "fp",
+		ActionName: mitigationActions["fp"],
+		Comment:    "Not a password",
+		Flaws:      []MCPMitigationFlaw{{FlawID: 7, Status: mitigationFlawPending}},
+	}
+
+	text := formatMitigationResponse(submission)["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "Nothing has been submitted yet") || !strings.Contains(text, "confirm set to true") {
+		t.Errorf("Expected a confirmation request, got:\n%s", text)
+	}
+
+	submission.Submitted = true
+	submission.Flaws[0].Status = mitigationFlawSubmitted
+	text = formatMitigationResponse(submission)["content"].([]map[string]interface{})[0]["text"].(string)
+	if !strings.Contains(text, "Submitted \"fp\"") || !strings.Contains(text, "awaits review") {
+		t.Errorf("Expected a submission summary, got:\n%s", text)
+	}
+}
+
+// scanTypeFindingsClient streams canned findings per scan type and records which scan types were walked;
+// every other method is unimplemented
+type scanTypeFindingsClient struct {
+	api.Client
+	findings map[string][]api.Finding
+	walked   []string
+}
+
+func (c *scanTypeFindingsClient) IterateFindings(_ context.Context, _ api.FindingsRequest, scanType string) iter.Seq2[api.Finding, error] {
+	c.walked = append(c.walked, scanType)
+	return func(yield func(api.Finding, error) bool) {
+		findings, ok := c.findings[scanType]
+		if !ok {
+			yield(api.Finding{}, errors.New("API returned status 403"))
+			return
+		}
+		for _, finding := range findings {
+			if !yield(finding, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestFindMitigationFindings(t *testing.T) {
+	client := &scanTypeFindingsClient{findings: map[string][]api.Finding{
+		"STATIC":  {{ID: "1", ScanType: "STATIC"}, {ID: "7", ScanType: "STATIC", BuildID: 10}},
+		"DYNAMIC": {{ID: "8", ScanType: "DYNAMIC", BuildID: 11}},
+	}}
+
+	found, searchErrs := findMitigationFindings(context.Background(), client, "app-guid", "", []int32{7, 8})
+	if len(searchErrs) != 0 {
+		t.Errorf("Expected no search errors, got %v", searchErrs)
+	}
+	if found["7"] == nil || found["7"].BuildID != 10 || found["8"] == nil || found["8"].BuildID != 11 {
+		t.Errorf("Expected flaws 7 and 8 with their builds, got %+v", found)
+	}
+	if strings.Join(client.walked, ",") != "STATIC,DYNAMIC" {
+		t.Errorf("Expected the walk to stop once every flaw was found, walked %v", client.walked)
+	}
+
+	client.walked = nil
+	found, searchErrs = findMitigationFindings(context.Background(), client, "app-guid", "", []int32{7, 99})
+	if found["99"] != nil {
+		t.Errorf("Expected flaw 99 not to be found, got %+v", found["99"])
+	}
+	if len(client.walked) != len(mitigationScanTypes) {
+		t.Errorf("Expected every scan type to be walked once, walked %v", client.walked)
+	}
+	if len(searchErrs) != 2 {
+		t.Errorf("Expected the MANUAL and SCA errors, got %v", searchErrs)
+	}
+}
@@ -0,0 +1,41 @@
+package mcp_tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dipsylala/veracode-mcp/api"
+)
+
+// resolveSandbox looks up a sandbox by name (or GUID) for an application.
+// An empty name means the policy scan and returns nil.
+func resolveSandbox(ctx context.Context, client api.Client, applicationGUID, name string) (*MCPSandbox, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	sandbox, err := client.GetSandboxByName(ctx, applicationGUID, name)
+	if err != nil {
+		return nil, err
+	}
+	if sandbox.Guid == nil {
+		return nil, fmt.Errorf("sandbox %s has no GUID", name)
+	}
+
+	resolved := &MCPSandbox{
+		Name: name,
+		ID:   *sandbox.Guid,
+	}
+	if sandbox.Name != nil {
+		resolved.Name = *sandbox.Name
+	}
+	return resolved, nil
+}
+
+// sandboxGUID returns the sandbox's GUID, or "" for the policy scan
+func sandboxGUID(sandbox *MCPSandbox) string {
+	if sandbox == nil {
+		return ""
+	}
+	return sandbox.ID
+}
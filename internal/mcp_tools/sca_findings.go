@@ -2,7 +2,6 @@ package mcp_tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -25,10 +24,26 @@ type ScaFindingsRequest struct {
 	Sandbox         string `json:"sandbox,omitempty"`
 	Size            int    `json:"size,omitempty"`
 	Page            int    `json:"page,omitempty"`
+	AllPages        bool   `json:"all_pages,omitempty"`
+	MaxResults      int    `json:"max_results,omitempty"`
 	Severity        *int32 `json:"severity,omitempty"`
 	SeverityGte     *int32 `json:"severity_gte,omitempty"`
+
+	CVE               string   `json:"cve,omitempty"`
+	CVSS              *float64 `json:"cvss,omitempty"`
+	CVSSGte           *float64 `json:"cvss_gte,omitempty"`
+	DependencyMode    string   `json:"dependency_mode,omitempty"`
+	ScanMode          string   `json:"scan_mode,omitempty"`
+	New               bool     `json:"new,omitempty"`
+	IncludeExpiryDate bool     `json:"include_expiry_date,omitempty"`
 }
 
+// scaDependencyModes and scaScanModes are the values accepted by the Findings API's sca_dep_mode and sca_scan_mode filters
+var (
+	scaDependencyModes = []string{"DIRECT", "TRANSITIVE", "BOTH", "UNKNOWN"}
+	scaScanModes       = []string{"UPLOAD", "AGENT", "BOTH"}
+)
+
 // parseScaFindingsRequest extracts and validates parameters from the raw args map
 func parseScaFindingsRequest(args map[string]interface{}) (*ScaFindingsRequest, error) {
 	req := &ScaFindingsRequest{}
@@ -46,6 +61,12 @@ func parseScaFindingsRequest(args map[string]interface{}) (*ScaFindingsRequest,
 	req.Size = extractInt(args, "page_size", 10)
 	req.Page = extractInt(args, "page", 0)
 
+	// Extract all-pages options
+	req.AllPages, req.MaxResults, err = extractAllPagesParams(args)
+	if err != nil {
+		return nil, err
+	}
+
 	// Extract optional int32 pointers with validation
 	req.Severity, _, err = extractOptionalInt32Ptr(args, "severity")
 	if err != nil {
@@ -56,6 +77,25 @@ func parseScaFindingsRequest(args map[string]interface{}) (*ScaFindingsRequest,
 		return nil, err
 	}
 
+	// Extract the SCA-specific Findings API filters
+	req.CVE, _ = extractOptionalString(args, "cve")
+	req.CVE = strings.ToUpper(strings.TrimSpace(req.CVE))
+	if req.CVE != "" && !strings.HasPrefix(req.CVE, "CVE-") {
+		return nil, fmt.Errorf("cve must be a CVE ID such as CVE-2021-44228, got %q", req.CVE)
+	}
+	req.CVSS = extractOptionalFloat64Ptr(args, "cvss")
+	req.CVSSGte = extractOptionalFloat64Ptr(args, "cvss_gte")
+	req.DependencyMode, err = extractOptionalEnum(args, "dependency_mode", scaDependencyModes)
+	if err != nil {
+		return nil, err
+	}
+	req.ScanMode, err = extractOptionalEnum(args, "scan_mode", scaScanModes)
+	if err != nil {
+		return nil, err
+	}
+	req.New = extractFlag(args, "new")
+	req.IncludeExpiryDate = extractFlag(args, "include_expiry_date")
+
 	// Validate pagination bounds
 	if err := validatePaginationParams(req.Size, req.Page); err != nil {
 		return nil, err
@@ -69,6 +109,14 @@ func parseScaFindingsRequest(args map[string]interface{}) (*ScaFindingsRequest,
 		return nil, err
 	}
 
+	// Validate CVSS bounds
+	if err := validateFloat64Range(req.CVSS, 0, 10, "cvss"); err != nil {
+		return nil, err
+	}
+	if err := validateFloat64Range(req.CVSSGte, 0, 10, "cvss_gte"); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -86,16 +134,23 @@ func handleGetScaFindings(ctx context.Context, args map[string]interface{}) (int
 	hasAppProfile := appProfile != ""
 	if !hasAppProfile {
 		// Fall back to workspace config if app_profile not provided
-		appProfile, err = workspace.FindWorkspaceConfig(req.ApplicationPath)
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
 		if err != nil {
 			return map[string]interface{}{
 				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
 			}, nil
 		}
+		appProfile = wsConfig.Application()
+
+		// The workspace's default sandbox only applies to the workspace's own application
+		if req.Sandbox == "" {
+			req.Sandbox = wsConfig.Sandbox
+		}
 	}
 
 	// Step 2: Create API client
-	client, err := api.NewClient()
+	client, err := newWorkspaceClient(req.ApplicationPath)
 	if err != nil {
 		responseText := fmt.Sprintf(`SCA Findings Analysis - Error
 ========================
@@ -123,8 +178,7 @@ Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set
 	// Step 3: Get application GUID using the profile name (or use directly if it's a GUID)
 	var applicationGUID string
 
-	// Check if appProfile is already a GUID (format: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
-	if len(appProfile) == 36 && appProfile[8] == '-' && appProfile[13] == '-' && appProfile[18] == '-' && appProfile[23] == '-' {
+	if isApplicationGUID(appProfile) {
 		// It's a GUID, use it directly
 		applicationGUID = appProfile
 	} else {
@@ -172,18 +226,56 @@ Please verify:
 		}
 	}
 
-	// Step 4: Build the findings request
+	// Step 4: Resolve the sandbox name to its GUID (no sandbox means the policy scan)
+	sandbox, err := resolveSandbox(ctx, client, applicationGUID, req.Sandbox)
+	if err != nil {
+		responseText := fmt.Sprintf(`SCA Findings Analysis - Error
+========================
+
+Application Path: %s
+App Profile: %s
+Application GUID: %s
+Sandbox: %s
+Error: Failed to find sandbox
+
+%v
+
+Please verify the sandbox name matches a sandbox of this application.`,
+			req.ApplicationPath,
+			appProfile,
+			applicationGUID,
+			req.Sandbox,
+			err,
+		)
+
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": responseText,
+			}},
+		}, nil
+	}
+
+	// Step 5: Build the findings request
 	findingsReq := api.FindingsRequest{
 		AppProfile:  applicationGUID,
-		Sandbox:     req.Sandbox,
+		Sandbox:     sandboxGUID(sandbox),
 		Size:        req.Size,
 		Page:        req.Page,
 		Severity:    req.Severity,
 		SeverityGte: req.SeverityGte,
+
+		CVE:            req.CVE,
+		CVSS:           req.CVSS,
+		CVSSGte:        req.CVSSGte,
+		ScaDepMode:     req.DependencyMode,
+		ScaScanMode:    req.ScanMode,
+		New:            req.New,
+		IncludeExpDate: req.IncludeExpiryDate,
 	}
 
-	// Step 5: Call the API to get SCA findings
-	findingsResp, err := client.GetScaFindings(ctx, findingsReq)
+	// Step 6: Call the API to get SCA findings
+	findings, err := collectFindings(ctx, client, findingsReq, req.AllPages, req.MaxResults, scaFindingsFormat)
 	if err != nil {
 		responseText := fmt.Sprintf(`SCA Findings Analysis - Error
 ========================
@@ -213,101 +305,32 @@ Please verify:
 		}, nil
 	}
 
-	// Step 6: Format and return the response
-	return formatScaFindingsResponse(appProfile, applicationGUID, req.Sandbox, findingsResp), nil
+	// Step 7: Format and return the response
+	return formatScaFindingsResponse(ctx, appProfile, applicationGUID, sandbox, findings), nil
 }
 
-// formatScaFindingsResponse formats the findings API response into an MCP tool response
-func formatScaFindingsResponse(appProfile, applicationGUID, sandbox string, findings *api.FindingsResponse) map[string]interface{} {
-	// Build MCP response structure
-	response := MCPFindingsResponse{
-		Application: MCPApplication{
-			Name: appProfile,
-			ID:   applicationGUID,
-		},
-		Summary: MCPFindingsSummary{
-			BySeverity: map[string]int{
-				"very high": 0,
-				"high":      0,
-				"medium":    0,
-				"low":       0,
-				"very low":  0,
-				"info":      0,
-			},
-			ByStatus: map[string]int{
-				"open":   0,
-				"closed": 0,
-			},
-			ByMitigation: map[string]int{
-				"none":     0,
-				"proposed": 0,
-				"approved": 0,
-				"rejected": 0,
-			},
-		},
-		Findings: []MCPFinding{},
-	}
-
-	// Add sandbox if specified
-	if sandbox != "" {
-		response.Sandbox = &MCPSandbox{
-			Name: sandbox,
-			ID:   sandbox,
-		}
-	}
-
-	// Add pagination info
-	if findings != nil {
-		response.Pagination = &MCPPagination{
-			CurrentPage:   findings.Page,
-			PageSize:      findings.Size,
-			TotalElements: findings.TotalCount,
-			TotalPages:    (findings.TotalCount + findings.Size - 1) / findings.Size,
-			HasNext:       (findings.Page+1)*findings.Size < findings.TotalCount,
-			HasPrevious:   findings.Page > 0,
-		}
-		// Set total_findings to the total across all pages
-		response.Summary.TotalFindings = findings.TotalCount
-	}
-
-	// Process each finding
-	if findings != nil && len(findings.Findings) > 0 {
-		for _, finding := range findings.Findings {
-			mcpFinding := processScaFinding(finding)
-			response.Findings = append(response.Findings, mcpFinding)
-			updateSummaryCounters(&response.Summary, mcpFinding)
-		}
-	}
+// scaFindingsFormat converts and counts SCA findings, in the order the API returns them
+var scaFindingsFormat = findingsFormat{
+	Label:             "SCA findings",
+	ScanType:          "SCA",
+	Convert:           processScaFinding,
+	Count:             updateSummaryCounters,
+	SortBySeverity:    false,
+	StructuredContent: false,
+}
 
-	// Marshal response to JSON for non-UI clients
-	responseJSON, err := json.MarshalIndent(response, "", "  ")
-	if err != nil {
-		// Fall back to compact JSON
-		responseJSON, _ = json.Marshal(response)
+// formatScaFindingsResponse formats the collected findings into an MCP tool response
+func formatScaFindingsResponse(ctx context.Context, appProfile, applicationGUID string, sandbox *MCPSandbox, findings *collectedFindings) map[string]interface{} {
+	response := newFindingsResponse(findings, scaFindingsFormat)
+	response.Application = MCPApplication{
+		Name: appProfile,
+		ID:   applicationGUID,
 	}
 
-	// Build pagination summary for LLM
-	var paginationSummary string
-	if response.Pagination != nil {
-		paginationSummary = fmt.Sprintf("Showing %d findings on page %d of %d (Total: %d findings across all pages)\n\n",
-			len(response.Findings),
-			response.Pagination.CurrentPage+1, // Display as 1-based
-			response.Pagination.TotalPages,
-			response.Pagination.TotalElements,
-		)
-	} else {
-		paginationSummary = fmt.Sprintf("Showing %d findings\n\n", len(response.Findings))
-	}
+	// Add sandbox if the findings are from one rather than the policy scan
+	response.Sandbox = sandbox
 
-	// Return as MCP tool response with text content (consistent with static/dynamic findings)
-	return map[string]interface{}{
-		"content": []map[string]interface{}{
-			{
-				"type": "text",
-				"text": paginationSummary + string(responseJSON),
-			},
-		},
-	}
+	return formatFindingsResponse(ctx, response, findings.Response != nil && findings.Response.AllPages, scaFindingsFormat)
 }
 
 // processScaFinding transforms a single API finding into an MCP finding
@@ -357,17 +380,21 @@ func processScaFinding(finding api.Finding) MCPFinding {
 	}
 
 	mcpFinding := MCPFinding{
-		FlawID:           finding.ID,
-		BuildID:          finding.BuildID,
-		ScanType:         "SCA",
-		Status:           string(transformedStatus),
-		MitigationStatus: mitigationStatus,
-		ViolatesPolicy:   violatesPolicy,
-		Severity:         string(transformedSeverity),
-		SeverityScore:    severityNum,
-		CweId:            cweID,
-		Description:      cleanedDesc,
-		References:       references,
+		FlawID:             finding.ID,
+		BuildID:            finding.BuildID,
+		ScanType:           "SCA",
+		Status:             string(transformedStatus),
+		MitigationStatus:   mitigationStatus,
+		ViolatesPolicy:     violatesPolicy,
+		Severity:           string(transformedSeverity),
+		SeverityScore:      severityNum,
+		CweId:              cweID,
+		CweName:            CWEName(cweID),
+		Description:        cleanedDesc,
+		References:         references,
+		Mitigations:        TransformAnnotations(finding.Annotations),
+		GracePeriodExpires: finding.GracePeriodExpires,
+		DetailsError:       finding.DetailsError,
 	}
 
 	// Add SCA-specific component information if available
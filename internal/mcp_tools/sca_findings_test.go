@@ -66,6 +66,58 @@ func TestParseScaFindingsRequest_SeverityValidation(t *testing.T) {
 	}
 }
 
+func TestParseScaFindingsRequest_Filters(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path":    "/path/to/app",
+		"cve":                 "cve-2021-44228",
+		"cvss_gte":            float64(7),
+		"dependency_mode":     "transitive",
+		"scan_mode":           "agent",
+		"new":                 true,
+		"include_expiry_date": true,
+	}
+
+	req, err := parseScaFindingsRequest(args)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if req.CVE != "CVE-2021-44228" {
+		t.Errorf("Expected CVE-2021-44228, got %s", req.CVE)
+	}
+	if req.CVSSGte == nil || *req.CVSSGte != 7 {
+		t.Errorf("Expected cvss_gte=7, got %v", req.CVSSGte)
+	}
+	if req.DependencyMode != "TRANSITIVE" || req.ScanMode != "AGENT" {
+		t.Errorf("Expected TRANSITIVE/AGENT, got %s/%s", req.DependencyMode, req.ScanMode)
+	}
+	if !req.New || !req.IncludeExpiryDate {
+		t.Error("Expected new and include_expiry_date to be set")
+	}
+}
+
+func TestParseScaFindingsRequest_FilterValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"cvss above 10":       {"cvss": float64(11)},
+		"negative cvss_gte":   {"cvss_gte": float64(-1)},
+		"bad dependency_mode": {"dependency_mode": "indirect"},
+		"bad scan_mode":       {"scan_mode": "binary"},
+		"bad cve":             {"cve": "GHSA-1234"},
+	}
+
+	for name, extra := range tests {
+		t.Run(name, func(t *testing.T) {
+			args := map[string]interface{}{"application_path": "/path/to/app"}
+			for k, v := range extra {
+				args[k] = v
+			}
+			if _, err := parseScaFindingsRequest(args); err == nil {
+				t.Errorf("Expected error for %s", name)
+			}
+		})
+	}
+}
+
 func TestParseScaFindingsRequest_PaginationValidation(t *testing.T) {
 	args := map[string]interface{}{
 		"application_path": "/path/to/app",
@@ -0,0 +1,666 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/policy"
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const SCALicensesToolName = "sca-licenses"
+
+// SCA license sources
+const (
+	scaLicenseSourcePlatform = "PLATFORM"
+	scaLicenseSourceLocal    = "LOCAL"
+)
+
+var scaLicenseSources = []string{scaLicenseSourcePlatform, scaLicenseSourceLocal}
+
+// License risk levels, as reported by the license catalog and used by LICENSE_RISK policy rules
+const (
+	licenseRiskHigh    = "High"
+	licenseRiskMedium  = "Medium"
+	licenseRiskLow     = "Low"
+	licenseRiskUnknown = "Unknown"
+)
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(SCALicensesToolName, handleGetSCALicenses)
+}
+
+// SCALicensesRequest represents the parsed parameters for sca-licenses
+type SCALicensesRequest struct {
+	ApplicationPath string `json:"application_path"`
+	AppProfile      string `json:"app_profile,omitempty"`
+	Source          string `json:"source"` // PLATFORM or LOCAL
+}
+
+// MCPSCALicenseReport lists the third-party licenses in an application and whether its policy allows them
+type MCPSCALicenseReport struct {
+	ApplicationPath string                `json:"application_path"`
+	AppProfile      string                `json:"app_profile,omitempty"`
+	Source          string                `json:"source"`
+	Policy          string                `json:"policy,omitempty"`
+	LicenseRules    []string              `json:"license_rules,omitempty"` // The policy's license rules, in plain words
+	Summary         MCPSCALicenseSummary  `json:"summary"`
+	Licenses        []MCPSCALicenseDetail `json:"licenses"`
+	// Components with no license detected, which license rules can't check
+	ComponentsWithoutLicense []string `json:"components_without_license,omitempty"`
+	Notes                    []string `json:"notes,omitempty"`
+	Warnings                 []string `json:"warnings,omitempty"`
+}
+
+// MCPSCALicenseSummary counts the licenses in an MCPSCALicenseReport
+type MCPSCALicenseSummary struct {
+	TotalLicenses   int            `json:"total_licenses"`
+	TotalComponents int            `json:"total_components"`
+	ByRisk          map[string]int `json:"by_risk"`
+	Disallowed      int            `json:"disallowed_by_policy"`
+}
+
+// MCPSCALicenseDetail is a license used by one or more of an application's components
+type MCPSCALicenseDetail struct {
+	LicenseID          string   `json:"license_id"` // As reported by the scan
+	SPDXID             string   `json:"spdx_id,omitempty"`
+	FullName           string   `json:"full_name,omitempty"`
+	URL                string   `json:"url,omitempty"`
+	Risk               string   `json:"risk"` // High, Medium, Low or Unknown
+	DisallowedByPolicy bool     `json:"disallowed_by_policy"`
+	PolicyRules        []string `json:"policy_rules,omitempty"` // The rules that disallow the license
+	Components         []string `json:"components"`             // name@version
+}
+
+// scaLicenseUsage collects the components using a license before it is enriched from the license catalog
+type scaLicenseUsage struct {
+	licenseID  string
+	risk       string
+	components map[string]bool
+}
+
+// parseSCALicensesRequest extracts and validates parameters from the raw args map
+func parseSCALicensesRequest(args map[string]interface{}) (*SCALicensesRequest, error) {
+	req := &SCALicensesRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.AppProfile, _ = extractOptionalString(args, "app_profile")
+
+	req.Source, err = extractOptionalEnum(args, "source", scaLicenseSources)
+	if err != nil {
+		return nil, err
+	}
+	if req.Source == "" {
+		req.Source = scaLicenseSourcePlatform
+	}
+
+	return req, nil
+}
+
+func handleGetSCALicenses(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseSCALicensesRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Retrieve application profile name
+	appProfile := req.AppProfile
+	if appProfile == "" {
+		// Fall back to workspace config if app_profile not provided
+		wsConfig, wsErr := workspace.LoadWorkspaceConfig(req.ApplicationPath)
+		switch {
+		case wsErr == nil:
+			appProfile = wsConfig.Application()
+		case req.Source == scaLicenseSourcePlatform:
+			return map[string]interface{}{
+				"error": fmt.Sprintf("Failed to find workspace configuration: %v", wsErr),
+			}, nil
+		}
+	}
+
+	report := &MCPSCALicenseReport{
+		ApplicationPath: req.ApplicationPath,
+		AppProfile:      appProfile,
+		Source:          req.Source,
+	}
+
+	// Step 2: Create API client. Local results can still be listed without one.
+	client, err := newWorkspaceClient(req.ApplicationPath)
+	if err != nil {
+		if req.Source == scaLicenseSourcePlatform {
+			return scaLicensesError(appProfile, fmt.Sprintf(`Error: Failed to create API client
+
+%v
+
+Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set with valid credentials.`, err)), nil
+		}
+		log.Printf("SCA licenses: API client unavailable, listing local licenses only: %v", err)
+		report.Warnings = append(report.Warnings, "The Veracode API is unavailable, so license names and policy rules could not be loaded")
+		client = nil
+	}
+
+	// Step 3: Look up the application, whose policy decides which licenses are allowed
+	var application *applications.Application
+	if client != nil && appProfile != "" {
+		application, err = resolveApplication(ctx, client, appProfile)
+		if err != nil {
+			if req.Source == scaLicenseSourcePlatform {
+				return scaLicensesError(appProfile, fmt.Sprintf(`Error: Failed to lookup application
+
+%v
+
+Please verify:
+- The application name exists in Veracode platform
+- API credentials have sufficient permissions`, err)), nil
+			}
+			log.Printf("SCA licenses: failed to lookup application %q: %v", appProfile, err)
+			report.Warnings = append(report.Warnings, fmt.Sprintf("Could not look up application %q, so licenses were not checked against policy: %v", appProfile, err))
+		}
+	}
+
+	var policyVersion *policy.PolicyVersion
+	if application != nil {
+		policyVersion = loadLicensePolicy(ctx, client, application, report)
+	}
+
+	// Step 4: Collect the licenses of every component
+	var inventory *scaLicenseInventory
+	if req.Source == scaLicenseSourcePlatform {
+		inventory, err = platformLicenseInventory(ctx, client, application, req.ApplicationPath, report)
+	} else {
+		inventory, err = localLicenseInventory(req.ApplicationPath, report)
+	}
+	if err != nil {
+		return scaLicensesError(appProfile, fmt.Sprintf("Error: %v", err)), nil
+	}
+	report.ComponentsWithoutLicense = inventory.unlicensedComponents()
+
+	// Step 5: Add full names and SPDX IDs from the license catalog
+	var catalog []policy.ScaLicenseSummary
+	if client != nil {
+		catalog, err = client.ListScaLicenses(ctx)
+		if err != nil {
+			log.Printf("SCA licenses: failed to list SCA licenses: %v", err)
+			report.Warnings = append(report.Warnings, fmt.Sprintf("Could not load the license catalog, so full names and SPDX IDs are missing: %v", err))
+		}
+	}
+
+	// Step 6: Check each license against the policy's license rules
+	report.Licenses = buildLicenseDetails(inventory.usages, catalog)
+	if policyVersion != nil {
+		report.LicenseRules = evaluateLicenseRules(policyVersion.FindingRules, report.Licenses)
+	} else {
+		report.Notes = append(report.Notes, "No application policy was loaded, so licenses were not checked against policy")
+	}
+	report.Summary = summarizeLicenses(report.Licenses)
+	report.Summary.TotalComponents += len(report.ComponentsWithoutLicense)
+
+	return formatSCALicensesResponse(report), nil
+}
+
+// scaLicensesError formats an error as an MCP tool response
+func scaLicensesError(appProfile, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": fmt.Sprintf("SCA Licenses - Error\n====================\n\nApp Profile: %s\n%s", appProfile, message),
+		}},
+	}
+}
+
+// loadLicensePolicy loads the application's policy, recording its name on the report.
+// A missing or unreadable policy is reported as a warning, as the licenses can still be listed.
+func loadLicensePolicy(ctx context.Context, client api.Client, application *applications.Application, report *MCPSCALicenseReport) *policy.PolicyVersion {
+	appPolicy := applicationPolicy(application)
+	if appPolicy == nil || appPolicy.Guid == nil {
+		report.Warnings = append(report.Warnings, "The application has no policy assigned")
+		return nil
+	}
+	report.Policy = appPolicy.GetName()
+
+	policyVersion, err := client.GetPolicyByGUID(ctx, *appPolicy.Guid)
+	if err != nil {
+		log.Printf("SCA licenses: failed to retrieve policy %q: %v", appPolicy.GetName(), err)
+		report.Warnings = append(report.Warnings, fmt.Sprintf("Could not retrieve policy %q, so licenses were not checked against it: %v", appPolicy.GetName(), err))
+		return nil
+	}
+	return policyVersion
+}
+
+// scaLicenseInventory collects an application's components and the licenses they use
+type scaLicenseInventory struct {
+	usages     map[string]*scaLicenseUsage
+	components map[string]bool
+}
+
+func newSCALicenseInventory() *scaLicenseInventory {
+	return &scaLicenseInventory{usages: make(map[string]*scaLicenseUsage), components: make(map[string]bool)}
+}
+
+// addComponent records a component, whether or not a license was detected for it
+func (inventory *scaLicenseInventory) addComponent(component string) {
+	inventory.components[component] = true
+}
+
+// addLicense records that component uses licenseID
+func (inventory *scaLicenseInventory) addLicense(licenseID, risk, component string) {
+	inventory.addComponent(component)
+	addLicenseUsage(inventory.usages, licenseID, risk, component)
+}
+
+// unlicensedComponents lists the components with no license detected, sorted
+func (inventory *scaLicenseInventory) unlicensedComponents() []string {
+	licensed := make(map[string]bool)
+	for _, usage := range inventory.usages {
+		for component := range usage.components {
+			licensed[component] = true
+		}
+	}
+
+	var components []string
+	for component := range inventory.components {
+		if !licensed[component] {
+			components = append(components, component)
+		}
+	}
+	sort.Strings(components)
+	return components
+}
+
+// platformLicenseInventory lists every component of the application's latest published policy scan, from
+// the build's detailed report. If no build has published results, it falls back to the SCA findings,
+// which only include components with known vulnerabilities.
+func platformLicenseInventory(ctx context.Context, client api.Client, application *applications.Application, applicationPath string, report *MCPSCALicenseReport) (*scaLicenseInventory, error) {
+	buildID, err := latestPublishedBuild(ctx, client, int64(application.GetId()), 0)
+	if err == nil {
+		var detailedReport *api.DetailedReport
+		detailedReport, _, err = loadDetailedReport(ctx, client, detailedReportPath(applicationPath, buildID), buildID, false)
+		if err == nil {
+			report.Notes = append(report.Notes, fmt.Sprintf("Components are from the detailed report of build %d, the latest policy scan with published results", buildID))
+			return reportLicenseInventory(detailedReport), nil
+		}
+	}
+
+	log.Printf("SCA licenses: failed to read the latest detailed report, falling back to SCA findings: %v", err)
+	report.Warnings = append(report.Warnings, fmt.Sprintf("Could not read the components of the latest policy scan, so only components with known vulnerabilities are listed: %v", err))
+	return findingsLicenseInventory(ctx, client, application.GetGuid())
+}
+
+// reportLicenseInventory collects the licenses of every third-party component in a detailed report
+func reportLicenseInventory(detailedReport *api.DetailedReport) *scaLicenseInventory {
+	inventory := newSCALicenseInventory()
+	if detailedReport.SCA == nil {
+		return inventory
+	}
+	for _, component := range detailedReport.SCA.Components {
+		name := component.Library
+		if name == "" {
+			name = component.FileName
+		}
+		ref := componentRef(name, component.Version)
+		inventory.addComponent(ref)
+		for _, license := range component.Licenses {
+			licenseID := license.SPDXID
+			if licenseID == "" {
+				licenseID = license.Name
+			}
+			inventory.addLicense(licenseID, licenseRiskLevel(license.RiskRating), ref)
+		}
+	}
+	return inventory
+}
+
+// findingsLicenseInventory collects the licenses of the components in the application's SCA findings.
+// Findings that were fixed are skipped, as their component has been removed or upgraded.
+func findingsLicenseInventory(ctx context.Context, client api.Client, applicationGUID string) (*scaLicenseInventory, error) {
+	inventory := newSCALicenseInventory()
+	findingsReq := api.FindingsRequest{AppProfile: applicationGUID}
+	for finding, err := range client.IterateFindings(ctx, findingsReq, "SCA") {
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve SCA findings: %w", err)
+		}
+		if strings.EqualFold(finding.Status, string(StatusClosed)) && !strings.EqualFold(finding.ResolutionStatus, string(MitigationApproved)) {
+			continue
+		}
+		component := componentRef(finding.ComponentFilename, finding.ComponentVersion)
+		inventory.addComponent(component)
+		for _, license := range finding.Licenses {
+			inventory.addLicense(license.LicenseID, licenseRiskLevel(license.RiskRating), component)
+		}
+	}
+	return inventory, nil
+}
+
+// cycloneDXBOM is the part of a CycloneDX JSON SBOM that lists components
+type cycloneDXBOM struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+// cycloneDXComponent is a component of a CycloneDX SBOM, which may contain further components
+type cycloneDXComponent struct {
+	Name       string                   `json:"name"`
+	Version    string                   `json:"version"`
+	Licenses   []cycloneDXLicenseChoice `json:"licenses"`
+	Components []cycloneDXComponent     `json:"components"`
+}
+
+// cycloneDXLicenseChoice is either a single license or an SPDX license expression
+type cycloneDXLicenseChoice struct {
+	License *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"license"`
+	Expression string `json:"expression"`
+}
+
+// localLicenseInventory lists every component in the SBOM written by local-sca-scan. Without an SBOM,
+// e.g. from a scan run before local-sca-scan wrote one, it falls back to the scan results, which only
+// include components with known vulnerabilities.
+func localLicenseInventory(applicationPath string, report *MCPSCALicenseReport) (*scaLicenseInventory, error) {
+	sbomFile := filepath.Join(veracodeWorkDir(applicationPath, "sca"), scaSBOMFileName)
+
+	// #nosec G304 -- sbomFile is constructed from validated application path
+	sbomData, err := os.ReadFile(sbomFile)
+	if err == nil {
+		var bom cycloneDXBOM
+		if err := json.Unmarshal(sbomData, &bom); err != nil {
+			return nil, fmt.Errorf("failed to parse SBOM file %s: %w", sbomFile, err)
+		}
+		report.Notes = append(report.Notes, fmt.Sprintf("Components are from the SBOM at %s", sbomFile))
+		inventory := newSCALicenseInventory()
+		addCycloneDXComponents(inventory, bom.Components)
+		return inventory, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read SBOM file: %w", err)
+	}
+
+	inventory, err := matchesLicenseInventory(applicationPath)
+	if err != nil {
+		return nil, err
+	}
+	report.Warnings = append(report.Warnings, fmt.Sprintf("No SBOM found at %s, so only components with known vulnerabilities are listed; run local-sca-scan again to write one", sbomFile))
+	return inventory, nil
+}
+
+// addCycloneDXComponents records the licenses of SBOM components, including nested ones
+func addCycloneDXComponents(inventory *scaLicenseInventory, components []cycloneDXComponent) {
+	for _, component := range components {
+		ref := componentRef(component.Name, component.Version)
+		inventory.addComponent(ref)
+		for _, choice := range component.Licenses {
+			switch {
+			case choice.License != nil && choice.License.ID != "":
+				inventory.addLicense(choice.License.ID, licenseRiskUnknown, ref)
+			case choice.License != nil:
+				inventory.addLicense(choice.License.Name, licenseRiskUnknown, ref)
+			default:
+				inventory.addLicense(choice.Expression, licenseRiskUnknown, ref)
+			}
+		}
+		addCycloneDXComponents(inventory, component.Components)
+	}
+}
+
+// matchesLicenseInventory collects the licenses of the components in the local SCA scan results
+func matchesLicenseInventory(applicationPath string) (*scaLicenseInventory, error) {
+	resultsFile := filepath.Join(veracodeWorkDir(applicationPath, "sca"), "veracode.json")
+
+	// #nosec G304 -- resultsFile is constructed from validated application path
+	resultsData, err := os.ReadFile(resultsFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no local SCA results found at %s. Run a local SCA scan using the local-sca-scan tool first", resultsFile)
+		}
+		return nil, fmt.Errorf("failed to read results file: %w", err)
+	}
+
+	var scaResults SCAFindings
+	if err := json.Unmarshal(resultsData, &scaResults); err != nil {
+		return nil, fmt.Errorf("failed to parse results file: %w", err)
+	}
+
+	inventory := newSCALicenseInventory()
+	for _, match := range scaResults.Vulnerabilities.Matches {
+		component := componentRef(match.Artifact.Name, match.Artifact.Version)
+		inventory.addComponent(component)
+		for _, licenseID := range match.Artifact.Licenses {
+			inventory.addLicense(licenseID, licenseRiskUnknown, component)
+		}
+	}
+	return inventory, nil
+}
+
+// componentRef identifies a component as name@version
+func componentRef(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + "@" + version
+}
+
+// addLicenseUsage records that component uses licenseID
+func addLicenseUsage(usages map[string]*scaLicenseUsage, licenseID, risk, component string) {
+	licenseID = strings.TrimSpace(licenseID)
+	if licenseID == "" {
+		return
+	}
+
+	key := strings.ToLower(licenseID)
+	usage, ok := usages[key]
+	if !ok {
+		usage = &scaLicenseUsage{licenseID: licenseID, risk: risk, components: make(map[string]bool)}
+		usages[key] = usage
+	}
+	if usage.risk == licenseRiskUnknown {
+		usage.risk = risk
+	}
+	if component != "" {
+		usage.components[component] = true
+	}
+}
+
+// licenseRiskLevel normalises a license risk to High, Medium, Low or Unknown. The license catalog and
+// policy rules use names; the Findings API reports a numeric rating where higher is riskier.
+func licenseRiskLevel(risk string) string {
+	risk = strings.TrimSpace(risk)
+	switch strings.ToLower(risk) {
+	case "high":
+		return licenseRiskHigh
+	case "medium":
+		return licenseRiskMedium
+	case "low":
+		return licenseRiskLow
+	}
+
+	rating, err := strconv.Atoi(risk)
+	switch {
+	case err != nil || rating <= 0:
+		return licenseRiskUnknown
+	case rating == 1:
+		return licenseRiskLow
+	case rating == 2:
+		return licenseRiskMedium
+	default:
+		return licenseRiskHigh
+	}
+}
+
+// licenseRiskRank orders risk levels for LICENSE_RISK rules, with Unknown lowest
+func licenseRiskRank(risk string) int {
+	switch risk {
+	case licenseRiskHigh:
+		return 3
+	case licenseRiskMedium:
+		return 2
+	case licenseRiskLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// findCatalogLicense finds a license in the catalog by SPDX ID, name or full name (case-insensitive)
+func findCatalogLicense(catalog []policy.ScaLicenseSummary, licenseID string) (policy.ScaLicenseSummary, bool) {
+	for _, license := range catalog {
+		if strings.EqualFold(license.GetSpdxId(), licenseID) || strings.EqualFold(license.GetName(), licenseID) || strings.EqualFold(license.GetFullName(), licenseID) {
+			return license, true
+		}
+	}
+	return policy.ScaLicenseSummary{}, false
+}
+
+// buildLicenseDetails enriches the collected licenses from the catalog, ordered by risk and then license ID
+func buildLicenseDetails(usages map[string]*scaLicenseUsage, catalog []policy.ScaLicenseSummary) []MCPSCALicenseDetail {
+	details := make([]MCPSCALicenseDetail, 0, len(usages))
+	for _, usage := range usages {
+		detail := MCPSCALicenseDetail{
+			LicenseID:  usage.licenseID,
+			Risk:       usage.risk,
+			Components: make([]string, 0, len(usage.components)),
+		}
+		if license, ok := findCatalogLicense(catalog, usage.licenseID); ok {
+			detail.SPDXID = license.GetSpdxId()
+			detail.FullName = license.GetFullName()
+			detail.URL = license.GetUrl()
+			if catalogRisk := licenseRiskLevel(license.GetRisk()); catalogRisk != licenseRiskUnknown {
+				detail.Risk = catalogRisk
+			}
+		}
+		for component := range usage.components {
+			detail.Components = append(detail.Components, component)
+		}
+		sort.Strings(detail.Components)
+		details = append(details, detail)
+	}
+
+	sort.Slice(details, func(i, j int) bool {
+		if rankI, rankJ := licenseRiskRank(details[i].Risk), licenseRiskRank(details[j].Risk); rankI != rankJ {
+			return rankI > rankJ
+		}
+		return strings.ToLower(details[i].LicenseID) < strings.ToLower(details[j].LicenseID)
+	})
+	return details
+}
+
+// evaluateLicenseRules marks the licenses disallowed by the policy's license rules and
+// returns a description of every license rule
+func evaluateLicenseRules(rules []policy.FindingRule, licenses []MCPSCALicenseDetail) []string {
+	var descriptions []string
+	for _, rule := range rules {
+		description, breaks := licenseRuleCheck(rule)
+		if breaks == nil {
+			continue
+		}
+		descriptions = append(descriptions, description)
+
+		for i := range licenses {
+			if breaks(licenses[i]) {
+				licenses[i].DisallowedByPolicy = true
+				licenses[i].PolicyRules = append(licenses[i].PolicyRules, description)
+			}
+		}
+	}
+	return descriptions
+}
+
+// licenseRuleCheck describes a license rule and returns a check for licenses that break it,
+// or a nil check if the rule doesn't restrict licenses
+func licenseRuleCheck(rule policy.FindingRule) (string, func(MCPSCALicenseDetail) bool) {
+	if rule.GetType() == "LICENSE_RISK" && strings.TrimSpace(rule.GetValue()) != "" {
+		minRisk := licenseRiskLevel(rule.GetValue())
+		description := fmt.Sprintf("No component licenses of risk %s or higher", rule.GetValue())
+		return description, func(license MCPSCALicenseDetail) bool {
+			return licenseRiskRank(minRisk) > 0 && licenseRiskRank(license.Risk) >= licenseRiskRank(minRisk)
+		}
+	}
+
+	options := rule.AdvancedOptions
+	if options == nil || len(options.SelectedLicenses) == 0 {
+		return "", nil
+	}
+
+	selected := make([]string, 0, len(options.SelectedLicenses))
+	for _, license := range options.SelectedLicenses {
+		selected = append(selected, license.GetSpdxId())
+	}
+	isSelected := func(license MCPSCALicenseDetail) bool {
+		if _, ok := findCatalogLicense(options.SelectedLicenses, license.LicenseID); ok {
+			return true
+		}
+		_, ok := findCatalogLicense(options.SelectedLicenses, license.SPDXID)
+		return license.SPDXID != "" && ok
+	}
+
+	if options.GetIsBlocklist() {
+		return "Blocklisted licenses: " + strings.Join(selected, ", "), isSelected
+	}
+	return "Only allowlisted licenses: " + strings.Join(selected, ", "), func(license MCPSCALicenseDetail) bool {
+		return !isSelected(license)
+	}
+}
+
+// summarizeLicenses counts the licenses by risk and policy outcome
+func summarizeLicenses(licenses []MCPSCALicenseDetail) MCPSCALicenseSummary {
+	summary := MCPSCALicenseSummary{
+		TotalLicenses: len(licenses),
+		ByRisk: map[string]int{
+			licenseRiskHigh:    0,
+			licenseRiskMedium:  0,
+			licenseRiskLow:     0,
+			licenseRiskUnknown: 0,
+		},
+	}
+
+	components := make(map[string]bool)
+	for _, license := range licenses {
+		summary.ByRisk[license.Risk]++
+		if license.DisallowedByPolicy {
+			summary.Disallowed++
+		}
+		for _, component := range license.Components {
+			components[component] = true
+		}
+	}
+	summary.TotalComponents = len(components)
+	return summary
+}
+
+// formatSCALicensesResponse formats the license report into an MCP tool response
+func formatSCALicensesResponse(report *MCPSCALicenseReport) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format SCA licenses: %v", err)}
+	}
+
+	summary := fmt.Sprintf("Found %d licenses across %d components (%d high risk)",
+		report.Summary.TotalLicenses, report.Summary.TotalComponents, report.Summary.ByRisk[licenseRiskHigh])
+	if report.Policy != "" {
+		summary += fmt.Sprintf("; %d disallowed by policy %q", report.Summary.Disallowed, report.Policy)
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": summary + ".\n\n" + string(responseJSON),
+		}},
+	}
+}
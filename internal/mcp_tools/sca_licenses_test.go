@@ -0,0 +1,256 @@
+package mcp_tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
+	"github.com/dipsylala/veracode-mcp/api/rest/generated/policy"
+)
+
+func TestParseSCALicensesRequest(t *testing.T) {
+	req, err := parseSCALicensesRequest(map[string]interface{}{"application_path": "/app"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if req.Source != scaLicenseSourcePlatform {
+		t.Errorf("Expected source to default to PLATFORM, got %q", req.Source)
+	}
+
+	req, err = parseSCALicensesRequest(map[string]interface{}{"application_path": "/app", "source": "local"})
+	if err != nil || req.Source != scaLicenseSourceLocal {
+		t.Errorf("Expected LOCAL source, got %+v, %v", req, err)
+	}
+
+	if _, err := parseSCALicensesRequest(map[string]interface{}{"application_path": "/app", "source": "sbom"}); err == nil {
+		t.Error("Expected error for an unknown source")
+	}
+	if _, err := parseSCALicensesRequest(map[string]interface{}{}); err == nil {
+		t.Error("Expected error when application_path is missing")
+	}
+}
+
+func TestLicenseRiskLevel(t *testing.T) {
+	tests := map[string]string{
+		"High":   licenseRiskHigh,
+		"MEDIUM": licenseRiskMedium,
+		"low":    licenseRiskLow,
+		"3":      licenseRiskHigh,
+		"2":      licenseRiskMedium,
+		"1":      licenseRiskLow,
+		"0":      licenseRiskUnknown,
+		"":       licenseRiskUnknown,
+		"n/a":    licenseRiskUnknown,
+	}
+	for risk, expected := range tests {
+		if got := licenseRiskLevel(risk); got != expected {
+			t.Errorf("licenseRiskLevel(%q) = %q, expected %q", risk, got, expected)
+		}
+	}
+}
+
+func testLicenseCatalog() []policy.ScaLicenseSummary {
+	return []policy.ScaLicenseSummary{
+		{SpdxId: "GPL-3.0", Name: stringPtr("GPL 3.0"), FullName: stringPtr("GNU General Public License v3.0 only"), Risk: stringPtr("High"), Url: stringPtr("https://spdx.org/licenses/GPL-3.0.html")},
+		{SpdxId: "MIT", Name: stringPtr("MIT"), FullName: stringPtr("MIT License"), Risk: stringPtr("Low")},
+		{SpdxId: "Apache-2.0", Name: stringPtr("Apache 2.0"), FullName: stringPtr("Apache License 2.0"), Risk: stringPtr("Low")},
+	}
+}
+
+func testLicenseUsages() map[string]*scaLicenseUsage {
+	usages := make(map[string]*scaLicenseUsage)
+	addLicenseUsage(usages, "MIT", licenseRiskUnknown, "lodash@4.17.20")
+	addLicenseUsage(usages, "mit", licenseRiskLow, "minimist@1.2.5")
+	addLicenseUsage(usages, "GPL-3.0", licenseRiskUnknown, "readline@1.0.0")
+	addLicenseUsage(usages, "Apache 2.0", licenseRiskUnknown, "log4j@2.14.0")
+	addLicenseUsage(usages, "Custom", licenseRiskUnknown, "internal@0.1.0")
+	return usages
+}
+
+func TestBuildLicenseDetails(t *testing.T) {
+	details := buildLicenseDetails(testLicenseUsages(), testLicenseCatalog())
+
+	if len(details) != 4 {
+		t.Fatalf("Expected 4 licenses, got %d: %+v", len(details), details)
+	}
+
+	// Ordered by risk, then license ID
+	expectedOrder := []string{"GPL-3.0", "Apache 2.0", "MIT", "Custom"}
+	for i, licenseID := range expectedOrder {
+		if details[i].LicenseID != licenseID {
+			t.Errorf("Expected license %d to be %q, got %q", i, licenseID, details[i].LicenseID)
+		}
+	}
+
+	gpl := details[0]
+	if gpl.SPDXID != "GPL-3.0" || gpl.FullName != "GNU General Public License v3.0 only" || gpl.Risk != licenseRiskHigh || gpl.URL == "" {
+		t.Errorf("Expected GPL-3.0 to be enriched from the catalog, got %+v", gpl)
+	}
+
+	// Matched by catalog name rather than SPDX ID
+	if details[1].SPDXID != "Apache-2.0" {
+		t.Errorf("Expected Apache 2.0 to resolve to SPDX ID Apache-2.0, got %q", details[1].SPDXID)
+	}
+
+	mit := details[2]
+	if len(mit.Components) != 2 || mit.Components[0] != "lodash@4.17.20" {
+		t.Errorf("Expected MIT usages to merge case-insensitively, got %+v", mit.Components)
+	}
+
+	if details[3].Risk != licenseRiskUnknown || details[3].SPDXID != "" {
+		t.Errorf("Expected an unknown license to stay unresolved, got %+v", details[3])
+	}
+}
+
+func TestEvaluateLicenseRules(t *testing.T) {
+	rules := []policy.FindingRule{
+		findingRule("LICENSE_RISK", "High", "SCA"),
+		{
+			Type:     stringPtr("ALLOWLIST"),
+			ScanType: []string{"SCA"},
+			AdvancedOptions: &policy.FindingRuleAdvancedOptions{
+				IsBlocklist:      boolPtr(false),
+				SelectedLicenses: []policy.ScaLicenseSummary{{SpdxId: "MIT"}, {SpdxId: "Apache-2.0"}, {SpdxId: "GPL-3.0"}},
+			},
+		},
+		findingRule("MAX_SEVERITY", "4", "STATIC"),
+	}
+
+	details := buildLicenseDetails(testLicenseUsages(), testLicenseCatalog())
+	descriptions := evaluateLicenseRules(rules, details)
+
+	if len(descriptions) != 2 {
+		t.Fatalf("Expected only the two license rules to be described, got %v", descriptions)
+	}
+
+	disallowed := make(map[string]int)
+	for _, license := range details {
+		if license.DisallowedByPolicy {
+			disallowed[license.LicenseID] = len(license.PolicyRules)
+		}
+	}
+	if len(disallowed) != 2 || disallowed["GPL-3.0"] != 1 || disallowed["Custom"] != 1 {
+		t.Errorf("Expected GPL-3.0 (risk) and Custom (not allowlisted) to be disallowed, got %v", disallowed)
+	}
+
+	summary := summarizeLicenses(details)
+	if summary.TotalLicenses != 4 || summary.TotalComponents != 5 || summary.Disallowed != 2 || summary.ByRisk[licenseRiskLow] != 2 {
+		t.Errorf("Unexpected summary: %+v", summary)
+	}
+}
+
+func TestEvaluateLicenseRules_Blocklist(t *testing.T) {
+	rules := []policy.FindingRule{{
+		Type: stringPtr("BLACKLIST"),
+		AdvancedOptions: &policy.FindingRuleAdvancedOptions{
+			IsBlocklist:      boolPtr(true),
+			SelectedLicenses: []policy.ScaLicenseSummary{{SpdxId: "Apache-2.0"}},
+		},
+	}}
+
+	details := buildLicenseDetails(testLicenseUsages(), testLicenseCatalog())
+	evaluateLicenseRules(rules, details)
+
+	for _, license := range details {
+		if license.DisallowedByPolicy != (license.SPDXID == "Apache-2.0") {
+			t.Errorf("Unexpected policy outcome for %q: %+v", license.LicenseID, license)
+		}
+	}
+}
+
+func TestReportLicenseInventory(t *testing.T) {
+	report, err := api.ParseDetailedReport([]byte(`<detailedreport build_id="300">
+  <software_composition_analysis third_party_components="3">
+    <vulnerable_components>
+      <component file_name="log4j-core-2.14.1.jar" library="log4j-core" version="2.14.1">
+        <licenses><license name="Apache License 2.0" spdx_id="Apache-2.0" risk_rating="2"/></licenses>
+        <vulnerabilities><vulnerability cve_id="CVE-2021-44228" severity="5"/></vulnerabilities>
+      </component>
+      <component file_name="readline-1.0.0.jar" library="readline" version="1.0.0">
+        <licenses><license name="GPL 3.0" spdx_id="GPL-3.0" risk_rating="4"/></licenses>
+        <vulnerabilities/>
+      </component>
+      <component file_name="internal-0.1.0.jar" version="0.1.0">
+        <vulnerabilities/>
+      </component>
+    </vulnerable_components>
+  </software_composition_analysis>
+</detailedreport>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inventory := reportLicenseInventory(report)
+	if len(inventory.usages) != 2 || !inventory.usages["gpl-3.0"].components["readline@1.0.0"] {
+		t.Errorf("Expected the licenses of components without vulnerabilities to be listed, got %+v", inventory.usages)
+	}
+	if inventory.usages["gpl-3.0"].risk != licenseRiskHigh || inventory.usages["apache-2.0"].risk != licenseRiskMedium {
+		t.Errorf("Expected risk ratings from the report, got %+v", inventory.usages)
+	}
+	if unlicensed := inventory.unlicensedComponents(); len(unlicensed) != 1 || unlicensed[0] != "internal-0.1.0.jar@0.1.0" {
+		t.Errorf("Expected the component without a license to be reported, got %v", unlicensed)
+	}
+}
+
+func TestLocalLicenseInventory(t *testing.T) {
+	appPath := filepath.Join(t.TempDir(), "sca-licenses-app")
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(veracodeWorkDir(appPath, "sca"))) })
+
+	if _, err := localLicenseInventory(appPath, &MCPSCALicenseReport{}); err == nil {
+		t.Error("Expected error when there are no local results")
+	}
+
+	resultsDir := veracodeWorkDir(appPath, "sca")
+	if err := os.MkdirAll(resultsDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	results := `{"vulnerabilities": {"matches": [
+		{"artifact": {"name": "lodash", "version": "4.17.20", "licenses": ["MIT"]}},
+		{"artifact": {"name": "lodash", "version": "4.17.20", "licenses": ["MIT"]}},
+		{"artifact": {"name": "dual", "version": "1.0.0", "licenses": ["MIT", "GPL-3.0"]}}
+	]}}`
+	if err := os.WriteFile(filepath.Join(resultsDir, "veracode.json"), []byte(results), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without an SBOM, only the vulnerable components in the scan results are listed
+	report := &MCPSCALicenseReport{}
+	inventory, err := localLicenseInventory(appPath, report)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(inventory.usages) != 2 || len(inventory.usages["mit"].components) != 2 || len(inventory.usages["gpl-3.0"].components) != 1 {
+		t.Errorf("Unexpected usages: %+v", inventory.usages)
+	}
+	if len(report.Warnings) != 1 || !strings.Contains(report.Warnings[0], "No SBOM found") {
+		t.Errorf("Expected a warning that the SBOM is missing, got %v", report.Warnings)
+	}
+
+	// The SBOM lists every component, including nested ones and those without a license
+	sbom := `{"bomFormat": "CycloneDX", "components": [
+		{"name": "lodash", "version": "4.17.20", "licenses": [{"license": {"id": "MIT"}}]},
+		{"name": "left-pad", "version": "1.3.0", "licenses": [{"license": {"name": "WTFPL"}}],
+		 "components": [{"name": "bundled", "version": "0.1.0", "licenses": [{"expression": "MIT OR Apache-2.0"}]}]},
+		{"name": "internal", "version": "0.1.0"}
+	]}`
+	if err := os.WriteFile(filepath.Join(resultsDir, scaSBOMFileName), []byte(sbom), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	report = &MCPSCALicenseReport{}
+	inventory, err = localLicenseInventory(appPath, report)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(inventory.usages) != 3 || !inventory.usages["wtfpl"].components["left-pad@1.3.0"] || !inventory.usages["mit or apache-2.0"].components["bundled@0.1.0"] {
+		t.Errorf("Expected the licenses of every SBOM component, got %+v", inventory.usages)
+	}
+	if unlicensed := inventory.unlicensedComponents(); len(unlicensed) != 1 || unlicensed[0] != "internal@0.1.0" {
+		t.Errorf("Expected the component without a license to be reported, got %v", unlicensed)
+	}
+	if len(report.Warnings) != 0 {
+		t.Errorf("Expected no warnings when the SBOM exists, got %v", report.Warnings)
+	}
+}
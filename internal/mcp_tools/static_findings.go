@@ -2,11 +2,9 @@ package mcp_tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"log"
-	"sort"
 	"strings"
+	"time"
 
 	"github.com/dipsylala/veracode-mcp/api"
 	"github.com/dipsylala/veracode-mcp/api/rest/generated/applications"
@@ -27,10 +25,18 @@ type StaticFindingsRequest struct {
 	Sandbox         string   `json:"sandbox,omitempty"`
 	Size            int      `json:"size,omitempty"`
 	Page            int      `json:"page,omitempty"`
+	AllPages        bool     `json:"all_pages,omitempty"`
+	MaxResults      int      `json:"max_results,omitempty"`
 	Severity        *int32   `json:"severity,omitempty"`
 	SeverityGte     *int32   `json:"severity_gte,omitempty"`
 	CWEIDs          []string `json:"cwe_ids,omitempty"`
 	ViolatesPolicy  *bool    `json:"violates_policy,omitempty"`
+
+	FindingCategory    []int32    `json:"finding_category,omitempty"`
+	MitigatedAfter     *time.Time `json:"mitigated_after,omitempty"`
+	New                bool       `json:"new,omitempty"`
+	IncludeAnnotations bool       `json:"include_annotations,omitempty"`
+	IncludeExpiryDate  bool       `json:"include_expiry_date,omitempty"`
 }
 
 // parseStaticFindingsRequest extracts and validates parameters from the raw args map
@@ -50,6 +56,12 @@ func parseStaticFindingsRequest(args map[string]interface{}) (*StaticFindingsReq
 	req.Size = extractInt(args, "page_size", 10)
 	req.Page = extractInt(args, "page", 0)
 
+	// Extract all-pages options
+	req.AllPages, req.MaxResults, err = extractAllPagesParams(args)
+	if err != nil {
+		return nil, err
+	}
+
 	// Extract optional int32 pointers with validation
 	req.Severity, _, err = extractOptionalInt32Ptr(args, "severity")
 	if err != nil {
@@ -68,6 +80,19 @@ func parseStaticFindingsRequest(args map[string]interface{}) (*StaticFindingsReq
 	}
 	req.ViolatesPolicy = violatesPolicy
 
+	// Extract the remaining Findings API filters
+	req.FindingCategory, err = extractInt32List(args, "finding_category")
+	if err != nil {
+		return nil, err
+	}
+	req.MitigatedAfter, err = extractOptionalDate(args, "mitigated_after")
+	if err != nil {
+		return nil, err
+	}
+	req.New = extractFlag(args, "new")
+	req.IncludeAnnotations = extractFlag(args, "include_annotations")
+	req.IncludeExpiryDate = extractFlag(args, "include_expiry_date")
+
 	// Extract CWE IDs
 	req.CWEIDs = extractCWEIDs(args)
 
@@ -101,16 +126,23 @@ func handleGetStaticFindings(ctx context.Context, args map[string]interface{}) (
 	hasAppProfile := appProfile != ""
 	if !hasAppProfile {
 		// Fall back to workspace config if app_profile not provided
-		appProfile, err = workspace.FindWorkspaceConfig(req.ApplicationPath)
+		var wsConfig *workspace.WorkspaceConfig
+		wsConfig, err = workspace.LoadWorkspaceConfig(req.ApplicationPath)
 		if err != nil {
 			return map[string]interface{}{
 				"error": fmt.Sprintf("Failed to find workspace configuration: %v", err),
 			}, nil
 		}
+		appProfile = wsConfig.Application()
+
+		// The workspace's default sandbox only applies to the workspace's own application
+		if req.Sandbox == "" {
+			req.Sandbox = wsConfig.Sandbox
+		}
 	}
 
 	// Step 2: Create API client
-	client, err := api.NewClient()
+	client, err := newWorkspaceClient(req.ApplicationPath)
 	if err != nil {
 		responseText := fmt.Sprintf(`Static Findings Analysis - Error
 ========================
@@ -138,8 +170,7 @@ Please ensure VERACODE_API_ID and VERACODE_API_KEY environment variables are set
 	// Step 3: Get application GUID using the profile name (or use directly if it's a GUID)
 	var applicationGUID string
 
-	// Check if appProfile is already a GUID (format: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
-	if len(appProfile) == 36 && appProfile[8] == '-' && appProfile[13] == '-' && appProfile[18] == '-' && appProfile[23] == '-' {
+	if isApplicationGUID(appProfile) {
 		// It's a GUID, use it directly
 		applicationGUID = appProfile
 	} else {
@@ -187,20 +218,56 @@ Please verify:
 		}
 	}
 
-	// Step 4: Build the findings request
+	// Step 4: Resolve the sandbox name to its GUID (no sandbox means the policy scan)
+	sandbox, err := resolveSandbox(ctx, client, applicationGUID, req.Sandbox)
+	if err != nil {
+		responseText := fmt.Sprintf(`Static Findings Analysis - Error
+========================
+
+Application Path: %s
+App Profile: %s
+Application GUID: %s
+Sandbox: %s
+Error: Failed to find sandbox
+
+%v
+
+Please verify the sandbox name matches a sandbox of this application.`,
+			req.ApplicationPath,
+			appProfile,
+			applicationGUID,
+			req.Sandbox,
+			err,
+		)
+
+		return map[string]interface{}{
+			"content": []map[string]string{{
+				"type": "text",
+				"text": responseText,
+			}},
+		}, nil
+	}
+
+	// Step 5: Build the findings request
 	findingsReq := api.FindingsRequest{
 		AppProfile:     applicationGUID,
-		Sandbox:        req.Sandbox,
+		Sandbox:        sandboxGUID(sandbox),
 		Size:           req.Size,
 		Page:           req.Page,
 		Severity:       req.Severity,
 		SeverityGte:    req.SeverityGte,
 		CWEIDs:         req.CWEIDs,
 		ViolatesPolicy: req.ViolatesPolicy,
+
+		FindingCategory: req.FindingCategory,
+		MitigatedAfter:  req.MitigatedAfter,
+		New:             req.New,
+		IncludeAnnot:    req.IncludeAnnotations,
+		IncludeExpDate:  req.IncludeExpiryDate,
 	}
 
-	// Step 5: Call the API to get static findings
-	findingsResp, err := client.GetStaticFindings(ctx, findingsReq)
+	// Step 6: Call the API to get static findings
+	findings, err := collectFindings(ctx, client, findingsReq, req.AllPages, req.MaxResults, staticFindingsFormat)
 	if err != nil {
 		responseText := fmt.Sprintf(`Static Findings Analysis - Error
 ========================
@@ -230,118 +297,34 @@ Please verify:
 		}, nil
 	}
 
-	// Step 6: Format and return the response
+	// Step 7: Format and return the response
 	policyFilter := req.ViolatesPolicy != nil && *req.ViolatesPolicy
-	return formatStaticFindingsResponse(ctx, appProfile, applicationGUID, req.Sandbox, findingsResp, policyFilter), nil
+	return formatStaticFindingsResponse(ctx, appProfile, applicationGUID, sandbox, findings, policyFilter), nil
 }
 
-// formatStaticFindingsResponse formats the findings API response into an MCP tool response
-func formatStaticFindingsResponse(ctx context.Context, appProfile, applicationGUID, sandbox string, findings *api.FindingsResponse, policyFilter bool) map[string]interface{} {
-	// Build MCP response structure
-	response := MCPFindingsResponse{
-		Application: MCPApplication{
-			Name: appProfile,
-			ID:   applicationGUID,
-		},
-		PolicyFilter: policyFilter,
-		Summary: MCPFindingsSummary{
-			BySeverity: map[string]int{
-				"very high": 0,
-				"high":      0,
-				"medium":    0,
-				"low":       0,
-				"very low":  0,
-				"info":      0,
-			},
-			ByStatus: map[string]int{
-				"open":   0,
-				"closed": 0,
-			},
-			ByMitigation: map[string]int{
-				"none":     0,
-				"proposed": 0,
-				"approved": 0,
-				"rejected": 0,
-			},
-		},
-		Findings: []MCPFinding{},
-	}
-
-	// Add sandbox if specified
-	if sandbox != "" {
-		response.Sandbox = &MCPSandbox{
-			Name: sandbox,
-			ID:   sandbox,
-		}
-	}
-
-	// Add pagination info
-	if findings != nil {
-		response.Pagination = &MCPPagination{
-			CurrentPage:   findings.Page,
-			PageSize:      findings.Size,
-			TotalElements: findings.TotalCount,
-			TotalPages:    (findings.TotalCount + findings.Size - 1) / findings.Size,
-			HasNext:       (findings.Page+1)*findings.Size < findings.TotalCount,
-			HasPrevious:   findings.Page > 0,
-		}
-		// Set total_findings to the total across all pages
-		response.Summary.TotalFindings = findings.TotalCount
-	}
-
-	// Process each finding
-	if findings != nil && len(findings.Findings) > 0 {
-		for _, finding := range findings.Findings {
-			mcpFinding := processStaticFinding(finding)
-			response.Findings = append(response.Findings, mcpFinding)
-			updateStaticSummaryCounters(&response.Summary, mcpFinding)
-		}
-	}
-
-	// Sort findings by severity (Very High first)
-	sort.Slice(response.Findings, func(i, j int) bool {
-		return response.Findings[i].SeverityScore > response.Findings[j].SeverityScore
-	})
-
-	// Marshal response to JSON for non-UI clients
-	responseJSON, err := json.MarshalIndent(response, "", "  ")
-	if err != nil {
-		log.Printf("Warning: Failed to marshal response to JSON: %v", err)
-		responseJSON, _ = json.Marshal(response) // Fall back to compact JSON
-	}
-
-	// Build pagination summary for LLM
-	var paginationSummary string
-	if response.Pagination != nil {
-		paginationSummary = fmt.Sprintf("Showing %d findings on page %d of %d (Total: %d findings across all pages)\n\n",
-			len(response.Findings),
-			response.Pagination.CurrentPage+1, // Display as 1-based
-			response.Pagination.TotalPages,
-			response.Pagination.TotalElements,
-		)
-	} else {
-		paginationSummary = fmt.Sprintf("Showing %d findings\n\n", len(response.Findings))
-	}
+// staticFindingsFormat converts and counts static findings, listing the most severe first
+var staticFindingsFormat = findingsFormat{
+	Label:             "Static findings",
+	ScanType:          "STATIC",
+	Convert:           processStaticFinding,
+	Count:             updateStaticSummaryCounters,
+	SortBySeverity:    true,
+	StructuredContent: true,
+}
 
-	// Build result with content
-	result := map[string]interface{}{
-		"content": []map[string]interface{}{
-			{
-				"type": "text",
-				"text": paginationSummary + string(responseJSON),
-			},
-		},
+// formatStaticFindingsResponse formats the collected findings into an MCP tool response
+func formatStaticFindingsResponse(ctx context.Context, appProfile, applicationGUID string, sandbox *MCPSandbox, findings *collectedFindings, policyFilter bool) map[string]interface{} {
+	response := newFindingsResponse(findings, staticFindingsFormat)
+	response.Application = MCPApplication{
+		Name: appProfile,
+		ID:   applicationGUID,
 	}
+	response.PolicyFilter = policyFilter
 
-	// Only include structuredContent if client supports MCP Apps UI
-	if ClientSupportsUIFromContext(ctx) {
-		log.Printf("Static findings: Returning %d findings (content: JSON, structuredContent: full data for UI)", len(response.Findings))
-		result["structuredContent"] = response
-	} else {
-		log.Printf("Static findings: Returning %d findings (content: JSON only, no structuredContent - client doesn't support UI)", len(response.Findings))
-	}
+	// Add sandbox if the findings are from one rather than the policy scan
+	response.Sandbox = sandbox
 
-	return result
+	return formatFindingsResponse(ctx, response, findings.Response != nil && findings.Response.AllPages, staticFindingsFormat)
 }
 
 // processStaticFinding transforms a single API finding into an MCP finding
@@ -366,22 +349,26 @@ func processStaticFinding(finding api.Finding) MCPFinding {
 	}
 
 	return MCPFinding{
-		FlawID:           finding.ID,
-		BuildID:          finding.BuildID,
-		ScanType:         "STATIC",
-		Status:           finding.Status,
-		MitigationStatus: mitigationStatus,
-		ViolatesPolicy:   finding.ViolatesPolicy,
-		Severity:         string(transformedSeverity),
-		SeverityScore:    severityNum,
-		CweId:            cweID,
-		Description:      cleanedDesc,
-		References:       references,
-		FilePath:         finding.FilePath,
-		LineNumber:       finding.LineNumber,
-		Module:           finding.Module,
-		Procedure:        finding.Procedure,
-		AttackVector:     finding.AttackVector,
+		FlawID:             finding.ID,
+		BuildID:            finding.BuildID,
+		ScanType:           "STATIC",
+		Status:             finding.Status,
+		MitigationStatus:   mitigationStatus,
+		ViolatesPolicy:     finding.ViolatesPolicy,
+		Severity:           string(transformedSeverity),
+		SeverityScore:      severityNum,
+		CweId:              cweID,
+		CweName:            CWEName(cweID),
+		Description:        cleanedDesc,
+		References:         references,
+		FilePath:           finding.FilePath,
+		LineNumber:         finding.LineNumber,
+		Module:             finding.Module,
+		Procedure:          finding.Procedure,
+		AttackVector:       finding.AttackVector,
+		Mitigations:        TransformAnnotations(finding.Annotations),
+		GracePeriodExpires: finding.GracePeriodExpires,
+		DetailsError:       finding.DetailsError,
 	}
 }
 
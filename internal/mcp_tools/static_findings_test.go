@@ -2,7 +2,10 @@ package mcp_tools
 
 import (
 	"context"
+	"strings"
 	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
 )
 
 // ============================================================================
@@ -80,6 +83,74 @@ func TestParseStaticFindingsRequest_PaginationValidation(t *testing.T) {
 	}
 }
 
+func TestParseStaticFindingsRequest_AllPages(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path": "/path/to/app",
+		"all_pages":        true,
+	}
+
+	req, err := parseStaticFindingsRequest(args)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !req.AllPages {
+		t.Error("Expected all_pages to be true")
+	}
+	if req.MaxResults != defaultAllPagesMaxResults {
+		t.Errorf("Expected default max_results %d, got %d", defaultAllPagesMaxResults, req.MaxResults)
+	}
+}
+
+func TestParseStaticFindingsRequest_MaxResultsValidation(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path": "/path/to/app",
+		"all_pages":        true,
+		"max_results":      float64(10001),
+	}
+
+	_, err := parseStaticFindingsRequest(args)
+	if err == nil {
+		t.Fatal("Expected error for max_results=10001")
+	}
+}
+
+func TestParseStaticFindingsRequest_Filters(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path":    "/path/to/app",
+		"finding_category":    []interface{}{float64(19), float64(20)},
+		"mitigated_after":     "2024-01-31",
+		"new":                 true,
+		"include_annotations": true,
+	}
+
+	req, err := parseStaticFindingsRequest(args)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(req.FindingCategory) != 2 || req.FindingCategory[0] != 19 {
+		t.Errorf("Expected finding categories [19 20], got %v", req.FindingCategory)
+	}
+	if req.MitigatedAfter == nil || req.MitigatedAfter.Format("2006-01-02") != "2024-01-31" {
+		t.Errorf("Expected mitigated_after 2024-01-31, got %v", req.MitigatedAfter)
+	}
+	if !req.New || !req.IncludeAnnotations {
+		t.Error("Expected new and include_annotations to be set")
+	}
+}
+
+func TestParseStaticFindingsRequest_InvalidMitigatedAfter(t *testing.T) {
+	args := map[string]interface{}{
+		"application_path": "/path/to/app",
+		"mitigated_after":  "last week",
+	}
+
+	if _, err := parseStaticFindingsRequest(args); err == nil {
+		t.Fatal("Expected error for invalid mitigated_after")
+	}
+}
+
 func TestParseStaticFindingsRequest_MissingApplicationPath(t *testing.T) {
 	args := map[string]interface{}{}
 
@@ -89,6 +160,53 @@ func TestParseStaticFindingsRequest_MissingApplicationPath(t *testing.T) {
 	}
 }
 
+func TestFormatStaticFindingsResponse_AllPages(t *testing.T) {
+	summary := newFindingsSummary()
+	summary.OpenFindings = 30
+	findings := &collectedFindings{
+		Response: &api.FindingsResponse{
+			Findings: []api.Finding{
+				{ID: "1", SeverityScore: 5, Status: "OPEN", ViolatesPolicy: true},
+				{ID: "2", SeverityScore: 4, Status: "CLOSED"},
+			},
+			TotalCount: 40,
+			AllPages:   true,
+			Truncated:  true,
+		},
+		Summary: &summary,
+	}
+
+	result := formatStaticFindingsResponse(context.Background(), "MyApp", "guid", nil, findings, true)
+	content := result["content"].([]map[string]interface{})
+	text := content[0]["text"].(string)
+
+	if !strings.Contains(text, "Showing the first 2 of 40 findings") || !strings.Contains(text, "summary counts cover all 40 findings") {
+		t.Errorf("Expected truncated all-pages summary, got: %s", text[:160])
+	}
+	if !strings.Contains(text, `"truncated": true`) {
+		t.Error("Expected truncated flag in JSON response")
+	}
+	if !strings.Contains(text, `"open_findings": 30`) {
+		t.Error("Expected the summary counted over every page rather than the findings listed")
+	}
+	if strings.Contains(text, `"pagination"`) {
+		t.Error("Expected no pagination block for all-pages response")
+	}
+}
+
+func TestFormatStaticFindingsResponse_Sandbox(t *testing.T) {
+	findings := &api.FindingsResponse{Findings: []api.Finding{}, Size: 10}
+	sandbox := &MCPSandbox{Name: "feature-login", ID: "0b9e2a4c-5d6f-4a1b-8c7d-9e0f1a2b3c4d"}
+
+	result := formatStaticFindingsResponse(context.Background(), "MyApp", "guid", sandbox, &collectedFindings{Response: findings}, true)
+	content := result["content"].([]map[string]interface{})
+	text := content[0]["text"].(string)
+
+	if !strings.Contains(text, `"id": "0b9e2a4c-5d6f-4a1b-8c7d-9e0f1a2b3c4d"`) || !strings.Contains(text, `"name": "feature-login"`) {
+		t.Error("Expected the resolved sandbox name and GUID in the response")
+	}
+}
+
 // ============================================================================
 // handleGetStaticFindings tests
 // ============================================================================
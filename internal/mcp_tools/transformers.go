@@ -153,6 +153,24 @@ func TransformMitigationAction(actionCode string) string {
 	}
 }
 
+// TransformAnnotations converts finding annotations (mitigation proposals, reviews and comments) to MCP mitigations
+func TransformAnnotations(annotations []api.Annotation) []MCPMitigation {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	mitigations := make([]MCPMitigation, 0, len(annotations))
+	for _, annotation := range annotations {
+		mitigations = append(mitigations, MCPMitigation{
+			Action:    TransformMitigationAction(annotation.Action),
+			Comment:   annotation.Comment,
+			Submitter: annotation.UserName,
+			Date:      TransformDate(&annotation.Created),
+		})
+	}
+	return mitigations
+}
+
 // ProcessBase64Description decodes base64 encoded descriptions (for DAST findings)
 func ProcessBase64Description(description string) string {
 	// Try to detect if the description is base64 encoded
@@ -269,11 +287,15 @@ func TransformDate(dateStr *string) string {
 	return *dateStr
 }
 
-// TransformWeaknessType generates weakness type string (e.g., "CWE-79")
+// TransformWeaknessType generates weakness type string (e.g., "CWE-79"), with the
+// weakness name from the CWE catalog when it is known (e.g., "CWE-89: Improper Neutralization ...")
 func TransformWeaknessType(cweID *int32) string {
 	if cweID == nil {
 		return "Unknown"
 	}
+	if name := CWEName(*cweID); name != "" {
+		return fmt.Sprintf("CWE-%d: %s", *cweID, name)
+	}
 	return fmt.Sprintf("CWE-%d", *cweID)
 }
 
@@ -2,6 +2,8 @@ package mcp_tools
 
 import (
 	"testing"
+
+	"github.com/dipsylala/veracode-mcp/api"
 )
 
 func TestExtractReferences(t *testing.T) {
@@ -96,3 +98,21 @@ func TestCleanDescription(t *testing.T) {
 		})
 	}
 }
+
+func TestTransformAnnotations(t *testing.T) {
+	mitigations := TransformAnnotations([]api.Annotation{
+		{Action: "APPDESIGN", Comment: "Input is validated upstream", UserName: "jdoe", Created: "2024-02-01T10:00:00Z"},
+	})
+
+	if len(mitigations) != 1 {
+		t.Fatalf("Expected 1 mitigation, got %d", len(mitigations))
+	}
+	m := mitigations[0]
+	if m.Action != "Mitigate by Design" || m.Submitter != "jdoe" || m.Date != "2024-02-01T10:00:00Z" {
+		t.Errorf("Unexpected mitigation: %+v", m)
+	}
+
+	if TransformAnnotations(nil) != nil {
+		t.Error("Expected nil for no annotations")
+	}
+}
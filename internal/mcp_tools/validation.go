@@ -2,18 +2,12 @@ package mcp_tools
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
-)
+	"time"
 
-// veracodeWorkDir returns the temporary working directory for a given application and scan type.
-// Path: {os.TempDir()}/.veracode/{app_dir_name}/{subdir}
-func veracodeWorkDir(applicationPath, subdir string) string {
-	appName := filepath.Base(applicationPath)
-	return filepath.Join(os.TempDir(), ".veracode", appName, subdir)
-}
+	"github.com/dipsylala/veracode-mcp/api"
+)
 
 // extractRequiredString extracts and validates a required non-empty string parameter.
 // Returns an error if the parameter is missing or empty.
@@ -60,6 +54,103 @@ func extractOptionalInt32Ptr(args map[string]interface{}, field string) (*int32,
 	return &result, true, nil
 }
 
+// extractOptionalFloat64Ptr extracts an optional float64 pointer parameter (for CVSS fields).
+// Returns nil if the parameter is not present.
+func extractOptionalFloat64Ptr(args map[string]interface{}, field string) *float64 {
+	val, ok := args[field].(float64)
+	if !ok {
+		return nil
+	}
+	return &val
+}
+
+// extractOptionalDate extracts an optional date parameter in YYYY-MM-DD format.
+// Returns nil if the parameter is not present or empty.
+func extractOptionalDate(args map[string]interface{}, field string) (*time.Time, error) {
+	val, ok := args[field].(string)
+	if !ok || val == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", val)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a date in YYYY-MM-DD format, got %q", field, val)
+	}
+	return &date, nil
+}
+
+// extractOptionalEnum extracts an optional string parameter that must be one of allowed (case-insensitive).
+// Returns the upper-cased value, or "" if the parameter is not present or empty.
+func extractOptionalEnum(args map[string]interface{}, field string, allowed []string) (string, error) {
+	val, ok := args[field].(string)
+	if !ok || val == "" {
+		return "", nil
+	}
+
+	upper := strings.ToUpper(val)
+	for _, option := range allowed {
+		if upper == option {
+			return upper, nil
+		}
+	}
+	return "", fmt.Errorf("%s must be one of %s, got %q", field, strings.Join(allowed, ", "), val)
+}
+
+// extractInt32List extracts an optional array of positive integers (e.g. finding category IDs).
+// Returns nil if the parameter is not present.
+func extractInt32List(args map[string]interface{}, field string) ([]int32, error) {
+	values, ok := args[field].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	result := make([]int32, 0, len(values))
+	for _, value := range values {
+		var n int
+		switch v := value.(type) {
+		case float64:
+			n = int(v)
+		case string:
+			parsed, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s must contain integers, got %q", field, v)
+			}
+			n = parsed
+		default:
+			return nil, fmt.Errorf("%s must contain integers, got %v", field, v)
+		}
+		if n <= 0 || n > 2147483647 {
+			return nil, fmt.Errorf("%s values must be positive integers, got %d", field, n)
+		}
+		result = append(result, int32(n)) // #nosec G115 - validated above
+	}
+	return result, nil
+}
+
+// extractStringList extracts an optional array of non-empty strings, also accepting a single string.
+// Returns nil if the parameter is not present.
+func extractStringList(args map[string]interface{}, field string) ([]string, error) {
+	switch v := args[field].(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		return []string{strings.TrimSpace(v)}, nil
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, value := range v {
+			s, ok := value.(string)
+			if !ok || strings.TrimSpace(s) == "" {
+				return nil, fmt.Errorf("%s must contain non-empty strings, got %v", field, value)
+			}
+			result = append(result, strings.TrimSpace(s))
+		}
+		return result, nil
+	default:
+		return nil, nil
+	}
+}
+
 // extractOptionalBool extracts an optional boolean parameter.
 // Returns (pointer to value, true) if present, (nil, false) if not present.
 func extractOptionalBool(args map[string]interface{}, field string) (*bool, bool) {
@@ -178,6 +269,15 @@ func validateInt32Range(value *int32, min, max int32, fieldName string) error {
 	return nil
 }
 
+// validateFloat64Range validates that an optional float64 pointer is within the specified range (inclusive).
+// Returns nil if the pointer is nil (value not provided).
+func validateFloat64Range(value *float64, min, max float64, fieldName string) error {
+	if value != nil && (*value < min || *value > max) {
+		return fmt.Errorf("%s must be between %g and %g, got %g", fieldName, min, max, *value)
+	}
+	return nil
+}
+
 // validatePaginationParams validates size and page parameters against schema constraints.
 // size must be between 1 and 500, page must be between 0 and 500.
 func validatePaginationParams(size, page int) error {
@@ -190,6 +290,20 @@ func validatePaginationParams(size, page int) error {
 	return nil
 }
 
+// defaultAllPagesMaxResults is the max_results used when all_pages is set without an explicit limit
+const defaultAllPagesMaxResults = 1000
+
+// extractAllPagesParams extracts the all_pages and max_results parameters used by platform findings tools.
+// max_results must be between 1 and api.MaxFetchAllFindings.
+func extractAllPagesParams(args map[string]interface{}) (bool, int, error) {
+	allPages, _ := extractOptionalBool(args, "all_pages")
+	maxResults := extractInt(args, "max_results", defaultAllPagesMaxResults)
+	if err := validateIntRange(maxResults, 1, api.MaxFetchAllFindings, "max_results"); err != nil {
+		return false, 0, err
+	}
+	return allPages != nil && *allPages, maxResults, nil
+}
+
 // validateSeverity validates that a severity value is between 0 and 5 (inclusive).
 // The fieldName parameter is used in error messages (e.g., "severity" or "severity_gte").
 func validateSeverity(severity *int32, fieldName string) error {
@@ -220,3 +334,9 @@ func extractCWEIDs(args map[string]interface{}) []string {
 	}
 	return cweIDs
 }
+
+// extractFlag returns the value of an optional boolean parameter, defaulting to false
+func extractFlag(args map[string]interface{}, field string) bool {
+	val, _ := extractOptionalBool(args, field)
+	return val != nil && *val
+}
@@ -713,3 +713,76 @@ func TestExtractCWEIDs_EmptyArray(t *testing.T) {
 		t.Errorf("Expected empty array, got %v", result)
 	}
 }
+
+// ============================================================================
+// Findings filter helper tests
+// ============================================================================
+
+func TestExtractOptionalDate_Valid(t *testing.T) {
+	date, err := extractOptionalDate(map[string]interface{}{"mitigated_after": "2024-03-15"}, "mitigated_after")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if date == nil || date.Format("2006-01-02") != "2024-03-15" {
+		t.Errorf("Expected 2024-03-15, got %v", date)
+	}
+}
+
+func TestExtractOptionalDate_Invalid(t *testing.T) {
+	if _, err := extractOptionalDate(map[string]interface{}{"mitigated_after": "15/03/2024"}, "mitigated_after"); err == nil {
+		t.Error("Expected error for a non YYYY-MM-DD date")
+	}
+}
+
+func TestExtractOptionalDate_Missing(t *testing.T) {
+	date, err := extractOptionalDate(map[string]interface{}{}, "mitigated_after")
+	if err != nil || date != nil {
+		t.Errorf("Expected nil date and no error, got %v, %v", date, err)
+	}
+}
+
+func TestExtractOptionalEnum_CaseInsensitive(t *testing.T) {
+	val, err := extractOptionalEnum(map[string]interface{}{"mode": "direct"}, "mode", []string{"DIRECT", "TRANSITIVE"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if val != "DIRECT" {
+		t.Errorf("Expected DIRECT, got %s", val)
+	}
+}
+
+func TestExtractOptionalEnum_Invalid(t *testing.T) {
+	if _, err := extractOptionalEnum(map[string]interface{}{"mode": "sideways"}, "mode", []string{"DIRECT", "TRANSITIVE"}); err == nil {
+		t.Error("Expected error for a value outside the allowed set")
+	}
+}
+
+func TestExtractInt32List(t *testing.T) {
+	values, err := extractInt32List(map[string]interface{}{"finding_category": []interface{}{float64(1), "19"}}, "finding_category")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(values) != 2 || values[0] != 1 || values[1] != 19 {
+		t.Errorf("Expected [1 19], got %v", values)
+	}
+
+	if _, err := extractInt32List(map[string]interface{}{"finding_category": []interface{}{float64(0)}}, "finding_category"); err == nil {
+		t.Error("Expected error for a non-positive value")
+	}
+	if _, err := extractInt32List(map[string]interface{}{"finding_category": []interface{}{"abc"}}, "finding_category"); err == nil {
+		t.Error("Expected error for a non-numeric value")
+	}
+}
+
+func TestValidateFloat64Range(t *testing.T) {
+	inRange, outOfRange := 7.5, 10.5
+	if err := validateFloat64Range(&inRange, 0, 10, "cvss"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := validateFloat64Range(&outOfRange, 0, 10, "cvss"); err == nil {
+		t.Error("Expected error for value above max")
+	}
+	if err := validateFloat64Range(nil, 0, 10, "cvss"); err != nil {
+		t.Errorf("Expected nil to pass, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package mcp_tools
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// workDirEnvVar selects where the working directories (packages, pipeline runs, SCA results, reports) are kept:
+// unset for the user cache directory, an absolute path for another root, or workDirInRepo for the application's .veracode directory
+const workDirEnvVar = "VERACODE_MCP_WORK_DIR"
+
+// workDirInRepo keeps each application's working directory in <application>/.veracode
+const workDirInRepo = "repo"
+
+// repoWorkDirName is the subdirectory of .veracode holding an application's working directory with
+// VERACODE_MCP_WORK_DIR=repo. It ignores itself in git; .veracode is left for files meant to be committed,
+// such as baseline.json.
+const repoWorkDirName = "work"
+
+// repoWorkDirGitignore is written to the repo working directory so none of it is committed
+const repoWorkDirGitignore = "# Veracode MCP working files, not meant to be committed\n*\n"
+
+// packagingSubdir holds the packaged artifacts. With VERACODE_MCP_WORK_DIR=repo it is kept in the default root
+// instead, because the packager reads the whole application directory and would pick up its own earlier output.
+const packagingSubdir = "packaging"
+
+// workspaceManifestFile records which application a working directory belongs to
+const workspaceManifestFile = "manifest.json"
+
+// workspaceManifest is written to the root of each application's working directory
+type workspaceManifest struct {
+	ApplicationPath string `json:"application_path"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"` // Last time a tool wrote to the working directory
+}
+
+// veracodeWorkRoot returns the directory holding the working directories of all applications,
+// or "" when each application keeps its own in .veracode
+func veracodeWorkRoot() string {
+	configured := strings.TrimSpace(os.Getenv(workDirEnvVar))
+	switch {
+	case configured == workDirInRepo:
+		return ""
+	case configured == "~" || strings.HasPrefix(configured, "~/") || strings.HasPrefix(configured, `~\`):
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, configured[1:])
+		}
+		log.Printf("Warning: Cannot expand %s=%s without a home directory; using the default", workDirEnvVar, configured)
+	case filepath.IsAbs(configured):
+		return filepath.Clean(configured)
+	case configured != "":
+		log.Printf("Warning: %s must be an absolute path or %q, got %q; using the default", workDirEnvVar, workDirInRepo, configured)
+	}
+
+	return defaultWorkRoot()
+}
+
+// defaultWorkRoot returns the user cache directory's veracode-mcp directory
+func defaultWorkRoot() string {
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(cacheDir, "veracode-mcp")
+	}
+	return filepath.Join(os.TempDir(), "veracode-mcp")
+}
+
+// absApplicationPath returns the absolute, cleaned application path that working directories are keyed on
+func absApplicationPath(applicationPath string) string {
+	absPath, err := filepath.Abs(applicationPath)
+	if err != nil {
+		return filepath.Clean(applicationPath)
+	}
+	return absPath
+}
+
+// workspaceKey names an application's working directory: the directory name for readability,
+// then a hash of the absolute path so two checkouts with the same name don't share one
+func workspaceKey(applicationPath string) string {
+	absPath := absApplicationPath(applicationPath)
+	hashed := absPath
+	if runtime.GOOS == "windows" {
+		hashed = strings.ToLower(hashed)
+	}
+	sum := sha256.Sum256([]byte(hashed))
+	return fmt.Sprintf("%s-%s", filepath.Base(absPath), hex.EncodeToString(sum[:])[:12])
+}
+
+// veracodeWorkspaceDir returns the working directory of an application.
+// Path: {root}/{app_dir_name}-{path_hash}, or {application}/.veracode/work with VERACODE_MCP_WORK_DIR=repo
+func veracodeWorkspaceDir(applicationPath string) string {
+	root := veracodeWorkRoot()
+	if root == "" {
+		return filepath.Join(absApplicationPath(applicationPath), ".veracode", repoWorkDirName)
+	}
+	return filepath.Join(root, workspaceKey(applicationPath))
+}
+
+// veracodeWorkspaceDirs returns every working directory an application's artifacts can be in:
+// with VERACODE_MCP_WORK_DIR=repo, packages are kept outside the application
+func veracodeWorkspaceDirs(applicationPath string) []string {
+	dirs := []string{veracodeWorkspaceDir(applicationPath)}
+	if packagingWorkspace := filepath.Dir(veracodeWorkDir(applicationPath, packagingSubdir)); packagingWorkspace != dirs[0] {
+		dirs = append(dirs, packagingWorkspace)
+	}
+	return dirs
+}
+
+// veracodeWorkDir returns the working directory for a given application and scan type.
+// Path: {workspace_dir}/{subdir}
+func veracodeWorkDir(applicationPath, subdir string) string {
+	if subdir == packagingSubdir && veracodeWorkRoot() == "" {
+		return filepath.Join(defaultWorkRoot(), workspaceKey(applicationPath), subdir)
+	}
+	return filepath.Join(veracodeWorkspaceDir(applicationPath), subdir)
+}
+
+// createVeracodeWorkDir creates the working directory for an application and scan type,
+// and records the application in the workspace manifest
+func createVeracodeWorkDir(applicationPath, subdir string) (string, error) {
+	dir := veracodeWorkDir(applicationPath, subdir)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return "", err
+	}
+	workspaceDir := filepath.Dir(dir)
+	if veracodeWorkRoot() == "" && workspaceDir == veracodeWorkspaceDir(applicationPath) {
+		if err := writeRepoWorkDirGitignore(workspaceDir); err != nil {
+			log.Printf("Warning: Failed to write %s: %v", filepath.Join(workspaceDir, ".gitignore"), err)
+		}
+	}
+	if err := writeWorkspaceManifest(workspaceDir, applicationPath); err != nil {
+		// The manifest only helps workspace-artifacts find the application; don't fail the scan over it
+		log.Printf("Warning: Failed to update workspace manifest: %v", err)
+	}
+	return dir, nil
+}
+
+// writeRepoWorkDirGitignore keeps a working directory inside the application out of git, unless it already has a .gitignore
+func writeRepoWorkDirGitignore(workspaceDir string) error {
+	gitignore := filepath.Join(workspaceDir, ".gitignore")
+	if _, err := os.Stat(gitignore); err == nil {
+		return nil
+	}
+	return os.WriteFile(gitignore, []byte(repoWorkDirGitignore), 0600)
+}
+
+// touchWorkspaceManifest writes the manifest of an application's working directory, keeping its creation time
+func touchWorkspaceManifest(applicationPath string) error {
+	return writeWorkspaceManifest(veracodeWorkspaceDir(applicationPath), applicationPath)
+}
+
+// writeWorkspaceManifest writes the manifest of one of an application's working directories, keeping its creation time
+func writeWorkspaceManifest(workspaceDir, applicationPath string) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	manifest, err := readWorkspaceManifest(workspaceDir)
+	if err != nil {
+		manifest = &workspaceManifest{CreatedAt: now}
+	}
+	manifest.ApplicationPath = absApplicationPath(applicationPath)
+	manifest.UpdatedAt = now
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(workspaceDir, workspaceManifestFile), data, 0600)
+}
+
+// readWorkspaceManifest reads the manifest at the root of a working directory
+func readWorkspaceManifest(workspaceDir string) (*workspaceManifest, error) {
+	// #nosec G304 -- the manifest path is built from the Veracode work directory and a fixed name
+	data, err := os.ReadFile(filepath.Join(workspaceDir, workspaceManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest workspaceManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace manifest: %w", err)
+	}
+	if manifest.ApplicationPath == "" {
+		return nil, errors.New("workspace manifest has no application path")
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,96 @@
+package mcp_tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVeracodeWorkDir_SameNameCheckouts(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv(workDirEnvVar, root)
+
+	first := veracodeWorkDir("/work/team-a/api", "pipeline")
+	second := veracodeWorkDir("/work/team-b/api", "pipeline")
+	if first == second {
+		t.Fatalf("checkouts with the same name share %s", first)
+	}
+	if filepath.Dir(filepath.Dir(first)) != root || !strings.HasPrefix(filepath.Base(filepath.Dir(first)), "api-") {
+		t.Errorf("expected {root}/api-{hash}/pipeline, got %s", first)
+	}
+	if again := veracodeWorkDir("/work/team-a/api/", "pipeline"); again != first {
+		t.Errorf("the same application should always get %s, got %s", first, again)
+	}
+}
+
+func TestVeracodeWorkRoot(t *testing.T) {
+	t.Setenv(workDirEnvVar, workDirInRepo)
+	if dir := veracodeWorkDir("/work/app", "sca"); dir != filepath.Join("/work/app", ".veracode", repoWorkDirName, "sca") {
+		t.Errorf("repo layout: got %s", dir)
+	}
+	// Packages stay out of the application so the next packaging run doesn't pick them up
+	if dir := veracodeWorkDir("/work/app", packagingSubdir); strings.HasPrefix(dir, "/work/app") || filepath.Dir(filepath.Dir(dir)) != defaultWorkRoot() {
+		t.Errorf("repo layout packaging: got %s", dir)
+	}
+	if dirs := veracodeWorkspaceDirs("/work/app"); len(dirs) != 2 {
+		t.Errorf("expected the repo and packaging working directories, got %v", dirs)
+	}
+
+	// A relative root is ignored rather than resolved against the server's working directory
+	t.Setenv(workDirEnvVar, "relative/dir")
+	if root := veracodeWorkRoot(); !filepath.IsAbs(root) || filepath.Base(root) != "veracode-mcp" {
+		t.Errorf("expected the default root, got %s", root)
+	}
+}
+
+func TestCreateVeracodeWorkDir_WritesManifest(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	appPath := filepath.Join(t.TempDir(), "manifest-app")
+
+	if _, err := createVeracodeWorkDir(appPath, "packaging"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first, err := readWorkspaceManifest(veracodeWorkspaceDir(appPath))
+	if err != nil {
+		t.Fatalf("manifest was not written: %v", err)
+	}
+	if first.ApplicationPath != appPath || first.CreatedAt == "" {
+		t.Errorf("unexpected manifest: %+v", first)
+	}
+
+	if _, err := createVeracodeWorkDir(appPath, "pipeline"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := readWorkspaceManifest(veracodeWorkspaceDir(appPath))
+	if err != nil || second.CreatedAt != first.CreatedAt {
+		t.Errorf("the creation time should be kept, got %+v, %v", second, err)
+	}
+}
+
+func TestCreateVeracodeWorkDir_RepoLayoutIgnoresItself(t *testing.T) {
+	t.Setenv(workDirEnvVar, workDirInRepo)
+	appPath := t.TempDir()
+	baseline := filepath.Join(appPath, ".veracode", "baseline.json")
+	if err := os.MkdirAll(filepath.Dir(baseline), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(baseline, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := createVeracodeWorkDir(appPath, "pipeline"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(veracodeWorkspaceDir(appPath), ".gitignore"))
+	if err != nil || !strings.Contains(string(data), "\n*\n") {
+		t.Errorf("expected a .gitignore ignoring the whole working directory, got %q, %v", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(appPath, ".veracode", ".gitignore")); !os.IsNotExist(err) {
+		t.Error("the .veracode directory holding baseline.json should not be ignored")
+	}
+	if _, err := readWorkspaceManifest(veracodeWorkspaceDir(appPath)); err != nil {
+		t.Errorf("manifest was not written: %v", err)
+	}
+}
@@ -0,0 +1,427 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const WorkspaceArtifactsToolName = "workspace-artifacts"
+
+const (
+	artifactsActionList  = "LIST"
+	artifactsActionPrune = "PRUNE"
+)
+
+// artifactNames are the artifact directories the tools keep in a working directory
+var artifactNames = []string{packagingSubdir, "pipeline", "sca", "platform", "reports", "jobs"}
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(WorkspaceArtifactsToolName, handleWorkspaceArtifacts)
+}
+
+// WorkspaceArtifactsRequest represents the parsed parameters for workspace-artifacts
+type WorkspaceArtifactsRequest struct {
+	ApplicationPath string // Empty covers every working directory under the work root
+	Action          string
+	Artifacts       []string // Artifact directories to prune (e.g. pipeline); empty selects all of them
+	OlderThanDays   int      // Only prune artifacts not modified for this many days; 0 ignores age
+	Confirm         bool
+}
+
+// MCPWorkspaceArtifactsResponse lists the working directories and what is stored in them
+type MCPWorkspaceArtifactsResponse struct {
+	Root       string                 `json:"root,omitempty"` // Empty when each application keeps its artifacts in .veracode
+	Action     string                 `json:"action"`
+	Confirmed  bool                   `json:"confirmed,omitempty"`
+	Workspaces []MCPArtifactWorkspace `json:"workspaces"`
+}
+
+// MCPArtifactWorkspace is the working directory of one application
+type MCPArtifactWorkspace struct {
+	ApplicationPath   string                 `json:"application_path"`
+	Directory         string                 `json:"directory"`
+	ApplicationExists bool                   `json:"application_exists"`
+	LastUsed          string                 `json:"last_used,omitempty"`
+	SizeBytes         int64                  `json:"size_bytes"`
+	Artifacts         []MCPWorkspaceArtifact `json:"artifacts"`
+}
+
+// MCPWorkspaceArtifact is one artifact directory (packaging, pipeline, sca, platform, reports)
+type MCPWorkspaceArtifact struct {
+	Name         string `json:"name"`
+	Path         string `json:"path"`
+	Files        int    `json:"files"`
+	SizeBytes    int64  `json:"size_bytes"`
+	LastModified string `json:"last_modified,omitempty"`
+	InUse        bool   `json:"in_use,omitempty"`  // Used by a queued or running job, so never pruned
+	Prune        bool   `json:"prune,omitempty"`   // Selected for pruning
+	Deleted      bool   `json:"deleted,omitempty"` // Pruned once confirmed
+	Error        string `json:"error,omitempty"`
+
+	modified time.Time
+}
+
+// parseWorkspaceArtifactsRequest extracts and validates parameters from the raw args map
+func parseWorkspaceArtifactsRequest(args map[string]interface{}) (*WorkspaceArtifactsRequest, error) {
+	req := &WorkspaceArtifactsRequest{}
+
+	req.ApplicationPath, _ = extractOptionalString(args, "application_path")
+	if req.ApplicationPath != "" && !filepath.IsAbs(req.ApplicationPath) {
+		return nil, fmt.Errorf("application_path must be an absolute path, got %q", req.ApplicationPath)
+	}
+
+	var err error
+	req.Action, err = extractOptionalEnum(args, "action", []string{artifactsActionList, artifactsActionPrune})
+	if err != nil {
+		return nil, err
+	}
+	if req.Action == "" {
+		req.Action = artifactsActionList
+	}
+
+	req.Artifacts, err = extractStringList(args, "artifacts")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range req.Artifacts {
+		if !slices.Contains(artifactNames, name) {
+			return nil, fmt.Errorf("unknown artifact %q; use one of %s", name, strings.Join(artifactNames, ", "))
+		}
+	}
+	req.OlderThanDays = extractInt(args, "older_than_days", 0)
+	if req.OlderThanDays < 0 {
+		return nil, fmt.Errorf("older_than_days must not be negative, got %d", req.OlderThanDays)
+	}
+	req.Confirm = extractFlag(args, "confirm")
+
+	if req.Action != artifactsActionPrune && (len(req.Artifacts) > 0 || req.OlderThanDays > 0 || req.Confirm) {
+		return nil, fmt.Errorf("artifacts, older_than_days and confirm can only be used with action PRUNE")
+	}
+
+	return req, nil
+}
+
+// handleWorkspaceArtifacts lists the stored artifacts and, after a confirmation preview, prunes them
+func handleWorkspaceArtifacts(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseWorkspaceArtifactsRequest(args)
+	if err != nil {
+		return map[string]interface{}{
+			"error": err.Error(),
+		}, nil
+	}
+
+	// Step 1: Find the working directories
+	response := &MCPWorkspaceArtifactsResponse{Root: veracodeWorkRoot(), Action: req.Action}
+	if req.ApplicationPath == "" && response.Root == "" {
+		return map[string]interface{}{
+			"error": fmt.Sprintf("application_path is required when %s=%s keeps artifacts in each application", workDirEnvVar, workDirInRepo),
+		}, nil
+	}
+	response.Workspaces, err = findArtifactWorkspaces(req.ApplicationPath, response.Root)
+	if err != nil {
+		return map[string]interface{}{
+			"error": fmt.Sprintf("Failed to list working directories: %v", err),
+		}, nil
+	}
+
+	// Step 2: Select and delete the artifacts to prune
+	if req.Action == artifactsActionPrune {
+		markArtifactsInUse(response.Workspaces)
+		selectArtifactsToPrune(response.Workspaces, req, time.Now())
+		if req.Confirm {
+			pruneWorkspaceArtifacts(response.Workspaces)
+			response.Confirmed = true
+		}
+	}
+
+	return formatWorkspaceArtifactsResponse(response), nil
+}
+
+// findArtifactWorkspaces returns the working directories of applicationPath,
+// or every working directory with a manifest under root when no application is given
+func findArtifactWorkspaces(applicationPath, root string) ([]MCPArtifactWorkspace, error) {
+	if applicationPath != "" {
+		var workspaces []MCPArtifactWorkspace
+		for _, workspaceDir := range veracodeWorkspaceDirs(applicationPath) {
+			if _, err := os.Stat(workspaceDir); os.IsNotExist(err) {
+				continue
+			}
+			workspace := newArtifactWorkspace(workspaceDir, absApplicationPath(applicationPath))
+			if manifest, err := readWorkspaceManifest(workspaceDir); err == nil {
+				workspace.LastUsed = manifest.UpdatedAt
+			}
+			workspaces = append(workspaces, workspace)
+		}
+		return workspaces, nil
+	}
+
+	entries, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaces []MCPArtifactWorkspace
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		// Only directories with a manifest are working directories; leave anything else alone
+		workspaceDir := filepath.Join(root, entry.Name())
+		manifest, err := readWorkspaceManifest(workspaceDir)
+		if err != nil {
+			continue
+		}
+		workspace := newArtifactWorkspace(workspaceDir, manifest.ApplicationPath)
+		workspace.LastUsed = manifest.UpdatedAt
+		workspaces = append(workspaces, workspace)
+	}
+
+	sort.Slice(workspaces, func(i, j int) bool {
+		return workspaces[i].ApplicationPath < workspaces[j].ApplicationPath
+	})
+	return workspaces, nil
+}
+
+// newArtifactWorkspace describes a working directory and measures its artifact directories
+func newArtifactWorkspace(workspaceDir, applicationPath string) MCPArtifactWorkspace {
+	workspace := MCPArtifactWorkspace{
+		ApplicationPath: applicationPath,
+		Directory:       workspaceDir,
+		Artifacts:       []MCPWorkspaceArtifact{},
+	}
+	if _, err := os.Stat(applicationPath); err == nil {
+		workspace.ApplicationExists = true
+	}
+
+	entries, err := os.ReadDir(workspaceDir)
+	if err != nil {
+		return workspace
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		artifact := measureArtifactDir(entry.Name(), filepath.Join(workspaceDir, entry.Name()))
+		workspace.SizeBytes += artifact.SizeBytes
+		workspace.Artifacts = append(workspace.Artifacts, artifact)
+	}
+	return workspace
+}
+
+// measureArtifactDir counts the files in an artifact directory, their total size and the latest modification
+func measureArtifactDir(name, dir string) MCPWorkspaceArtifact {
+	artifact := MCPWorkspaceArtifact{Name: name, Path: dir}
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(artifact.modified) {
+			artifact.modified = info.ModTime()
+		}
+		if !d.IsDir() {
+			artifact.Files++
+			artifact.SizeBytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		artifact.Error = err.Error()
+	}
+	if !artifact.modified.IsZero() {
+		artifact.LastModified = artifact.modified.UTC().Format(time.RFC3339)
+	}
+	return artifact
+}
+
+// markArtifactsInUse marks the artifact directories holding the logs, output or records of a queued or
+// running job, which pruning would pull out from under it
+func markArtifactsInUse(workspaces []MCPArtifactWorkspace) {
+	for i := range workspaces {
+		workspace := &workspaces[i]
+		jobs, err := backgroundJobs.list(workspace.ApplicationPath)
+		if err != nil {
+			log.Printf("Warning: %v", err)
+		}
+
+		var paths []string
+		for _, job := range jobs {
+			if job.isActive() {
+				paths = append(paths, jobRecordPath(job.ApplicationPath, job.ID), job.LogFile, job.OutputDir, job.ResultFile)
+			}
+		}
+		for j := range workspace.Artifacts {
+			artifact := &workspace.Artifacts[j]
+			for _, path := range paths {
+				if path != "" && pathWithinDir(path, artifact.Path) {
+					artifact.InUse = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// pathWithinDir reports whether path is dir or inside it
+func pathWithinDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// selectArtifactsToPrune marks the artifacts matching the request, except those in use by a job. Without an
+// application path only the artifacts of applications that no longer exist are pruned, unless older_than_days is given.
+func selectArtifactsToPrune(workspaces []MCPArtifactWorkspace, req *WorkspaceArtifactsRequest, now time.Time) {
+	names := make(map[string]bool, len(req.Artifacts))
+	for _, name := range req.Artifacts {
+		names[name] = true
+	}
+	cutoff := now.AddDate(0, 0, -req.OlderThanDays)
+
+	for i := range workspaces {
+		workspace := &workspaces[i]
+		if req.ApplicationPath == "" && workspace.ApplicationExists && req.OlderThanDays == 0 {
+			continue
+		}
+		for j := range workspace.Artifacts {
+			artifact := &workspace.Artifacts[j]
+			if artifact.InUse || (len(names) > 0 && !names[artifact.Name]) {
+				continue
+			}
+			if req.OlderThanDays > 0 && artifact.modified.After(cutoff) {
+				continue
+			}
+			artifact.Prune = true
+		}
+	}
+}
+
+// pruneWorkspaceArtifacts deletes the selected artifacts, and the whole working directory
+// once nothing is left of an application that no longer exists
+func pruneWorkspaceArtifacts(workspaces []MCPArtifactWorkspace) {
+	for i := range workspaces {
+		workspace := &workspaces[i]
+		remaining := 0
+		for j := range workspace.Artifacts {
+			artifact := &workspace.Artifacts[j]
+			if !artifact.Prune {
+				remaining++
+				continue
+			}
+			if err := os.RemoveAll(artifact.Path); err != nil {
+				artifact.Error = fmt.Sprintf("failed to delete: %v", err)
+				remaining++
+				continue
+			}
+			artifact.Deleted = true
+		}
+
+		if !workspace.ApplicationExists && remaining == 0 {
+			_ = os.RemoveAll(workspace.Directory)
+		}
+	}
+}
+
+// formatArtifactSize formats a size in bytes for the summary
+func formatArtifactSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value, suffix := float64(size)/unit, "KB"
+	for _, next := range []string{"MB", "GB", "TB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
+
+// workspaceArtifactsSummary describes the response in a sentence or two
+func workspaceArtifactsSummary(response *MCPWorkspaceArtifactsResponse) string {
+	if len(response.Workspaces) == 0 {
+		return "No stored Veracode artifacts found."
+	}
+
+	var total, selectedSize int64
+	selected, deleted, failed, missing, inUse := 0, 0, 0, 0, 0
+	for _, workspace := range response.Workspaces {
+		total += workspace.SizeBytes
+		if !workspace.ApplicationExists {
+			missing++
+		}
+		for _, artifact := range workspace.Artifacts {
+			if artifact.InUse {
+				inUse++
+			}
+			if !artifact.Prune {
+				continue
+			}
+			selected++
+			selectedSize += artifact.SizeBytes
+			switch {
+			case artifact.Deleted:
+				deleted++
+			case response.Confirmed:
+				failed++
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("%d workspace(s) hold %s of Veracode artifacts.", len(response.Workspaces), formatArtifactSize(total))
+	switch {
+	case response.Action == artifactsActionList:
+		if missing > 0 {
+			summary += fmt.Sprintf("\n%d belong to application paths that no longer exist; remove them with action PRUNE.", missing)
+		}
+	case selected == 0:
+		summary += "\nNothing matches the prune criteria."
+	case response.Confirmed:
+		summary += fmt.Sprintf("\nDeleted %d artifact(s), freeing up to %s.", deleted, formatArtifactSize(selectedSize))
+		if failed > 0 {
+			summary += fmt.Sprintf(" %d could not be deleted; see their errors below.", failed)
+		}
+	default:
+		summary += fmt.Sprintf("\nNot deleted yet: %d artifact(s) (%s) marked \"prune\" below would be deleted. Ask the user to confirm, then call workspace-artifacts again with confirm set to true.", selected, formatArtifactSize(selectedSize))
+	}
+	if response.Action == artifactsActionPrune && inUse > 0 {
+		summary += fmt.Sprintf("\n%d artifact(s) marked \"in_use\" are kept while their queued or running jobs need them.", inUse)
+	}
+	return summary
+}
+
+// formatWorkspaceArtifactsResponse formats the working directories into an MCP tool response
+func formatWorkspaceArtifactsResponse(response *MCPWorkspaceArtifactsResponse) map[string]interface{} {
+	if response.Workspaces == nil {
+		response.Workspaces = []MCPArtifactWorkspace{}
+	}
+
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format workspace artifacts response: %v", err)}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": workspaceArtifactsSummary(response) + "\n\n" + string(responseJSON),
+		}},
+	}
+}
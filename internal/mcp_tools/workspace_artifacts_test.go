@@ -0,0 +1,172 @@
+package mcp_tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// artifactsWorkspace creates an application with a file in each of the given artifact directories
+func artifactsWorkspace(t *testing.T, name string, artifacts ...string) string {
+	t.Helper()
+	appPath := filepath.Join(t.TempDir(), name)
+	if err := os.MkdirAll(appPath, 0750); err != nil {
+		t.Fatal(err)
+	}
+	for _, artifact := range artifacts {
+		dir, err := createVeracodeWorkDir(appPath, artifact)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "output.json"), []byte(`{"findings": []}`), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return appPath
+}
+
+func workspaceArtifactsText(t *testing.T, args map[string]interface{}) string {
+	t.Helper()
+	result, err := handleWorkspaceArtifacts(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, ok := result.(map[string]interface{})["content"].([]map[string]interface{})
+	if !ok {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	return content[0]["text"].(string)
+}
+
+func TestParseWorkspaceArtifactsRequest(t *testing.T) {
+	req, err := parseWorkspaceArtifactsRequest(map[string]interface{}{})
+	if err != nil || req.Action != artifactsActionList {
+		t.Errorf("default request: got %+v, %v", req, err)
+	}
+
+	req, err = parseWorkspaceArtifactsRequest(map[string]interface{}{"action": "prune", "artifacts": []interface{}{"pipeline"}, "older_than_days": float64(7)})
+	if err != nil || req.Action != artifactsActionPrune || req.Artifacts[0] != "pipeline" || req.OlderThanDays != 7 {
+		t.Errorf("prune request: got %+v, %v", req, err)
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"application_path": "relative/app"},
+		{"action": "delete"},
+		{"confirm": true},
+		{"action": "prune", "older_than_days": float64(-1)},
+		{"action": "prune", "artifacts": []interface{}{"src"}},
+	} {
+		if _, err := parseWorkspaceArtifactsRequest(args); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
+
+func TestWorkspaceArtifacts_ListAll(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	artifactsWorkspace(t, "api", "packaging", "pipeline")
+	removed := artifactsWorkspace(t, "api", "sca")
+	if err := os.RemoveAll(removed); err != nil {
+		t.Fatal(err)
+	}
+
+	text := workspaceArtifactsText(t, map[string]interface{}{})
+	if !strings.Contains(text, "2 workspace(s) hold") || !strings.Contains(text, "1 belong to application paths that no longer exist") {
+		t.Errorf("unexpected listing:\n%s", text)
+	}
+}
+
+func TestWorkspaceArtifacts_PruneOrphaned(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	kept := artifactsWorkspace(t, "kept-app", "pipeline")
+	removed := artifactsWorkspace(t, "removed-app", "pipeline")
+	if err := os.RemoveAll(removed); err != nil {
+		t.Fatal(err)
+	}
+
+	// The preview deletes nothing
+	text := workspaceArtifactsText(t, map[string]interface{}{"action": "PRUNE"})
+	if !strings.Contains(text, "Not deleted yet: 1 artifact(s)") {
+		t.Errorf("unexpected preview:\n%s", text)
+	}
+	if _, err := os.Stat(veracodeWorkDir(removed, "pipeline")); err != nil {
+		t.Fatalf("the preview should not delete anything: %v", err)
+	}
+
+	text = workspaceArtifactsText(t, map[string]interface{}{"action": "PRUNE", "confirm": true})
+	if !strings.Contains(text, "Deleted 1 artifact(s)") {
+		t.Errorf("unexpected prune:\n%s", text)
+	}
+	if _, err := os.Stat(veracodeWorkspaceDir(removed)); !os.IsNotExist(err) {
+		t.Error("the working directory of the removed application should be deleted")
+	}
+	if _, err := os.Stat(veracodeWorkDir(kept, "pipeline")); err != nil {
+		t.Errorf("the artifacts of an existing application should be kept: %v", err)
+	}
+}
+
+func TestWorkspaceArtifacts_PruneApplicationArtifacts(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	appPath := artifactsWorkspace(t, "prune-app", "packaging", "pipeline")
+
+	workspaceArtifactsText(t, map[string]interface{}{"application_path": appPath, "action": "PRUNE", "artifacts": "packaging", "confirm": true})
+
+	if _, err := os.Stat(veracodeWorkDir(appPath, "packaging")); !os.IsNotExist(err) {
+		t.Error("packaging should be deleted")
+	}
+	if _, err := os.Stat(veracodeWorkDir(appPath, "pipeline")); err != nil {
+		t.Errorf("pipeline should be kept: %v", err)
+	}
+}
+
+func TestWorkspaceArtifacts_PruneKeepsArtifactsOfActiveJobs(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	appPath := artifactsWorkspace(t, "busy-app", "packaging", "pipeline")
+	runDir := filepath.Join(veracodeWorkDir(appPath, "pipeline"), "20261019-101500")
+	running := &Job{
+		ID:              "pipeline-scan-20261019-101500-a1b2",
+		Kind:            "pipeline-scan",
+		ApplicationPath: appPath,
+		State:           JobRunning,
+		PID:             os.Getpid(),
+		Executable:      filepath.Base(os.Args[0]),
+		LogFile:         filepath.Join(runDir, "pipeline-scan.log"),
+		OutputDir:       runDir,
+	}
+	if err := saveJobRecord(running); err != nil {
+		t.Fatal(err)
+	}
+
+	text := workspaceArtifactsText(t, map[string]interface{}{"application_path": appPath, "action": "PRUNE", "confirm": true})
+
+	if !strings.Contains(text, `"in_use": true`) || !strings.Contains(text, "2 artifact(s) marked \"in_use\" are kept") {
+		t.Errorf("expected the running job's artifacts to be reported as in use:\n%s", text)
+	}
+	for _, kept := range []string{"pipeline", "jobs"} {
+		if _, err := os.Stat(veracodeWorkDir(appPath, kept)); err != nil {
+			t.Errorf("%s is used by a running job and should be kept: %v", kept, err)
+		}
+	}
+	if _, err := os.Stat(veracodeWorkDir(appPath, "packaging")); !os.IsNotExist(err) {
+		t.Error("packaging isn't used by a job and should be deleted")
+	}
+}
+
+func TestSelectArtifactsToPrune_OlderThanDays(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	workspaces := []MCPArtifactWorkspace{{
+		ApplicationExists: true,
+		Artifacts: []MCPWorkspaceArtifact{
+			{Name: "pipeline", modified: now.AddDate(0, 0, -30)},
+			{Name: "sca", modified: now.AddDate(0, 0, -1)},
+		},
+	}}
+
+	selectArtifactsToPrune(workspaces, &WorkspaceArtifactsRequest{Action: artifactsActionPrune, OlderThanDays: 7}, now)
+	if !workspaces[0].Artifacts[0].Prune || workspaces[0].Artifacts[1].Prune {
+		t.Errorf("only the artifacts older than 7 days should be selected: %+v", workspaces[0].Artifacts)
+	}
+}
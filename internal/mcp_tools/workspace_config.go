@@ -0,0 +1,327 @@
+package mcp_tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+const WorkspaceConfigToolName = "workspace-config"
+
+const (
+	workspaceConfigActionView  = "VIEW"
+	workspaceConfigActionSet   = "SET"
+	workspaceConfigActionUnset = "UNSET"
+)
+
+// Auto-register this tool when the package is imported
+func init() {
+	RegisterMCPTool(WorkspaceConfigToolName, handleWorkspaceConfig)
+}
+
+// WorkspaceConfigRequest represents the parsed parameters for workspace-config
+type WorkspaceConfigRequest struct {
+	ApplicationPath string
+	Action          string
+	Field           string      // Dotted field path, e.g. pipeline_scan.timeout_minutes
+	Value           interface{} // Decoded JSON value for SET
+}
+
+// MCPWorkspaceConfigResponse describes the workspace file and what an edit changed
+type MCPWorkspaceConfigResponse struct {
+	File          string                     `json:"file"`
+	Found         bool                       `json:"found"`
+	Created       bool                       `json:"created,omitempty"`
+	Action        string                     `json:"action"`
+	Field         string                     `json:"field,omitempty"`
+	PreviousValue interface{}                `json:"previous_value,omitempty"`
+	Config        *workspace.WorkspaceConfig `json:"config,omitempty"`
+	Application   string                     `json:"application,omitempty"` // The profile the application path resolves to
+	Project       string                     `json:"project,omitempty"`     // The path pattern of the project it belongs to
+	Fields        []string                   `json:"fields,omitempty"`      // The fields that can be set
+	Warnings      []string                   `json:"warnings,omitempty"`    // Fields of the file this server ignores
+}
+
+// parseWorkspaceConfigRequest extracts and validates parameters from the raw args map
+func parseWorkspaceConfigRequest(args map[string]interface{}) (*WorkspaceConfigRequest, error) {
+	req := &WorkspaceConfigRequest{}
+
+	var err error
+	req.ApplicationPath, err = extractRequiredString(args, "application_path")
+	if err != nil {
+		return nil, err
+	}
+
+	req.Action, err = extractOptionalEnum(args, "action", []string{workspaceConfigActionView, workspaceConfigActionSet, workspaceConfigActionUnset})
+	if err != nil {
+		return nil, err
+	}
+	if req.Action == "" {
+		req.Action = workspaceConfigActionView
+	}
+
+	req.Field, _ = extractOptionalString(args, "field")
+	value, hasValue := extractOptionalString(args, "value")
+	switch req.Action {
+	case workspaceConfigActionView:
+		if req.Field != "" || hasValue {
+			return nil, fmt.Errorf("field and value can only be used with action SET or UNSET")
+		}
+		return req, nil
+	case workspaceConfigActionSet:
+		if !hasValue {
+			return nil, fmt.Errorf("value is required for action SET")
+		}
+	case workspaceConfigActionUnset:
+		if hasValue {
+			return nil, fmt.Errorf("value can only be used with action SET")
+		}
+	}
+
+	if req.Field == "" {
+		return nil, fmt.Errorf("field is required for action %s", req.Action)
+	}
+	fieldType, ok := workspaceConfigFieldTypes()[req.Field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q; the fields are: %s", req.Field, strings.Join(workspaceConfigFields(), ", "))
+	}
+	if req.Field == "version" {
+		return nil, fmt.Errorf("version is set to %d whenever the file is saved", workspace.CurrentSchemaVersion)
+	}
+	if req.Action == workspaceConfigActionSet {
+		if req.Value, err = decodeWorkspaceConfigValue(req.Field, fieldType, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+// handleWorkspaceConfig shows the nearest .veracode-workspace.json, or changes one of its fields.
+// Edits are validated before the file is replaced, so an invalid value never reaches the file.
+func handleWorkspaceConfig(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	// Parse and validate request parameters
+	req, err := parseWorkspaceConfigRequest(args)
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}, nil
+	}
+	if _, err := os.Stat(req.ApplicationPath); err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("application path does not exist: %s", req.ApplicationPath)}, nil
+	}
+
+	response := &MCPWorkspaceConfigResponse{Action: req.Action, Field: req.Field, Fields: workspaceConfigFields()}
+
+	// Step 1: Find and read the workspace file as written, without applying a project;
+	// a new file goes in the application directory
+	fields := map[string]interface{}{}
+	response.File, err = workspace.FindWorkspaceFile(req.ApplicationPath)
+	if err == nil {
+		response.Found = true
+		config, err := workspace.ReadWorkspaceFile(response.File)
+		if err != nil {
+			return map[string]interface{}{"error": err.Error()}, nil
+		}
+		response.Config = config
+		response.Warnings = config.Warnings
+		describeWorkspaceConfigProject(response, req.ApplicationPath)
+		if req.Action != workspaceConfigActionView && len(config.Warnings) > 0 {
+			// Saving rewrites the file from the known fields, which would drop the unknown ones
+			return map[string]interface{}{"error": fmt.Sprintf("%s was not changed because saving it would remove fields this server doesn't know:\n- %s\n\nFix or remove them in the file first.", response.File, strings.Join(config.Warnings, "\n- "))}, nil
+		}
+		if fields, err = workspaceConfigFieldMap(config); err != nil {
+			return map[string]interface{}{"error": err.Error()}, nil
+		}
+	} else {
+		response.File = filepath.Join(req.ApplicationPath, workspace.WorkspaceFileName)
+	}
+
+	if req.Action == workspaceConfigActionView {
+		return formatWorkspaceConfigResponse(response), nil
+	}
+
+	// Step 2: Change the field, then check the whole configuration as if it had been read from the file
+	response.PreviousValue = setWorkspaceConfigField(fields, req.Field, req.Value)
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to encode the workspace configuration: %v", err)}, nil
+	}
+	config, err := workspace.ParseWorkspaceConfig(data, response.File)
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("%s was not changed:\n\n%v", response.File, err)}, nil
+	}
+
+	// Step 3: Save it
+	if err := workspace.SaveWorkspaceConfig(config); err != nil {
+		return map[string]interface{}{"error": err.Error()}, nil
+	}
+	response.Created = !response.Found
+	response.Found = true
+	response.Config = config
+	response.Warnings = nil
+	describeWorkspaceConfigProject(response, req.ApplicationPath)
+	return formatWorkspaceConfigResponse(response), nil
+}
+
+// describeWorkspaceConfigProject records the application profile and project that applicationPath resolves to
+func describeWorkspaceConfigProject(response *MCPWorkspaceConfigResponse, applicationPath string) {
+	absPath, err := filepath.Abs(applicationPath)
+	if err != nil {
+		return
+	}
+	resolved := response.Config.ForPath(absPath)
+	response.Application = resolved.Application()
+	response.Project = resolved.Project
+}
+
+// workspaceConfigFieldMap converts a configuration into nested maps keyed by JSON field name
+func workspaceConfigFieldMap(config *workspace.WorkspaceConfig) (map[string]interface{}, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the workspace configuration: %v", err)
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode the workspace configuration: %v", err)
+	}
+	return fields, nil
+}
+
+// setWorkspaceConfigField sets a dotted field in nested maps, or removes it if value is nil,
+// and returns the previous value. Sections left empty are removed.
+func setWorkspaceConfigField(fields map[string]interface{}, field string, value interface{}) interface{} {
+	section, name, nested := strings.Cut(field, ".")
+	if !nested {
+		previous := fields[field]
+		if value == nil {
+			delete(fields, field)
+		} else {
+			fields[field] = value
+		}
+		return previous
+	}
+
+	sectionFields, _ := fields[section].(map[string]interface{})
+	if sectionFields == nil {
+		sectionFields = map[string]interface{}{}
+	}
+	previous := setWorkspaceConfigField(sectionFields, name, value)
+	if len(sectionFields) == 0 {
+		delete(fields, section)
+	} else {
+		fields[section] = sectionFields
+	}
+	return previous
+}
+
+// workspaceConfigFieldTypes maps the fields of .veracode-workspace.json, with sections as dotted paths, to their types
+func workspaceConfigFieldTypes() map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	var collect func(t reflect.Type, prefix string)
+	collect = func(t reflect.Type, prefix string) {
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			fieldType := t.Field(i).Type
+			fields[prefix+name] = fieldType
+			if fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Struct {
+				collect(fieldType.Elem(), prefix+name+".")
+			}
+		}
+	}
+	collect(reflect.TypeOf(workspace.WorkspaceConfig{}), "")
+	return fields
+}
+
+// workspaceConfigFields lists the fields of .veracode-workspace.json, with sections as dotted paths
+func workspaceConfigFields() []string {
+	var fields []string
+	for field := range workspaceConfigFieldTypes() {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// decodeWorkspaceConfigValue decodes the value of a SET by the type of the field: string fields take
+// the value as it is, so "123" or "true" stay strings, and other fields take a JSON value of their type
+func decodeWorkspaceConfigValue(field string, fieldType reflect.Type, value string) (interface{}, error) {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() == reflect.String {
+		return value, nil
+	}
+	if err := json.Unmarshal([]byte(value), reflect.New(fieldType).Interface()); err != nil {
+		return nil, fmt.Errorf("value of %s must be a JSON %s, got %q: %v", field, workspaceConfigValueKind(fieldType), value, err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return nil, fmt.Errorf("value of %s is not valid JSON: %v", field, err)
+	}
+	return decoded, nil
+}
+
+// workspaceConfigValueKind describes the JSON type of a field type
+func workspaceConfigValueKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice:
+		return "list"
+	}
+	return "object"
+}
+
+// workspaceConfigSummary describes the response in a sentence or two
+func workspaceConfigSummary(response *MCPWorkspaceConfigResponse) string {
+	switch {
+	case !response.Found:
+		return fmt.Sprintf("No %s applies to this application path. Create %s with action SET and field \"name\" (the application profile name).", workspace.WorkspaceFileName, response.File)
+	case response.Action == workspaceConfigActionView:
+		var summary string
+		switch {
+		case response.Application == "":
+			summary = fmt.Sprintf("Workspace configuration from %s. No project matches this application path, so it has no application profile.", response.File)
+		case response.Project != "":
+			summary = fmt.Sprintf("Workspace configuration from %s. This application path belongs to project %q, application profile %q.", response.File, response.Project, response.Application)
+		default:
+			summary = fmt.Sprintf("Workspace configuration of application profile %q from %s.", response.Application, response.File)
+		}
+		if response.Config.Version < workspace.CurrentSchemaVersion {
+			summary += fmt.Sprintf(" The file uses schema version %d; editing it with action SET updates it to version %d.", max(response.Config.Version, 1), workspace.CurrentSchemaVersion)
+		}
+		return summary
+	case response.Created:
+		return fmt.Sprintf("Created %s.", response.File)
+	case response.Action == workspaceConfigActionUnset:
+		return fmt.Sprintf("Removed %s from %s.", response.Field, response.File)
+	default:
+		return fmt.Sprintf("Set %s in %s.", response.Field, response.File)
+	}
+}
+
+// formatWorkspaceConfigResponse formats the workspace configuration into an MCP tool response
+func formatWorkspaceConfigResponse(response *MCPWorkspaceConfigResponse) map[string]interface{} {
+	responseJSON, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("Failed to format workspace configuration response: %v", err)}
+	}
+
+	return map[string]interface{}{
+		"content": []map[string]interface{}{{
+			"type": "text",
+			"text": workspaceConfigSummary(response) + "\n\n" + string(responseJSON),
+		}},
+	}
+}
@@ -0,0 +1,151 @@
+package mcp_tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+func workspaceConfigText(t *testing.T, args map[string]interface{}) string {
+	t.Helper()
+	result, err := handleWorkspaceConfig(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resultMap := result.(map[string]interface{})
+	if resultMap["error"] != nil {
+		t.Fatalf("unexpected error: %v", resultMap["error"])
+	}
+	return resultMap["content"].([]map[string]interface{})[0]["text"].(string)
+}
+
+func TestParseWorkspaceConfigRequest(t *testing.T) {
+	req, err := parseWorkspaceConfigRequest(map[string]interface{}{"application_path": "/work/app", "action": "set", "field": "pipeline_scan.timeout_minutes", "value": "30"})
+	if err != nil || req.Action != workspaceConfigActionSet || req.Value != float64(30) {
+		t.Errorf("unexpected request: %+v, %v", req, err)
+	}
+	req, err = parseWorkspaceConfigRequest(map[string]interface{}{"application_path": "/work/app", "action": "set", "field": "name", "value": "My App"})
+	if err != nil || req.Value != "My App" {
+		t.Errorf("expected a plain string value, got %+v, %v", req, err)
+	}
+	// String fields keep values that would decode as other JSON types
+	req, err = parseWorkspaceConfigRequest(map[string]interface{}{"application_path": "/work/app", "action": "set", "field": "sandbox", "value": "123"})
+	if err != nil || req.Value != "123" {
+		t.Errorf("expected the string \"123\", got %+v, %v", req, err)
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"action": "set", "field": "name"},
+		{"action": "set", "field": "nmae", "value": "App"},
+		{"action": "set", "field": "version", "value": "1"},
+		{"action": "set", "field": "pipeline_scan.timeout_minutes", "value": "thirty"},
+		{"action": "set", "field": "package.include", "value": "*.war"},
+		{"action": "unset"},
+		{"field": "name"},
+	} {
+		args["application_path"] = "/work/app"
+		if _, err := parseWorkspaceConfigRequest(args); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
+
+func TestWorkspaceConfigTool_CreateAndEdit(t *testing.T) {
+	appPath := t.TempDir()
+
+	text := workspaceConfigText(t, map[string]interface{}{"application_path": appPath})
+	if !strings.Contains(text, "No .veracode-workspace.json applies") {
+		t.Errorf("unexpected view:\n%s", text)
+	}
+
+	text = workspaceConfigText(t, map[string]interface{}{"application_path": appPath, "action": "SET", "field": "name", "value": "EditedApp"})
+	if !strings.Contains(text, "Created ") {
+		t.Errorf("unexpected create:\n%s", text)
+	}
+	workspaceConfigText(t, map[string]interface{}{"application_path": appPath, "action": "SET", "field": "pipeline_scan.fail_on_severity", "value": `["Very High"]`})
+
+	config, err := workspace.LoadWorkspaceConfig(appPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Name != "EditedApp" || config.Version != workspace.CurrentSchemaVersion || config.PipelineScan.FailOnSeverity[0] != "Very High" {
+		t.Errorf("unexpected configuration: %+v", config)
+	}
+
+	workspaceConfigText(t, map[string]interface{}{"application_path": appPath, "action": "UNSET", "field": "pipeline_scan.fail_on_severity"})
+	if config, _ := workspace.LoadWorkspaceConfig(appPath); config.PipelineScan != nil {
+		t.Errorf("expected the empty pipeline_scan section to be removed, got %+v", config.PipelineScan)
+	}
+}
+
+func TestWorkspaceConfigTool_RejectsInvalidValue(t *testing.T) {
+	repo := t.TempDir()
+	if err := os.WriteFile(filepath.Join(repo, workspace.WorkspaceFileName), []byte(`{"name": "RepoApp"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	subdir := filepath.Join(repo, "services", "api")
+	if err := os.MkdirAll(subdir, 0750); err != nil {
+		t.Fatal(err)
+	}
+
+	// The workspace file of the parent directory is edited, and an invalid value leaves it unchanged
+	result, _ := handleWorkspaceConfig(context.Background(), map[string]interface{}{"application_path": subdir, "action": "SET", "field": "pipeline_scan.timeout_minutes", "value": "900"})
+	errText, _ := result.(map[string]interface{})["error"].(string)
+	if !strings.Contains(errText, "was not changed") || !strings.Contains(errText, "pipeline_scan.timeout_minutes must be between 1 and 600") {
+		t.Errorf("expected a validation error, got %v", result)
+	}
+	data, err := os.ReadFile(filepath.Join(repo, workspace.WorkspaceFileName))
+	if err != nil || string(data) != `{"name": "RepoApp"}` {
+		t.Errorf("expected the file to be unchanged, got %s, %v", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(subdir, workspace.WorkspaceFileName)); !os.IsNotExist(err) {
+		t.Error("expected no workspace file in the subdirectory")
+	}
+}
+
+func TestWorkspaceConfigTool_Projects(t *testing.T) {
+	repo := writeMonorepoWorkspace(t)
+	payments := filepath.Join(repo, "services", "payments")
+
+	text := workspaceConfigText(t, map[string]interface{}{"application_path": payments})
+	if !strings.Contains(text, `belongs to project "services/payments", application profile "Payments"`) {
+		t.Errorf("unexpected view:\n%s", text)
+	}
+
+	// Edits change the file as written, not the project's settings merged into it
+	workspaceConfigText(t, map[string]interface{}{"application_path": payments, "action": "SET", "field": "sandbox", "value": "dev"})
+	config, err := workspace.ReadWorkspaceFile(filepath.Join(repo, workspace.WorkspaceFileName))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Name != "" || config.Sandbox != "dev" || len(config.Projects) != 2 {
+		t.Errorf("unexpected configuration: %+v", config)
+	}
+}
+
+func TestWorkspaceConfigTool_UnknownFields(t *testing.T) {
+	appPath := t.TempDir()
+	content := `{"name": "App", "sandbx": "dev"}`
+	if err := os.WriteFile(filepath.Join(appPath, workspace.WorkspaceFileName), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	text := workspaceConfigText(t, map[string]interface{}{"application_path": appPath})
+	if !strings.Contains(text, `unknown field \"sandbx\" is ignored`) {
+		t.Errorf("expected a warning about the unknown field, got:\n%s", text)
+	}
+
+	// An edit would drop the unknown field, so the file is left alone
+	result, _ := handleWorkspaceConfig(context.Background(), map[string]interface{}{"application_path": appPath, "action": "SET", "field": "sandbox", "value": "dev"})
+	errText, _ := result.(map[string]interface{})["error"].(string)
+	if !strings.Contains(errText, "was not changed") || !strings.Contains(errText, "sandbx") {
+		t.Errorf("expected the edit to be refused, got %v", result)
+	}
+	if data, err := os.ReadFile(filepath.Join(appPath, workspace.WorkspaceFileName)); err != nil || string(data) != content {
+		t.Errorf("expected the file to be unchanged, got %s, %v", data, err)
+	}
+}
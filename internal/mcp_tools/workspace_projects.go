@@ -0,0 +1,142 @@
+package mcp_tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+// workspaceProject is a directory of a monorepo project and the application profile it maps to
+type workspaceProject struct {
+	Pattern     string // The project's path in the workspace file
+	Directory   string
+	Application string
+}
+
+// projectGroup holds the projects of one application profile, in the order of the workspace file
+type projectGroup struct {
+	Application string
+	Projects    []workspaceProject
+}
+
+// findWorkspaceProjects lists the project directories of the workspace file that applies to applicationPath
+// and returns them grouped by application profile, with the workspace file
+func findWorkspaceProjects(applicationPath string) ([]projectGroup, string, error) {
+	workspaceFile, err := workspace.FindWorkspaceFile(applicationPath)
+	if err != nil {
+		return nil, "", err
+	}
+	config, err := workspace.ReadWorkspaceFile(workspaceFile)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(config.Projects) == 0 {
+		return nil, "", fmt.Errorf(`%s has no "projects"; all_projects needs projects that map directories to application profiles, e.g. {"projects": [{"path": "services/*", "name": "MyService"}]}`, workspaceFile)
+	}
+
+	directories, err := config.ProjectDirectories()
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", workspaceFile, err)
+	}
+
+	var groups []projectGroup
+	index := map[string]int{}
+	for _, dir := range directories {
+		project := workspaceProject{
+			Pattern:     dir.Project.Path,
+			Directory:   dir.Directory,
+			Application: config.ForPath(dir.Directory).Application(),
+		}
+		i, ok := index[project.Application]
+		if !ok {
+			i = len(groups)
+			index[project.Application] = i
+			groups = append(groups, projectGroup{Application: project.Application})
+		}
+		groups[i].Projects = append(groups[i].Projects, project)
+	}
+	if len(groups) == 0 {
+		return nil, "", fmt.Errorf("no directory matches the projects in %s", workspaceFile)
+	}
+	return groups, workspaceFile, nil
+}
+
+// runForEachProject calls handler once per project of the workspace, with application_path set to the
+// project's directory and the other arguments unchanged, and reports the results grouped by application profile
+func runForEachProject(ctx context.Context, toolName string, args map[string]interface{}, handler ToolHandler) (interface{}, error) {
+	applicationPath, err := extractRequiredString(args, "application_path")
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}, nil
+	}
+	groups, workspaceFile, err := findWorkspaceProjects(applicationPath)
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}, nil
+	}
+
+	var sections strings.Builder
+	projects, failed := 0, 0
+	for _, group := range groups {
+		fmt.Fprintf(&sections, "\n## Application profile: %s\n", group.Application)
+		for _, project := range group.Projects {
+			projectArgs := make(map[string]interface{}, len(args))
+			for key, value := range args {
+				projectArgs[key] = value
+			}
+			delete(projectArgs, "all_projects")
+			projectArgs["application_path"] = project.Directory
+
+			result, err := handler(ctx, projectArgs)
+			text, ok := toolResultText(result)
+			if err != nil {
+				text, ok = err.Error(), false
+			}
+			projects++
+			status := "✓"
+			if !ok {
+				failed++
+				status = "❌"
+			}
+			fmt.Fprintf(&sections, "\n### %s %s (project %q)\n\n%s\n", status, project.Directory, project.Pattern, strings.TrimSpace(text))
+		}
+	}
+
+	summary := fmt.Sprintf("Ran %s for %d project(s) of %s, across %d application profile(s)", toolName, projects, workspaceFile, len(groups))
+	if failed > 0 {
+		summary += fmt.Sprintf("; %d failed", failed)
+	}
+	responseText := summary + ".\n" + sections.String()
+	if failed == projects {
+		return map[string]interface{}{"error": responseText}, nil
+	}
+	return map[string]interface{}{
+		"content": []map[string]string{{
+			"type": "text",
+			"text": responseText,
+		}},
+	}, nil
+}
+
+// toolResultText returns the text of a tool result, and false if the result is an error
+func toolResultText(result interface{}) (string, bool) {
+	response, _ := result.(map[string]interface{})
+	if message, ok := response["error"]; ok {
+		return fmt.Sprint(message), false
+	}
+
+	var texts []string
+	switch content := response["content"].(type) {
+	case []map[string]string:
+		for _, block := range content {
+			texts = append(texts, block["text"])
+		}
+	case []map[string]interface{}:
+		for _, block := range content {
+			if text, ok := block["text"].(string); ok {
+				texts = append(texts, text)
+			}
+		}
+	}
+	return strings.Join(texts, "\n"), true
+}
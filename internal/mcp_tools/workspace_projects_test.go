@@ -0,0 +1,151 @@
+package mcp_tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-mcp/workspace"
+)
+
+// writeMonorepoWorkspace creates a repository with three services mapped to two application profiles
+func writeMonorepoWorkspace(t *testing.T) string {
+	repo := t.TempDir()
+	for _, dir := range []string{".git", "services/orders", "services/payments", "services/shipping"} {
+		if err := os.MkdirAll(filepath.Join(repo, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	content := `{
+  "projects": [
+    {"path": "services/*", "name": "Commerce"},
+    {"path": "services/payments", "name": "Payments"}
+  ]
+}`
+	if err := os.WriteFile(filepath.Join(repo, workspace.WorkspaceFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+func TestRunForEachProject_GroupsByApplicationProfile(t *testing.T) {
+	repo := writeMonorepoWorkspace(t)
+
+	var called []string
+	handler := func(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+		path := args["application_path"].(string)
+		called = append(called, filepath.Base(path))
+		if _, ok := args["all_projects"]; ok {
+			t.Error("Expected all_projects to be removed from the project arguments")
+		}
+		if filepath.Base(path) == "shipping" {
+			return map[string]interface{}{"error": "shipping failed"}, nil
+		}
+		return map[string]interface{}{"content": []map[string]string{{"type": "text", "text": "started " + path}}}, nil
+	}
+
+	result, err := runForEachProject(context.Background(), "test-tool", map[string]interface{}{
+		"application_path": repo,
+		"all_projects":     true,
+	}, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, ok := toolResultText(result)
+	if !ok {
+		t.Fatalf("Expected a result when only some projects fail, got: %s", text)
+	}
+
+	if strings.Join(called, ",") != "orders,shipping,payments" {
+		t.Errorf("Expected each project once, in workspace order, got %v", called)
+	}
+	for _, want := range []string{
+		"Ran test-tool for 3 project(s)",
+		"across 2 application profile(s); 1 failed",
+		"## Application profile: Commerce",
+		"## Application profile: Payments",
+		"❌ " + filepath.Join(repo, "services", "shipping") + ` (project "services/*")`,
+		"shipping failed",
+		"started " + filepath.Join(repo, "services", "payments"),
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in response, got: %s", want, text)
+		}
+	}
+	if strings.Index(text, "Commerce") > strings.Index(text, "Payments") {
+		t.Errorf("Expected application profiles in workspace order, got: %s", text)
+	}
+}
+
+func TestRunForEachProject_RequiresProjects(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, workspace.WorkspaceFileName), []byte(`{"name": "App"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, _ := runForEachProject(context.Background(), "test-tool", map[string]interface{}{"application_path": dir}, nil)
+	if text, ok := toolResultText(result); ok || !strings.Contains(text, `has no "projects"`) {
+		t.Errorf("Expected an error for a workspace file without projects, got: %s", text)
+	}
+}
+
+func TestParsePipelineScanRequest_AllProjectsRejectsFilename(t *testing.T) {
+	_, err := parsePipelineScanRequest(map[string]interface{}{
+		"application_path": t.TempDir(),
+		"all_projects":     true,
+		"filename":         "app.jar",
+	})
+	if err == nil || !strings.Contains(err.Error(), "filename can't be used with all_projects") {
+		t.Errorf("Expected filename to be rejected with all_projects, got: %v", err)
+	}
+}
+
+func TestPipelineStatus_AllProjectsAddsUpFindingsPerProfile(t *testing.T) {
+	t.Setenv(workDirEnvVar, t.TempDir())
+	repo := writeMonorepoWorkspace(t)
+
+	// orders and shipping both belong to Commerce; payments has not been scanned
+	writeResults := func(service string, severities ...int) {
+		outputDir := veracodeWorkDir(filepath.Join(repo, "services", service), "pipeline")
+		if err := os.MkdirAll(outputDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		var flaws []string
+		for i, severity := range severities {
+			flaws = append(flaws, fmt.Sprintf(`{"issue_id": %d, "cwe_id": "89", "severity": %d}`, i, severity))
+		}
+		findings := `{"findings": [` + strings.Join(flaws, ",") + `]}`
+		if err := os.WriteFile(filepath.Join(outputDir, "results-20260101-120000.json"), []byte(findings), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(outputDir, "filtered-results-20260101-120000.json"), []byte(`{"findings": [`+flaws[0]+`]}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeResults("orders", 5, 3)
+	writeResults("shipping", 4)
+
+	result, err := handlePipelineStatus(context.Background(), map[string]interface{}{
+		"application_path": repo,
+		"all_projects":     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, ok := toolResultText(result)
+	if !ok {
+		t.Fatalf("Expected a status, got error: %s", text)
+	}
+	for _, want := range []string{
+		"## Application profile: Commerce\n\n3 findings (very high: 1, high: 1, medium: 1), 2 fail the policy",
+		"## Application profile: Payments\n\n0 findings (no findings), 0 fail the policy",
+		"No results yet",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in response, got: %s", want, text)
+		}
+	}
+}
@@ -38,9 +38,9 @@ func TestLoadToolDefinitions(t *testing.T) {
 	// Get all MCP tools from the tool manager
 	mcpTools := server.toolManager.GetAllMCPTools()
 
-	// tools.json now has 13 tools: api-health, dynamic-findings, static-findings, finding-details (handles both platform and pipeline), remediation-guidance, sca-findings, package-workspace, pipeline-scan, pipeline-status, pipeline-findings, run-sca-scan, local-sca-findings, local-iac-findings
-	if len(mcpTools) != 13 {
-		t.Errorf("Expected 13 tools, got %d", len(mcpTools))
+	// tools.json now has 33 tools: api-health, applications, application-summary, dynamic-findings, static-findings, manual-findings, finding-details (handles both platform and pipeline), propose-mitigation, mitigation-queue, detailed-report, policy-compliance, remediation-guidance, cwe-info, sca-findings, sca-licenses, package-workspace, pipeline-scan, pipeline-status, pipeline-findings, create-pipeline-baseline, pipeline-diff, platform-scan, platform-scan-status, dynamic-scan, run-sca-scan, local-sca-findings, local-iac-findings, workspace-artifacts, job-status, job-list, job-cancel, job-logs, workspace-config
+	if len(mcpTools) != 33 {
+		t.Errorf("Expected 33 tools, got %d", len(mcpTools))
 	}
 
 	// Check dynamic findings tool
@@ -54,8 +54,8 @@ func TestLoadToolDefinitions(t *testing.T) {
 		t.Errorf("Expected category 'findings' or empty, got '%s'", dynamicTool.Category)
 	}
 
-	if len(dynamicTool.Params) != 8 {
-		t.Errorf("Expected 8 params for dynamic-findings, got %d", len(dynamicTool.Params))
+	if len(dynamicTool.Params) != 16 {
+		t.Errorf("Expected 16 params for dynamic-findings, got %d", len(dynamicTool.Params))
 	}
 
 	// Check that application_path is first and required
@@ -57,6 +57,11 @@ $specs = @(
         Name = "Policy API"
         File = "specs/veracode-policy.json"
         Url = "https://api.swaggerhub.com/apis/Veracode/veracode-policy_api_specification/1.0"
+    },
+    @{
+        Name = "Dynamic Analysis API"
+        File = "specs/veracode-dynamic-analysis.json"
+        Url = "https://api.swaggerhub.com/apis/Veracode/veracode-dynamic_analysis_api_specification/1.0"
     }
 )
 
@@ -93,6 +93,11 @@ $apis = @(
         Name = "policy"
         Spec = "specs/veracode-policy.json"
         Package = "policy"
+    },
+    @{
+        Name = "dynamic_analysis"
+        Spec = "specs/veracode-dynamic-analysis.json"
+        Package = "dynamic_analysis"
     }
 )
 
@@ -423,6 +428,43 @@ func (dst *FindingFindingDetails) UnmarshalJSON(data []byte) error {
     Write-Warning "Could not find $findingDetailsFile - patch skipped"
 }
 
+# Patch date-only query parameters: the API expects yyyy-MM-dd, but the generated client sends
+# time.Time values as RFC3339, which the API rejects
+function Set-DateOnlyQueryParam {
+    param([string]$File, [string]$Param, [string]$Field, [string]$Style)
+
+    if (-not (Test-Path $File)) {
+        Write-Warning "Could not find $File - $Param patch skipped"
+        return
+    }
+    $content = Get-Content -Path $File -Raw
+    $call = "parameterAddToHeaderOrQuery(localVarQueryParams, `"$Param`", r.$Field"
+    $pattern = '(?m)^([ \t]*)' + [regex]::Escape("$call, `"$Style`", `"`")")
+    $replacement = '$1// PATCHED: The API expects yyyy-MM-dd; the generated client would send RFC3339' + "`n" + '$1' + "$call.Format(`"2006-01-02`"), `"$Style`", `"`")"
+    $content = $content -replace $pattern, $replacement
+    $content | Set-Content -Path $File -Encoding UTF8 -NoNewline
+    Write-Success "Patched $Param to send yyyy-MM-dd"
+}
+
+Set-DateOnlyQueryParam -File "api/rest/generated/findings/api_application_findings_information.go" -Param "mitigated_after" -Field "mitigatedAfter" -Style "form"
+Set-DateOnlyQueryParam -File "api/rest/generated/applications/api_application_information_api.go" -Param "modified_after" -Field "modifiedAfter" -Style ""
+Set-DateOnlyQueryParam -File "api/rest/generated/applications/api_application_information_api.go" -Param "policy_compliance_checked_after" -Field "policyComplianceCheckedAfter" -Style ""
+
+# Patch the SCA license page: the API responds with HAL's _embedded and _links, but the spec names
+# them __embedded and __links, so the generated model never decodes the licenses
+$licensePageFile = "api/rest/generated/policy/model_paged_resource_of_sca_license_summary.go"
+if (Test-Path $licensePageFile) {
+    $content = Get-Content -Path $licensePageFile -Raw
+    $content = $content -replace '(?m)^([ \t]*)(Embedded \*EmbeddedScaLicenseSummary\s+)`json:"__embedded,omitempty"`', ('$1// PATCHED: The API responds with HAL''s _embedded and _links; the spec''s __embedded and __links never match' + "`n" + '$1$2`json:"_embedded,omitempty"`')
+    $content = $content -replace '`json:"__links,omitempty"`', '`json:"_links,omitempty"`'
+    $content = $content -replace 'toSerialize\["__embedded"\]', 'toSerialize["_embedded"]'
+    $content = $content -replace 'toSerialize\["__links"\]', 'toSerialize["_links"]'
+    $content | Set-Content -Path $licensePageFile -Encoding UTF8 -NoNewline
+    Write-Success "Patched PagedResourceOfScaLicenseSummary to decode _embedded"
+} else {
+    Write-Warning "Could not find $licensePageFile - patch skipped"
+}
+
 # Restore original spec files
 Write-Step "Restoring original spec files..."
 
@@ -0,0 +1,112 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ProjectDirectory is a directory matched by a project of the workspace file
+type ProjectDirectory struct {
+	Directory string
+	Project   *ProjectConfig
+}
+
+// ForPath returns the configuration that applies to path: the top-level configuration with the fields
+// of the most specific project matching path, or one of its parent directories, applied.
+// A project without a name or GUID keeps the top-level application profile, with its sandbox and policy.
+// A project with a different application profile doesn't inherit them, since they belong to the top-level one;
+// without a top-level profile, the top-level sandbox and policy are defaults for every project.
+// Path must be absolute; paths outside the workspace file's directory get the top-level configuration.
+func (c *WorkspaceConfig) ForPath(path string) *WorkspaceConfig {
+	resolved := *c
+	project := c.projectFor(path)
+	if project == nil {
+		return &resolved
+	}
+
+	resolved.Project = project.Path
+	if project.Name != "" || project.ApplicationGUID != "" {
+		resolved.Name, resolved.ApplicationGUID = project.Name, project.ApplicationGUID
+		if c.Application() != "" && resolved.Application() != c.Application() {
+			resolved.Sandbox, resolved.Policy = "", ""
+		}
+	}
+	if project.Sandbox != "" {
+		resolved.Sandbox = project.Sandbox
+	}
+	if project.Policy != "" {
+		resolved.Policy = project.Policy
+	}
+	if project.PipelineScan != nil {
+		resolved.PipelineScan = project.PipelineScan
+	}
+	if project.Package != nil {
+		resolved.Package = project.Package
+	}
+	if project.LocalSCA != nil {
+		resolved.LocalSCA = project.LocalSCA
+	}
+	return &resolved
+}
+
+// projectFor returns the project whose path matches the most directories of path. Between patterns of the same
+// depth, the one with more literal directory names wins, then the first in the file.
+func (c *WorkspaceConfig) projectFor(path string) *ProjectConfig {
+	rel, err := filepath.Rel(c.Dir(), path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil
+	}
+	segments := strings.Split(filepath.ToSlash(rel), "/")
+
+	var best *ProjectConfig
+	bestDepth, bestLiterals := 0, 0
+	for i := range c.Projects {
+		pattern := strings.Split(strings.Trim(c.Projects[i].Path, "/"), "/")
+		if len(pattern) > len(segments) {
+			continue
+		}
+		literals := 0
+		matched := true
+		for j, part := range pattern {
+			if ok, _ := filepath.Match(part, segments[j]); !ok {
+				matched = false
+				break
+			}
+			if !strings.ContainsAny(part, `*?[`) {
+				literals++
+			}
+		}
+		if !matched || len(pattern) < bestDepth || (len(pattern) == bestDepth && literals <= bestLiterals) {
+			continue
+		}
+		best, bestDepth, bestLiterals = &c.Projects[i], len(pattern), literals
+	}
+	return best
+}
+
+// ProjectDirectories lists the existing directories matched by each project, in the order of the file.
+// A directory matched by several projects is listed once, for the project that applies to it.
+// It returns an error if a project's path is not a valid pattern.
+func (c *WorkspaceConfig) ProjectDirectories() ([]ProjectDirectory, error) {
+	var directories []ProjectDirectory
+	seen := map[string]bool{}
+	for i := range c.Projects {
+		matches, err := filepath.Glob(filepath.Join(c.Dir(), filepath.FromSlash(strings.Trim(c.Projects[i].Path, "/"))))
+		if err != nil {
+			return nil, fmt.Errorf("projects[%d].path %q is not a valid pattern: %w", i, c.Projects[i].Path, err)
+		}
+		for _, match := range matches {
+			if info, err := os.Stat(match); err != nil || !info.IsDir() || seen[match] {
+				continue
+			}
+			if c.projectFor(match) != &c.Projects[i] {
+				continue
+			}
+			seen[match] = true
+			directories = append(directories, ProjectDirectory{Directory: match, Project: &c.Projects[i]})
+		}
+	}
+	return directories, nil
+}
@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const monorepoWorkspace = `{
+  "version": 2,
+  "sandbox": "dev",
+  "pipeline_scan": {"fail_on_severity": ["Very High"]},
+  "projects": [
+    {"path": "services/*", "name": "Service-Default"},
+    {"path": "services/payments", "name": "Payments", "sandbox": "payments-dev", "pipeline_scan": {"timeout_minutes": 30}},
+    {"path": "web", "application_guid": "2f8c6a5e-1b3d-4c7e-9a0f-5d6e7f8a9b0c"}
+  ]
+}`
+
+// writeMonorepo creates a repository with the monorepo workspace file and a few project directories
+func writeMonorepo(t *testing.T) string {
+	repo := t.TempDir()
+	for _, dir := range []string{".git", "services/payments/src", "services/orders", "web", "tools"} {
+		if err := os.MkdirAll(filepath.Join(repo, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(repo, "services", "orders", "main.go"), []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, WorkspaceFileName), []byte(monorepoWorkspace), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+func TestLoadWorkspaceConfig_Projects(t *testing.T) {
+	repo := writeMonorepo(t)
+
+	tests := []struct {
+		path        string
+		application string
+		sandbox     string
+		project     string
+	}{
+		// The most specific project wins, and applies to the directories below it
+		{"services/payments/src", "Payments", "payments-dev", "services/payments"},
+		{"services/orders", "Service-Default", "dev", "services/*"},
+		// A file resolves to the project of its directory
+		{"services/orders/main.go", "Service-Default", "dev", "services/*"},
+		{"web", "2f8c6a5e-1b3d-4c7e-9a0f-5d6e7f8a9b0c", "dev", "web"},
+	}
+	for _, tt := range tests {
+		config, err := LoadWorkspaceConfig(filepath.Join(repo, tt.path))
+		if err != nil {
+			t.Errorf("%s: expected no error, got: %v", tt.path, err)
+			continue
+		}
+		if config.Application() != tt.application || config.Sandbox != tt.sandbox || config.Project != tt.project {
+			t.Errorf("%s: expected %s/%s from project %s, got %s/%s from project %q", tt.path, tt.application, tt.sandbox, tt.project, config.Application(), config.Sandbox, config.Project)
+		}
+	}
+
+	// A project's section replaces the top-level one
+	config, err := LoadWorkspaceConfig(filepath.Join(repo, "services", "payments"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.PipelineScan.TimeoutMinutes != 30 || len(config.PipelineScan.FailOnSeverity) != 0 {
+		t.Errorf("Expected the project's pipeline_scan section, got %+v", config.PipelineScan)
+	}
+
+	// Without a top-level name, a directory outside every project has no application profile
+	_, err = LoadWorkspaceConfig(filepath.Join(repo, "tools"))
+	if err == nil || !strings.Contains(err.Error(), "no project in") {
+		t.Errorf("Expected a no matching project error, got: %v", err)
+	}
+}
+
+func TestWorkspaceConfig_ProjectDirectories(t *testing.T) {
+	repo := writeMonorepo(t)
+	config, err := ReadWorkspaceFile(filepath.Join(repo, WorkspaceFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directories, err := config.ProjectDirectories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, dir := range directories {
+		rel, _ := filepath.Rel(repo, dir.Directory)
+		got = append(got, filepath.ToSlash(rel)+"="+dir.Project.Path)
+	}
+	// services/payments is listed once, for its own project
+	want := "services/orders=services/*,services/payments=services/payments,web=web"
+	if strings.Join(got, ",") != want {
+		t.Errorf("Expected %s, got %s", want, strings.Join(got, ","))
+	}
+}
+
+func TestWorkspaceConfig_ProjectDirectories_BadPattern(t *testing.T) {
+	config := &WorkspaceConfig{Path: filepath.Join(t.TempDir(), WorkspaceFileName), Projects: []ProjectConfig{{Path: "services/[", Name: "Broken"}}}
+	if _, err := config.ProjectDirectories(); err == nil || !strings.Contains(err.Error(), `projects[0].path "services/["`) {
+		t.Errorf("Expected an invalid pattern error, got: %v", err)
+	}
+}
+
+func TestParseWorkspaceConfig_ProjectErrors(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{`{"projects": [{"path": "api"}]}`, "projects[0].name or projects[0].application_guid is required"},
+		{`{"name": "App", "projects": [{"path": "../other", "name": "Other"}]}`, "projects[0].path must be a directory pattern"},
+		{`{"name": "App", "projects": [{"path": "", "name": "Other"}]}`, "projects[0].path must be a directory pattern"},
+		{`{"name": "App", "projects": [{"path": "api", "pipeline_scan": {"timeout_minutes": 9999}}]}`, "projects[0].pipeline_scan.timeout_minutes must be between 1 and 600"},
+		{`{"projects": []}`, `missing or empty "name"`},
+	}
+	for _, tt := range tests {
+		_, err := ParseWorkspaceConfig([]byte(tt.content), WorkspaceFileName)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: expected an error containing %q, got: %v", tt.content, tt.want, err)
+		}
+	}
+
+	// Projects without their own profile inherit the top-level one
+	config, err := ParseWorkspaceConfig([]byte(`{"name": "App", "projects": [{"path": "api", "sandbox": "api-dev"}]}`), WorkspaceFileName)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if resolved := config.ForPath(filepath.Join(config.Dir(), "api")); resolved.Application() != "App" || resolved.Sandbox != "api-dev" {
+		t.Errorf("Expected App/api-dev, got %s/%s", resolved.Application(), resolved.Sandbox)
+	}
+}
+
+func TestForPath_OtherApplicationDoesNotInheritSandboxOrPolicy(t *testing.T) {
+	content := `{"name": "App", "sandbox": "app-dev", "policy": "App Policy", "projects": [
+		{"path": "api", "sandbox": "api-dev"},
+		{"path": "billing", "name": "Billing"},
+		{"path": "admin", "name": "App"}
+	]}`
+	config, err := ParseWorkspaceConfig([]byte(content), WorkspaceFileName)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		dir, application, sandbox, policy string
+	}{
+		{"api", "App", "api-dev", "App Policy"},
+		// The top-level sandbox and policy belong to App, not Billing
+		{"billing", "Billing", "", ""},
+		{"admin", "App", "app-dev", "App Policy"},
+	}
+	for _, tt := range tests {
+		resolved := config.ForPath(filepath.Join(config.Dir(), tt.dir))
+		if resolved.Application() != tt.application || resolved.Sandbox != tt.sandbox || resolved.Policy != tt.policy {
+			t.Errorf("%s: expected %s/%q/%q, got %s/%q/%q", tt.dir, tt.application, tt.sandbox, tt.policy, resolved.Application(), resolved.Sandbox, resolved.Policy)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package workspace
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// PipelineScanSeverities are the severity names the Pipeline Scanner accepts for --fail-on-severity
+var PipelineScanSeverities = []string{"Very High", "High", "Medium", "Low", "Very Low", "Informational"}
+
+// MaxPipelineScanTimeoutMinutes bounds the pipeline scan timeout; the scanner's own default is 60 minutes
+const MaxPipelineScanTimeoutMinutes = 600
+
+var (
+	guidPattern              = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	credentialProfilePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	cvePattern               = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+)
+
+// Validate checks the values of the configuration and returns a problem per invalid field, naming the field.
+// It doesn't check that the file has a name, GUID or projects; ParseWorkspaceConfig and SaveWorkspaceConfig do.
+func (c *WorkspaceConfig) Validate() []string {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if c.Version < 0 || c.Version > CurrentSchemaVersion {
+		add("version %d is not supported; this server reads versions 1 to %d, so update the server or lower the version", c.Version, CurrentSchemaVersion)
+	}
+	if c.Name != "" && strings.TrimSpace(c.Name) != c.Name {
+		add("name must not start or end with spaces, got %q", c.Name)
+	}
+	if c.CredentialProfile != "" && !credentialProfilePattern.MatchString(c.CredentialProfile) {
+		add("credential_profile must contain only letters, digits, '.', '_' and '-', got %q", c.CredentialProfile)
+	}
+	validateProfile("", ProjectConfig{
+		Name:            c.Name,
+		ApplicationGUID: c.ApplicationGUID,
+		Sandbox:         c.Sandbox,
+		Policy:          c.Policy,
+		PipelineScan:    c.PipelineScan,
+		Package:         c.Package,
+		LocalSCA:        c.LocalSCA,
+	}, add)
+
+	hasApplication := c.Name != "" || c.ApplicationGUID != ""
+	for i, project := range c.Projects {
+		prefix := fmt.Sprintf("projects[%d].", i)
+		if !isProjectPathPattern(project.Path) {
+			add("%spath must be a directory pattern relative to the workspace file such as \"services/*\", got %q", prefix, project.Path)
+		}
+		if !hasApplication && project.Name == "" && project.ApplicationGUID == "" {
+			add("%sname or %sapplication_guid is required because the file has no top-level name", prefix, prefix)
+		}
+		if project.Name != "" && strings.TrimSpace(project.Name) != project.Name {
+			add("%sname must not start or end with spaces, got %q", prefix, project.Name)
+		}
+		validateProfile(prefix, project, add)
+	}
+	return problems
+}
+
+// validateProfile checks the application and scan settings that a project can set, naming fields with prefix
+func validateProfile(prefix string, p ProjectConfig, add func(format string, args ...interface{})) {
+	if p.ApplicationGUID != "" && !guidPattern.MatchString(p.ApplicationGUID) {
+		add("%sapplication_guid must be a GUID like 2f8c6a5e-1b3d-4c7e-9a0f-5d6e7f8a9b0c, got %q", prefix, p.ApplicationGUID)
+	}
+	if p.Sandbox != "" && strings.TrimSpace(p.Sandbox) == "" {
+		add("%ssandbox must not be blank", prefix)
+	}
+	if p.Policy != "" && strings.TrimSpace(p.Policy) == "" {
+		add("%spolicy must not be blank", prefix)
+	}
+
+	if scan := p.PipelineScan; scan != nil {
+		for _, severity := range scan.FailOnSeverity {
+			if !isPipelineSeverity(severity) {
+				add("%spipeline_scan.fail_on_severity must contain %s, got %q", prefix, strings.Join(PipelineScanSeverities, ", "), severity)
+			}
+		}
+		for _, cwe := range scan.FailOnCWE {
+			if cwe <= 0 {
+				add("%spipeline_scan.fail_on_cwe must contain positive CWE IDs, got %d", prefix, cwe)
+			}
+		}
+		if scan.TimeoutMinutes < 0 || scan.TimeoutMinutes > MaxPipelineScanTimeoutMinutes {
+			add("%spipeline_scan.timeout_minutes must be between 1 and %d, got %d", prefix, MaxPipelineScanTimeoutMinutes, scan.TimeoutMinutes)
+		}
+		for _, module := range scan.IncludeModules {
+			// The scanner takes the patterns as one comma-separated list
+			if strings.TrimSpace(module) == "" || strings.Contains(module, ",") {
+				add("%spipeline_scan.include_modules patterns must be non-empty and must not contain commas, got %q", prefix, module)
+			}
+		}
+		if scan.PolicyName != "" && scan.PolicyFile != "" {
+			add("%spipeline_scan can set policy_name or policy_file, not both", prefix)
+		}
+	}
+
+	if pkg := p.Package; pkg != nil {
+		for _, pattern := range pkg.Include {
+			if !isFileNamePattern(pattern) {
+				add("%spackage.include must contain file name patterns such as \"*.war\", got %q", prefix, pattern)
+			}
+		}
+		for _, pattern := range pkg.Exclude {
+			if !isFileNamePattern(pattern) {
+				add("%spackage.exclude must contain file name patterns such as \"*-tests.jar\", got %q", prefix, pattern)
+			}
+		}
+	}
+
+	if sca := p.LocalSCA; sca != nil {
+		if sca.SeverityGTE < 0 || sca.SeverityGTE > 5 {
+			add("%slocal_sca.severity_gte must be between 0 and 5, got %d", prefix, sca.SeverityGTE)
+		}
+		for _, cve := range sca.IgnoreCVEs {
+			if !cvePattern.MatchString(cve) {
+				add("%slocal_sca.ignore_cves must contain CVE IDs such as CVE-2024-1234, got %q", prefix, cve)
+			}
+		}
+	}
+}
+
+// isProjectPathPattern reports whether pattern is a valid relative directory pattern, without ".." segments
+func isProjectPathPattern(pattern string) bool {
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" || strings.Contains(pattern, `\`) || filepath.IsAbs(pattern) {
+		return false
+	}
+	for _, segment := range strings.Split(trimmed, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+		if _, err := filepath.Match(segment, ""); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// isPipelineSeverity reports whether name is a Pipeline Scanner severity, ignoring case
+func isPipelineSeverity(name string) bool {
+	for _, severity := range PipelineScanSeverities {
+		if strings.EqualFold(strings.ReplaceAll(name, "_", " "), severity) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFileNamePattern reports whether pattern is a valid filepath.Match pattern for a file name, without directories
+func isFileNamePattern(pattern string) bool {
+	if pattern == "" || strings.ContainsAny(pattern, `/\`) {
+		return false
+	}
+	_, err := filepath.Match(pattern, "")
+	return err == nil
+}
@@ -1,28 +1,139 @@
 package workspace
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 )
 
 const WorkspaceFileName = ".veracode-workspace.json"
 
+// CurrentSchemaVersion is the schema version written to new and edited workspace files.
+// Files without a version are version 1, which only had name and sandbox.
+const CurrentSchemaVersion = 2
+
 // WorkspaceConfig represents the structure of .veracode-workspace.json
 type WorkspaceConfig struct {
-	Name string `json:"name"`
+	Version           int                 `json:"version,omitempty"`
+	Name              string              `json:"name,omitempty"`               // Application profile name
+	ApplicationGUID   string              `json:"application_guid,omitempty"`   // Used instead of looking the profile up by name
+	Sandbox           string              `json:"sandbox,omitempty"`            // Default sandbox name for platform tools; empty means the policy scan
+	CredentialProfile string              `json:"credential_profile,omitempty"` // Profile of ~/.veracode/veracode.yml the repository's API calls use
+	Policy            string              `json:"policy,omitempty"`             // Policy for pipeline scans instead of the profile's first policy
+	PipelineScan      *PipelineScanConfig `json:"pipeline_scan,omitempty"`      // Default pipeline-scan options for the repository
+	Package           *PackageConfig      `json:"package,omitempty"`            // Which packaged artifacts to scan
+	LocalSCA          *LocalSCAConfig     `json:"local_sca,omitempty"`          // Defaults for local SCA results
+	Projects          []ProjectConfig     `json:"projects,omitempty"`           // Monorepo projects with their own application profiles
+
+	// Path is the workspace file the configuration was read from
+	Path string `json:"-"`
+	// Project is the path pattern of the project LoadWorkspaceConfig applied, if any
+	Project string `json:"-"`
+	// Warnings lists fields of the file that this server doesn't know and ignores
+	Warnings []string `json:"-"`
+}
+
+// ProjectConfig maps the directories matching Path to their own application profile and defaults.
+// Its fields replace the top-level ones; a section such as pipeline_scan replaces the top-level section.
+type ProjectConfig struct {
+	Path              string              `json:"path"` // Directory pattern relative to the workspace file, e.g. "services/*"
+	Name              string              `json:"name,omitempty"`
+	ApplicationGUID   string              `json:"application_guid,omitempty"`
+	Sandbox           string              `json:"sandbox,omitempty"`
+	CredentialProfile string              `json:"credential_profile,omitempty"` // Profile of ~/.veracode/veracode.yml the repository's API calls use
+	Policy            string              `json:"policy,omitempty"`
+	PipelineScan      *PipelineScanConfig `json:"pipeline_scan,omitempty"`
+	Package           *PackageConfig      `json:"package,omitempty"`
+	LocalSCA          *LocalSCAConfig     `json:"local_sca,omitempty"`
+}
+
+// PipelineScanConfig holds default Pipeline Scanner options; arguments passed to pipeline-scan take precedence
+type PipelineScanConfig struct {
+	FailOnSeverity []string `json:"fail_on_severity,omitempty"` // e.g. "Very High", "High"
+	FailOnCWE      []int    `json:"fail_on_cwe,omitempty"`
+	TimeoutMinutes int      `json:"timeout_minutes,omitempty"`
+	IncludeModules []string `json:"include_modules,omitempty"` // Module name patterns, e.g. "app*.jar"
+	PolicyName     string   `json:"policy_name,omitempty"`
+	PolicyFile     string   `json:"policy_file,omitempty"` // Relative to the workspace directory
+	Filename       string   `json:"filename,omitempty"`    // Scan target instead of the largest packaged file
+	SummaryOnly    bool     `json:"summary_only,omitempty"`
+}
+
+// PackageConfig selects the packaged artifacts that pipeline and platform scans use, by file name pattern
+type PackageConfig struct {
+	Include []string `json:"include,omitempty"` // Only artifacts matching one of these, e.g. "*.war"
+	Exclude []string `json:"exclude,omitempty"` // Never artifacts matching one of these, e.g. "*-tests.jar"
+}
+
+// LocalSCAConfig holds defaults for local-sca-findings; arguments take precedence
+type LocalSCAConfig struct {
+	SeverityGTE int      `json:"severity_gte,omitempty"` // Minimum severity (2=Low ... 5=Critical)
+	IgnoreCVEs  []string `json:"ignore_cves,omitempty"`  // Vulnerabilities the team has accepted
+}
+
+// Application returns the application GUID if one is configured, otherwise the profile name.
+// Tools that resolve applications accept either.
+func (c *WorkspaceConfig) Application() string {
+	if c.ApplicationGUID != "" {
+		return c.ApplicationGUID
+	}
+	return c.Name
+}
+
+// Dir returns the directory of the workspace file; relative paths in the configuration are relative to it
+func (c *WorkspaceConfig) Dir() string {
+	return filepath.Dir(c.Path)
+}
+
+// Selects reports whether a packaged artifact is scanned. A nil configuration selects every artifact.
+func (p *PackageConfig) Selects(filename string) bool {
+	if p == nil {
+		return true
+	}
+	name := filepath.Base(filename)
+	for _, pattern := range p.Exclude {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return false
+		}
+	}
+	if len(p.Include) == 0 {
+		return true
+	}
+	for _, pattern := range p.Include {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
 }
 
-// FindWorkspaceConfig searches for .veracode-workspace.json in the given directory
-// and returns the application profile name.
+// FindWorkspaceConfig searches for .veracode-workspace.json in the given directory and its parents
+// and returns the application profile name (or the application GUID if the file has no name).
 //
 // Returns an error with guidance if:
 // - The file doesn't exist
 // - The file cannot be read
 // - The JSON is invalid
-// - The name field is missing or empty
+// - The configuration is invalid, e.g. it has no name
 func FindWorkspaceConfig(directory string) (string, error) {
+	config, err := LoadWorkspaceConfig(directory)
+	if err != nil {
+		return "", err
+	}
+	return config.Application(), nil
+}
+
+// FindWorkspaceFile returns the nearest .veracode-workspace.json, searching from the given directory
+// (or the directory of the given file) up to the repository root (the first directory containing .git),
+// or the filesystem root outside a repository.
+func FindWorkspaceFile(directory string) (string, error) {
 	// Ensure directory path is absolute
 	absDir, err := filepath.Abs(directory)
 	if err != nil {
@@ -30,22 +141,38 @@ func FindWorkspaceConfig(directory string) (string, error) {
 	}
 
 	// Check if directory exists
-	if _, statErr := os.Stat(absDir); os.IsNotExist(statErr) {
+	info, statErr := os.Stat(absDir)
+	if os.IsNotExist(statErr) {
 		return "", fmt.Errorf("directory does not exist: %s", absDir)
 	}
+	if statErr == nil && !info.IsDir() {
+		absDir = filepath.Dir(absDir)
+	}
 
-	// Construct the workspace file path
-	workspaceFile := filepath.Join(absDir, WorkspaceFileName)
+	for dir := absDir; ; dir = filepath.Dir(dir) {
+		workspaceFile := filepath.Join(dir, WorkspaceFileName)
+		if _, statErr := os.Stat(workspaceFile); statErr == nil {
+			return workspaceFile, nil
+		}
+		if _, statErr := os.Stat(filepath.Join(dir, ".git")); statErr == nil || filepath.Dir(dir) == dir {
+			return "", workspaceNotFoundError(absDir, dir)
+		}
+	}
+}
 
-	// Check if workspace file exists
-	if _, statErr := os.Stat(workspaceFile); os.IsNotExist(statErr) {
-		return "", fmt.Errorf(`workspace configuration not found
+// workspaceNotFoundError explains how to create a workspace file after searching from absDir up to searchRoot
+func workspaceNotFoundError(absDir, searchRoot string) error {
+	searched := fmt.Sprintf("The directory '%s' does not contain a %s file.", absDir, WorkspaceFileName)
+	if searchRoot != absDir {
+		searched = fmt.Sprintf("No %s file was found in '%s' or its parent directories up to '%s'.", WorkspaceFileName, absDir, searchRoot)
+	}
+	return fmt.Errorf(`workspace configuration not found
 
-The directory '%s' does not contain a %s file.
+%s
 
 To create a workspace configuration:
 
-1. Create a file named '%s' in your project directory
+1. Create a file named '%s' in your project directory (or the repository root)
 2. Add the following JSON content:
 
 {
@@ -59,20 +186,61 @@ Example:
   "name": "MyApp-Production"
 }
 
-Note: The application profile name must match exactly as it appears in Veracode Platform.`,
-			absDir, WorkspaceFileName, WorkspaceFileName)
+Note: The application profile name must match exactly as it appears in Veracode Platform.
+The workspace-config tool can create and edit the file.`,
+		searched, WorkspaceFileName)
+}
+
+// LoadWorkspaceConfig reads the nearest .veracode-workspace.json, searching from the given directory
+// up to the repository root, and applies the project that the directory (or file) belongs to.
+// It returns the same errors as FindWorkspaceConfig.
+func LoadWorkspaceConfig(directory string) (*WorkspaceConfig, error) {
+	workspaceFile, err := FindWorkspaceFile(directory)
+	if err != nil {
+		return nil, err
+	}
+	config, err := ReadWorkspaceFile(workspaceFile)
+	if err != nil {
+		return nil, err
+	}
+
+	absPath, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve directory path: %w", err)
+	}
+	resolved := config.ForPath(absPath)
+	if resolved.Application() == "" {
+		return nil, fmt.Errorf(`no project in %s matches '%s'
+
+The file has no top-level "name", so every directory must belong to one of its projects:
+
+{
+  "projects": [
+    { "path": "services/api", "name": "YourApplicationProfileName" }
+  ]
+}
+
+Add a project whose "path" matches this directory, or a top-level "name".`, workspaceFile, absPath)
 	}
+	return resolved, nil
+}
 
-	// Read the workspace file
+// ReadWorkspaceFile reads and validates a workspace file as it is, without applying a project
+func ReadWorkspaceFile(workspaceFile string) (*WorkspaceConfig, error) {
 	data, err := os.ReadFile(workspaceFile) // nolint:gosec // Intentional workspace config file read
 	if err != nil {
-		return "", fmt.Errorf("failed to read %s: %w\n\nEnsure the file has read permissions.", WorkspaceFileName, err)
+		return nil, fmt.Errorf("failed to read %s: %w\n\nEnsure the file has read permissions.", WorkspaceFileName, err)
 	}
+	return ParseWorkspaceConfig(data, workspaceFile)
+}
+
+// ParseWorkspaceConfig parses and validates the contents of the workspace file at path
+func ParseWorkspaceConfig(data []byte, path string) (*WorkspaceConfig, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
 
-	// Parse JSON
 	var config WorkspaceConfig
-	if err := json.Unmarshal(data, &config); err != nil {
-		return "", fmt.Errorf(`invalid JSON in %s: %w
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf(`invalid JSON in %s: %s
 
 The workspace configuration file must be valid JSON with this format:
 
@@ -80,27 +248,166 @@ The workspace configuration file must be valid JSON with this format:
   "name": "YourApplicationProfileName"
 }
 
-Current file location: %s`, WorkspaceFileName, err, workspaceFile)
+Current file location: %s`, WorkspaceFileName, describeDecodeError(err, data), path)
 	}
+	config.Path = path
 
-	// Validate that name is not empty
-	if config.Name == "" {
-		return "", fmt.Errorf(`missing or empty "name" field in %s
+	// Unknown fields are ignored rather than rejected, so a file written for a newer server still loads
+	for _, field := range unknownFields(data, reflect.TypeOf(config), "") {
+		config.Warnings = append(config.Warnings, fmt.Sprintf("unknown field %q is ignored; check its spelling against the documented fields", field))
+	}
+	for _, warning := range config.Warnings {
+		log.Printf("%s: %s", path, warning)
+	}
 
-The workspace configuration must include an application profile name:
+	if config.Name == "" && config.ApplicationGUID == "" && len(config.Projects) == 0 {
+		return nil, fmt.Errorf(`missing or empty "name" field in %s
+
+The workspace configuration must include an application profile name (or "application_guid"):
 
 {
   "name": "YourApplicationProfileName"
 }
 
-Current file location: %s`, WorkspaceFileName, workspaceFile)
+A monorepo can instead map its directories to application profiles with "projects".
+
+Current file location: %s`, WorkspaceFileName, path)
+	}
+
+	if problems := config.Validate(); len(problems) > 0 {
+		return nil, fmt.Errorf("invalid %s:\n- %s\n\nCurrent file location: %s", WorkspaceFileName, strings.Join(problems, "\n- "), path)
+	}
+	return &config, nil
+}
+
+// describeDecodeError names the line, and the field where possible, of a JSON decoding error
+func describeDecodeError(err error, data []byte) string {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return fmt.Sprintf("line %d: %v", lineAt(data, syntaxErr.Offset), err)
+	case errors.As(err, &typeErr):
+		return fmt.Sprintf("line %d: %q must be a %s, got a JSON %s", lineAt(data, typeErr.Offset), typeErr.Field, jsonTypeName(typeErr.Type.Kind().String()), typeErr.Value)
+	}
+	return err.Error()
+}
+
+// unknownFields returns the dotted paths of the object keys in data that no JSON field of t matches.
+// Like encoding/json, keys match field names case-insensitively.
+func unknownFields(data []byte, t reflect.Type, prefix string) []string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return nil
+		}
+		var unknown []string
+		for i, item := range items {
+			unknown = append(unknown, unknownFields(item, t.Elem(), fmt.Sprintf("%s[%d]", strings.TrimSuffix(prefix, "."), i)+".")...)
+		}
+		return unknown
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	var object map[string]json.RawMessage
+	if json.Unmarshal(data, &object) != nil {
+		return nil
+	}
+	var unknown []string
+	for key, value := range object {
+		field, ok := jsonField(t, key)
+		if !ok {
+			unknown = append(unknown, prefix+key)
+			continue
+		}
+		unknown = append(unknown, unknownFields(value, field.Type, prefix+key+".")...)
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// jsonField returns the field of struct type t that decodes the JSON key
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" && strings.EqualFold(name, key) {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// lineAt returns the 1-based line of a byte offset
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
 	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
 
-	return config.Name, nil
+// jsonTypeName describes a Go kind as a JSON type
+func jsonTypeName(kind string) string {
+	switch kind {
+	case "int", "int32", "int64", "float64":
+		return "number"
+	case "slice":
+		return "list"
+	case "ptr", "struct":
+		return "object"
+	case "bool":
+		return "boolean"
+	}
+	return kind
+}
+
+// SaveWorkspaceConfig validates config and writes it to its Path with the current schema version.
+// The file is replaced atomically, so a failed write leaves the previous configuration in place.
+func SaveWorkspaceConfig(config *WorkspaceConfig) error {
+	if config.Path == "" {
+		return fmt.Errorf("the workspace configuration has no file path")
+	}
+	config.Version = CurrentSchemaVersion
+	if config.Name == "" && config.ApplicationGUID == "" && len(config.Projects) == 0 {
+		return fmt.Errorf(`the workspace configuration needs a "name", an "application_guid" or "projects"`)
+	}
+	if problems := config.Validate(); len(problems) > 0 {
+		return fmt.Errorf("invalid %s:\n- %s", WorkspaceFileName, strings.Join(problems, "\n- "))
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", WorkspaceFileName, err)
+	}
+	temp, err := os.CreateTemp(config.Dir(), WorkspaceFileName+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", WorkspaceFileName, err)
+	}
+	defer os.Remove(temp.Name())
+	if _, err := temp.Write(append(data, '\n')); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to write %s: %w", WorkspaceFileName, err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", WorkspaceFileName, err)
+	}
+	// #nosec G302 -- the workspace file is meant to be committed and read by the team
+	if err := os.Chmod(temp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", WorkspaceFileName, err)
+	}
+	if err := os.Rename(temp.Name(), config.Path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", WorkspaceFileName, err)
+	}
+	return nil
 }
 
 // FindWorkspaceConfigInCurrentDir is a convenience function that searches for
-// .veracode-workspace.json in the current working directory
+// .veracode-workspace.json from the current working directory
 func FindWorkspaceConfigInCurrentDir() (string, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
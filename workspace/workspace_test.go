@@ -1,6 +1,7 @@
 package workspace
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -273,3 +274,175 @@ func TestFindWorkspaceConfigInCurrentDir(t *testing.T) {
 		t.Errorf("Expected name 'CurrentDirTest', got '%s'", name)
 	}
 }
+
+func TestLoadWorkspaceConfig_WithSandbox(t *testing.T) {
+	tempDir := t.TempDir()
+
+	workspaceFile := filepath.Join(tempDir, WorkspaceFileName)
+	content := `{
+  "name": "SandboxApp",
+  "sandbox": "feature-login"
+}`
+	if err := os.WriteFile(workspaceFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test workspace file: %v", err)
+	}
+
+	config, err := LoadWorkspaceConfig(tempDir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if config.Name != "SandboxApp" {
+		t.Errorf("Expected name 'SandboxApp', got '%s'", config.Name)
+	}
+	if config.Sandbox != "feature-login" {
+		t.Errorf("Expected sandbox 'feature-login', got '%s'", config.Sandbox)
+	}
+}
+
+func TestLoadWorkspaceConfig_WithPipelineScan(t *testing.T) {
+	tempDir := t.TempDir()
+
+	workspaceFile := filepath.Join(tempDir, WorkspaceFileName)
+	content := `{
+  "name": "PipelineApp",
+  "pipeline_scan": {
+    "fail_on_severity": ["Very High", "High"],
+    "fail_on_cwe": [89],
+    "timeout_minutes": 30
+  }
+}`
+	if err := os.WriteFile(workspaceFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test workspace file: %v", err)
+	}
+
+	config, err := LoadWorkspaceConfig(tempDir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if config.PipelineScan == nil {
+		t.Fatal("Expected pipeline_scan defaults")
+	}
+	if len(config.PipelineScan.FailOnSeverity) != 2 || config.PipelineScan.FailOnCWE[0] != 89 || config.PipelineScan.TimeoutMinutes != 30 {
+		t.Errorf("Unexpected pipeline_scan defaults: %+v", config.PipelineScan)
+	}
+}
+
+func TestLoadWorkspaceConfig_SearchesParentsUpToRepositoryRoot(t *testing.T) {
+	repo := filepath.Join(t.TempDir(), "repo")
+	subdir := filepath.Join(repo, "services", "api")
+	if err := os.MkdirAll(filepath.Join(repo, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(subdir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, WorkspaceFileName), []byte(`{"name": "RepoApp"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadWorkspaceConfig(subdir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if config.Name != "RepoApp" || config.Path != filepath.Join(repo, WorkspaceFileName) {
+		t.Errorf("Expected the repository's workspace file, got %+v", config)
+	}
+
+	// The search stops at the repository root, so a file above it is not used
+	if err := os.Rename(filepath.Join(repo, WorkspaceFileName), filepath.Join(filepath.Dir(repo), WorkspaceFileName)); err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadWorkspaceConfig(subdir)
+	if err == nil || !strings.Contains(err.Error(), "or its parent directories up to '"+repo+"'") {
+		t.Errorf("Expected a not found error naming the repository root, got: %v", err)
+	}
+}
+
+func TestLoadWorkspaceConfig_PreciseErrors(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"{\n  \"name\": \"App\",\n  \"pipeline_scan\": {\"timeout_minutes\": \"30\"}\n}", `line 3: "pipeline_scan.timeout_minutes" must be a number, got a JSON string`},
+		{`{"version": 3, "name": "App"}`, "version 3 is not supported"},
+		{`{"application_guid": "not-a-guid"}`, "application_guid must be a GUID"},
+		{`{"name": "App", "pipeline_scan": {"fail_on_severity": ["Critical"]}}`, `pipeline_scan.fail_on_severity must contain Very High, High, Medium, Low, Very Low, Informational, got "Critical"`},
+		{`{"name": "App", "package": {"exclude": ["build/*.jar"]}}`, `package.exclude must contain file name patterns`},
+		{`{"name": "App", "local_sca": {"ignore_cves": ["2024-1234"]}}`, `local_sca.ignore_cves must contain CVE IDs`},
+	}
+	for _, tt := range tests {
+		_, err := ParseWorkspaceConfig([]byte(tt.content), WorkspaceFileName)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: expected an error containing %q, got: %v", tt.content, tt.want, err)
+		}
+	}
+}
+
+func TestParseWorkspaceConfig_WarnsOnUnknownFields(t *testing.T) {
+	content := `{"name": "App", "sandbx": "dev", "pipeline_scan": {"timeout": 30}, "projects": [{"path": "api", "name": "Api", "polcy": "P"}]}`
+	config, err := ParseWorkspaceConfig([]byte(content), WorkspaceFileName)
+	if err != nil {
+		t.Fatalf("Expected unknown fields to be ignored, got: %v", err)
+	}
+	if config.Name != "App" {
+		t.Errorf("Expected the known fields to be read, got %+v", config)
+	}
+	want := []string{"pipeline_scan.timeout", "projects[0].polcy", "sandbx"}
+	if len(config.Warnings) != len(want) {
+		t.Fatalf("Expected %d warnings, got %v", len(want), config.Warnings)
+	}
+	for i, field := range want {
+		if !strings.Contains(config.Warnings[i], fmt.Sprintf("unknown field %q", field)) {
+			t.Errorf("Expected warning %d to name %q, got %q", i, field, config.Warnings[i])
+		}
+	}
+}
+
+func TestParseWorkspaceConfig_ApplicationGUID(t *testing.T) {
+	config, err := ParseWorkspaceConfig([]byte(`{"version": 2, "application_guid": "2f8c6a5e-1b3d-4c7e-9a0f-5d6e7f8a9b0c"}`), WorkspaceFileName)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if config.Application() != "2f8c6a5e-1b3d-4c7e-9a0f-5d6e7f8a9b0c" {
+		t.Errorf("Expected the GUID to identify the application, got %q", config.Application())
+	}
+}
+
+func TestSaveWorkspaceConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), WorkspaceFileName)
+	config := &WorkspaceConfig{Name: "SavedApp", Package: &PackageConfig{Include: []string{"*.war"}}, Path: path}
+	if err := SaveWorkspaceConfig(config); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	saved, err := LoadWorkspaceConfig(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if saved.Version != CurrentSchemaVersion || saved.Name != "SavedApp" || saved.Package.Include[0] != "*.war" {
+		t.Errorf("Unexpected saved configuration: %+v", saved)
+	}
+
+	// An invalid configuration is not written
+	config.Package.Include = []string{"["}
+	if err := SaveWorkspaceConfig(config); err == nil {
+		t.Error("Expected an error saving an invalid configuration")
+	}
+	if saved, err := LoadWorkspaceConfig(filepath.Dir(path)); err != nil || saved.Package.Include[0] != "*.war" {
+		t.Errorf("Expected the previous configuration to be kept, got %+v, %v", saved, err)
+	}
+}
+
+func TestPackageConfig_Selects(t *testing.T) {
+	config := &PackageConfig{Include: []string{"*.jar", "*.war"}, Exclude: []string{"*-tests.jar"}}
+	for name, want := range map[string]bool{"app.jar": true, "/out/web.war": true, "app-tests.jar": false, "app.zip": false} {
+		if got := config.Selects(name); got != want {
+			t.Errorf("Selects(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if !(*PackageConfig)(nil).Selects("app.zip") {
+		t.Error("Expected no package configuration to select every artifact")
+	}
+}